@@ -2,24 +2,35 @@ package main
 
 import (
 	"log"
+	"log/slog"
 	"net/http"
 
-	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/presentation/public/router"
 )
 
 func main() {
-	// NewRouterでEcho、Config、EntClientを取得
-	e, cfg, entClient, err := router.NewRouter()
+	// NewRouterでEcho (公開API・管理用ポート)、Config、シャットダウン完了の通知を取得
+	e, admin, cfg, shutdownDone, err := router.NewRouter()
 	if err != nil {
 		log.Fatalf("Failed to initialize router: %v", err)
 	}
-	defer database.CloseEntClient(entClient)
+
+	// 管理用ポート (/metrics) は公開しない
+	adminAddr := ":" + cfg.AdminPort
+	go func() {
+		slog.Info("starting admin server", "addr", adminAddr)
+		if err := admin.Start(adminAddr); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to start admin server: %v", err)
+		}
+	}()
 
 	// Start server
 	addr := ":" + cfg.Port
-	log.Printf("Starting server on %s", addr)
+	slog.Info("starting server", "addr", addr)
 	if err := e.Start(addr); err != nil && err != http.ErrServerClosed {
 		log.Fatalf("Failed to start server: %v", err)
 	}
+	// Start は Shutdown の開始直後に戻るので、処理中のリクエストとDB接続の後始末を待つ
+	<-shutdownDone
+	slog.Info("server stopped")
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+type AuditEvent struct {
+	ID         string
+	TenantID   string
+	ActorID    *string
+	Action     string
+	EntityType string
+	EntityID   string
+	Before     map[string]any
+	After      map[string]any
+	RequestID  *string
+	IP         *string
+	CreatedAt  time.Time
+}
+
+// AuditEventFilter narrows down audit events. Nil fields are not filtered on.
+type AuditEventFilter struct {
+	EntityType *string
+	EntityID   *string
+	ActorID    *string
+	Action     *string
+	From       *time.Time
+	To         *time.Time
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// IdempotencyKey is a stored response to a request sent with an Idempotency-Key header.
+// Keys are scoped by tenant and user, both empty for unauthenticated requests.
+type IdempotencyKey struct {
+	ID          string
+	TenantID    string
+	UserID      string
+	Key         string
+	RequestHash string
+	// StatusCode is nil while the first request is still being processed
+	StatusCode      *int
+	ResponseHeaders map[string]string
+	ResponseBody    []byte
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+}
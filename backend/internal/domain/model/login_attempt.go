@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// LoginAttempt is a recorded password login, successful or not
+type LoginAttempt struct {
+	ID        string
+	TenantID  string
+	Email     string
+	IPAddress string
+	Succeeded bool
+	CreatedAt time.Time
+}
+
+// LoginFailures summarizes the failed logins since the last successful one
+type LoginFailures struct {
+	Count        int
+	LastFailedAt *time.Time
+}
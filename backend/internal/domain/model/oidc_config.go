@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// OIDCConfig is a tenant's OpenID Connect provider
+type OIDCConfig struct {
+	ID           string
+	TenantID     string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// AllowedDomains are the email domains that may sign in
+	AllowedDomains []string
+	// DefaultRole is given to users created on their first sign-in
+	DefaultRole string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// Scopes a personal access token can be given. Reading also covers HEAD
+const (
+	ScopeReadTodos   = "read:todos"
+	ScopeWriteTodos  = "write:todos"
+	ScopeReadUser    = "read:user"
+	ScopeWriteUser   = "write:user"
+	ScopeReadTenant  = "read:tenant"
+	ScopeWriteTenant = "write:tenant"
+)
+
+// PersonalAccessTokenScopes lists every scope, in the order they are shown
+var PersonalAccessTokenScopes = []string{
+	ScopeReadTodos,
+	ScopeWriteTodos,
+	ScopeReadUser,
+	ScopeWriteUser,
+	ScopeReadTenant,
+	ScopeWriteTenant,
+}
+
+// PersonalAccessToken lets a user's scripts call the API without their password
+type PersonalAccessToken struct {
+	ID       string
+	TenantID string
+	UserID   string
+	Name     string
+	// TokenHash is the SHA-256 of the token; the token itself is never stored
+	TokenHash  string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
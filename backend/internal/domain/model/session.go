@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// Session is a login on one device, kept alive by its refresh token
+type Session struct {
+	ID       string
+	TenantID string
+	UserID   string
+	// RefreshTokenHash is the SHA-256 of the only refresh token the session accepts
+	RefreshTokenHash string
+	UserAgent        string
+	IPAddress        string
+	CreatedAt        time.Time
+	LastUsedAt       time.Time
+	ExpiresAt        time.Time
+	RevokedAt        *time.Time
+}
+
+// Active reports whether the session can still be used at now
+func (s *Session) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -11,6 +11,8 @@ type Todo struct {
 	Completed   bool
 	IsPublic    bool
 	DueDate     *time.Time
+	DeletedAt   *time.Time
+	Version     int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
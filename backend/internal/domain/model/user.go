@@ -12,14 +12,49 @@ type User struct {
 	EmailVerified              bool
 	VerificationToken          *string
 	VerificationTokenExpiresAt *time.Time
-	CreatedAt                  time.Time
-	UpdatedAt                  time.Time
+	LockedUntil                *time.Time
+	MFAEnabled                 bool
+	// MFASecret is set during enrollment, before MFAEnabled
+	MFASecret       *string
+	MFALastUsedStep *int64
+	// MFARecoveryCodeHashes are the SHA-256 of the unused recovery codes
+	MFARecoveryCodeHashes []string
+	// PendingEmail replaces Email once the token mailed to it is confirmed
+	PendingEmail         *string
+	EmailChangeTokenHash *string
+	EmailChangeExpiresAt *time.Time
+	// DeletionCodeHash is the SHA-256 of the code mailed to confirm deleting the account
+	DeletionCodeHash      *string
+	DeletionCodeExpiresAt *time.Time
+	// PasswordHistoryHashes are the hashes of previous passwords, newest first
+	PasswordHistoryHashes []string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
 
 type Tenant struct {
-	ID        string
-	Name      string
-	Slug      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                 string
+	Name               string
+	Slug               string
+	TrashRetentionDays *int
+	RateLimitPerMinute *int
+	RequireMFA         bool
+	// DeletedUserPublicTodos is DeletedUserPublicTodosDelete or DeletedUserPublicTodosReassign
+	DeletedUserPublicTodos string
+	// PasswordMinLength falls back to PASSWORD_MIN_LENGTH when nil
+	PasswordMinLength *int
+	// PasswordMinCharacterClasses is how many of lowercase, uppercase, digits
+	// and symbols a password must contain
+	PasswordMinCharacterClasses int
+	// PasswordHistory is the number of previous passwords that cannot be reused
+	PasswordHistory int
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
+
+// What happens to the public todos of a user who deletes their account
+const (
+	DeletedUserPublicTodosDelete = "delete"
+	// DeletedUserPublicTodosReassign gives them to the oldest admin of the tenant
+	DeletedUserPublicTodosReassign = "reassign"
+)
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// IAuditEventRepository only reads; events are written by the ent audit hook
+type IAuditEventRepository interface {
+	// Read operations use tenant context (tenantID from context)
+	FindByFilter(ctx context.Context, filter *model.AuditEventFilter, limit, offset int) ([]*model.AuditEvent, error)
+	CountByFilter(ctx context.Context, filter *model.AuditEventFilter) (int, error)
+}
@@ -10,7 +10,10 @@ import (
 type IAuthRepository interface {
 	// Tenant operations
 	FindTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error)
+	FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error)
+	FindAllTenants(ctx context.Context) ([]*model.Tenant, error)
 	CreateTenant(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error)
+	UpdateTenant(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error)
 
 	// User operations
 	FindUserByEmail(ctx context.Context, tenantID, email string) (*model.User, error)
@@ -18,5 +21,6 @@ type IAuthRepository interface {
 	// FindUserByVerificationToken searches by unique token, so no tenant context needed
 	FindUserByVerificationToken(ctx context.Context, token string) (*model.User, error)
 	CreateUser(ctx context.Context, user *model.User) (*model.User, error)
+	// UpdateUser returns ErrEmailTaken when the email belongs to another user of the tenant
 	UpdateUser(ctx context.Context, user *model.User) (*model.User, error)
 }
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import "context"
+
+// IHealthRepository inspects the database for the readiness probe
+type IHealthRepository interface {
+	Ping(ctx context.Context) error
+	// MigrationVersion returns the last migration Atlas applied completely
+	MigrationVersion(ctx context.Context) (string, error)
+	// TablesWithoutRLS returns the tenant tables where row level security is not both enabled and forced
+	TablesWithoutRLS(ctx context.Context) ([]string, error)
+}
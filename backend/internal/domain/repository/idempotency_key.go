@@ -0,0 +1,21 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// IIdempotencyKeyRepository takes the tenant explicitly because unauthenticated
+// requests (tenant "") have no tenant context
+type IIdempotencyKeyRepository interface {
+	// Reserve stores k unless a key with the same scope that has not expired at now exists,
+	// in which case that key is returned instead and k is not stored
+	Reserve(ctx context.Context, k *model.IdempotencyKey, now time.Time) (*model.IdempotencyKey, error)
+	// SaveResponse records the response of a reserved key
+	SaveResponse(ctx context.Context, k *model.IdempotencyKey) error
+	Delete(ctx context.Context, tenantID, userID, key string) error
+	DeleteExpired(ctx context.Context, tenantID string, now time.Time) (int, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// ILoginAttemptRepository takes the tenant explicitly because logins run before
+// there is a tenant context
+type ILoginAttemptRepository interface {
+	Create(ctx context.Context, attempt *model.LoginAttempt) error
+	// FindFailuresByEmail summarizes failures for the email after since that are
+	// newer than its last successful login
+	FindFailuresByEmail(ctx context.Context, tenantID, email string, since time.Time) (*model.LoginFailures, error)
+	CountFailuresByIP(ctx context.Context, tenantID, ip string, since time.Time) (int, error)
+	// DeleteFailuresByEmail forgets the failures for the email, used when an account is unlocked
+	DeleteFailuresByEmail(ctx context.Context, tenantID, email string) error
+	DeleteBefore(ctx context.Context, tenantID string, before time.Time) (int, error)
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit_event.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit_event.go -destination=mock/audit_event.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAuditEventRepository is a mock of IAuditEventRepository interface.
+type MockIAuditEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditEventRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIAuditEventRepositoryMockRecorder is the mock recorder for MockIAuditEventRepository.
+type MockIAuditEventRepositoryMockRecorder struct {
+	mock *MockIAuditEventRepository
+}
+
+// NewMockIAuditEventRepository creates a new mock instance.
+func NewMockIAuditEventRepository(ctrl *gomock.Controller) *MockIAuditEventRepository {
+	mock := &MockIAuditEventRepository{ctrl: ctrl}
+	mock.recorder = &MockIAuditEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditEventRepository) EXPECT() *MockIAuditEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByFilter mocks base method.
+func (m *MockIAuditEventRepository) CountByFilter(ctx context.Context, filter *model.AuditEventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByFilter", ctx, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByFilter indicates an expected call of CountByFilter.
+func (mr *MockIAuditEventRepositoryMockRecorder) CountByFilter(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByFilter", reflect.TypeOf((*MockIAuditEventRepository)(nil).CountByFilter), ctx, filter)
+}
+
+// FindByFilter mocks base method.
+func (m *MockIAuditEventRepository) FindByFilter(ctx context.Context, filter *model.AuditEventFilter, limit, offset int) ([]*model.AuditEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFilter", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]*model.AuditEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByFilter indicates an expected call of FindByFilter.
+func (mr *MockIAuditEventRepositoryMockRecorder) FindByFilter(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFilter", reflect.TypeOf((*MockIAuditEventRepository)(nil).FindByFilter), ctx, filter, limit, offset)
+}
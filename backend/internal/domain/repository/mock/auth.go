@@ -71,6 +71,36 @@ func (mr *MockIAuthRepositoryMockRecorder) CreateUser(ctx, user any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockIAuthRepository)(nil).CreateUser), ctx, user)
 }
 
+// FindAllTenants mocks base method.
+func (m *MockIAuthRepository) FindAllTenants(ctx context.Context) ([]*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllTenants", ctx)
+	ret0, _ := ret[0].([]*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllTenants indicates an expected call of FindAllTenants.
+func (mr *MockIAuthRepositoryMockRecorder) FindAllTenants(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTenants", reflect.TypeOf((*MockIAuthRepository)(nil).FindAllTenants), ctx)
+}
+
+// FindTenantByID mocks base method.
+func (m *MockIAuthRepository) FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTenantByID", ctx, tenantID)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTenantByID indicates an expected call of FindTenantByID.
+func (mr *MockIAuthRepositoryMockRecorder) FindTenantByID(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTenantByID", reflect.TypeOf((*MockIAuthRepository)(nil).FindTenantByID), ctx, tenantID)
+}
+
 // FindTenantBySlug mocks base method.
 func (m *MockIAuthRepository) FindTenantBySlug(ctx context.Context, slug string) (*model.Tenant, error) {
 	m.ctrl.T.Helper()
@@ -131,6 +161,21 @@ func (mr *MockIAuthRepositoryMockRecorder) FindUserByVerificationToken(ctx, toke
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUserByVerificationToken", reflect.TypeOf((*MockIAuthRepository)(nil).FindUserByVerificationToken), ctx, token)
 }
 
+// UpdateTenant mocks base method.
+func (m *MockIAuthRepository) UpdateTenant(ctx context.Context, tenant *model.Tenant) (*model.Tenant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTenant", ctx, tenant)
+	ret0, _ := ret[0].(*model.Tenant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTenant indicates an expected call of UpdateTenant.
+func (mr *MockIAuthRepositoryMockRecorder) UpdateTenant(ctx, tenant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTenant", reflect.TypeOf((*MockIAuthRepository)(nil).UpdateTenant), ctx, tenant)
+}
+
 // UpdateUser mocks base method.
 func (m *MockIAuthRepository) UpdateUser(ctx context.Context, user *model.User) (*model.User, error) {
 	m.ctrl.T.Helper()
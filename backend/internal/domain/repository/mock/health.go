@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health.go
+//
+// Generated by this command:
+//
+//	mockgen -source=health.go -destination=mock/health.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHealthRepository is a mock of IHealthRepository interface.
+type MockIHealthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIHealthRepositoryMockRecorder is the mock recorder for MockIHealthRepository.
+type MockIHealthRepositoryMockRecorder struct {
+	mock *MockIHealthRepository
+}
+
+// NewMockIHealthRepository creates a new mock instance.
+func NewMockIHealthRepository(ctrl *gomock.Controller) *MockIHealthRepository {
+	mock := &MockIHealthRepository{ctrl: ctrl}
+	mock.recorder = &MockIHealthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthRepository) EXPECT() *MockIHealthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// MigrationVersion mocks base method.
+func (m *MockIHealthRepository) MigrationVersion(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrationVersion", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrationVersion indicates an expected call of MigrationVersion.
+func (mr *MockIHealthRepositoryMockRecorder) MigrationVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrationVersion", reflect.TypeOf((*MockIHealthRepository)(nil).MigrationVersion), ctx)
+}
+
+// Ping mocks base method.
+func (m *MockIHealthRepository) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockIHealthRepositoryMockRecorder) Ping(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockIHealthRepository)(nil).Ping), ctx)
+}
+
+// TablesWithoutRLS mocks base method.
+func (m *MockIHealthRepository) TablesWithoutRLS(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TablesWithoutRLS", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TablesWithoutRLS indicates an expected call of TablesWithoutRLS.
+func (mr *MockIHealthRepositoryMockRecorder) TablesWithoutRLS(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TablesWithoutRLS", reflect.TypeOf((*MockIHealthRepository)(nil).TablesWithoutRLS), ctx)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: idempotency_key.go
+//
+// Generated by this command:
+//
+//	mockgen -source=idempotency_key.go -destination=mock/idempotency_key.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIdempotencyKeyRepository is a mock of IIdempotencyKeyRepository interface.
+type MockIIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIdempotencyKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIIdempotencyKeyRepository.
+type MockIIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIIdempotencyKeyRepository
+}
+
+// NewMockIIdempotencyKeyRepository creates a new mock instance.
+func NewMockIIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIIdempotencyKeyRepository {
+	mock := &MockIIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIdempotencyKeyRepository) EXPECT() *MockIIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIIdempotencyKeyRepository) Delete(ctx context.Context, tenantID, userID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tenantID, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) Delete(ctx, tenantID, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).Delete), ctx, tenantID, userID, key)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIIdempotencyKeyRepository) DeleteExpired(ctx context.Context, tenantID string, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, tenantID, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) DeleteExpired(ctx, tenantID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).DeleteExpired), ctx, tenantID, now)
+}
+
+// Reserve mocks base method.
+func (m *MockIIdempotencyKeyRepository) Reserve(ctx context.Context, k *model.IdempotencyKey, now time.Time) (*model.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, k, now)
+	ret0, _ := ret[0].(*model.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) Reserve(ctx, k, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).Reserve), ctx, k, now)
+}
+
+// SaveResponse mocks base method.
+func (m *MockIIdempotencyKeyRepository) SaveResponse(ctx context.Context, k *model.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResponse", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResponse indicates an expected call of SaveResponse.
+func (mr *MockIIdempotencyKeyRepositoryMockRecorder) SaveResponse(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResponse", reflect.TypeOf((*MockIIdempotencyKeyRepository)(nil).SaveResponse), ctx, k)
+}
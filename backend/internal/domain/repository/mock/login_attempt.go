@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: login_attempt.go
+//
+// Generated by this command:
+//
+//	mockgen -source=login_attempt.go -destination=mock/login_attempt.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockILoginAttemptRepository is a mock of ILoginAttemptRepository interface.
+type MockILoginAttemptRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockILoginAttemptRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockILoginAttemptRepositoryMockRecorder is the mock recorder for MockILoginAttemptRepository.
+type MockILoginAttemptRepositoryMockRecorder struct {
+	mock *MockILoginAttemptRepository
+}
+
+// NewMockILoginAttemptRepository creates a new mock instance.
+func NewMockILoginAttemptRepository(ctrl *gomock.Controller) *MockILoginAttemptRepository {
+	mock := &MockILoginAttemptRepository{ctrl: ctrl}
+	mock.recorder = &MockILoginAttemptRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILoginAttemptRepository) EXPECT() *MockILoginAttemptRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountFailuresByIP mocks base method.
+func (m *MockILoginAttemptRepository) CountFailuresByIP(ctx context.Context, tenantID, ip string, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFailuresByIP", ctx, tenantID, ip, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFailuresByIP indicates an expected call of CountFailuresByIP.
+func (mr *MockILoginAttemptRepositoryMockRecorder) CountFailuresByIP(ctx, tenantID, ip, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFailuresByIP", reflect.TypeOf((*MockILoginAttemptRepository)(nil).CountFailuresByIP), ctx, tenantID, ip, since)
+}
+
+// Create mocks base method.
+func (m *MockILoginAttemptRepository) Create(ctx context.Context, attempt *model.LoginAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockILoginAttemptRepositoryMockRecorder) Create(ctx, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockILoginAttemptRepository)(nil).Create), ctx, attempt)
+}
+
+// DeleteBefore mocks base method.
+func (m *MockILoginAttemptRepository) DeleteBefore(ctx context.Context, tenantID string, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBefore", ctx, tenantID, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBefore indicates an expected call of DeleteBefore.
+func (mr *MockILoginAttemptRepositoryMockRecorder) DeleteBefore(ctx, tenantID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBefore", reflect.TypeOf((*MockILoginAttemptRepository)(nil).DeleteBefore), ctx, tenantID, before)
+}
+
+// DeleteFailuresByEmail mocks base method.
+func (m *MockILoginAttemptRepository) DeleteFailuresByEmail(ctx context.Context, tenantID, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailuresByEmail", ctx, tenantID, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFailuresByEmail indicates an expected call of DeleteFailuresByEmail.
+func (mr *MockILoginAttemptRepositoryMockRecorder) DeleteFailuresByEmail(ctx, tenantID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailuresByEmail", reflect.TypeOf((*MockILoginAttemptRepository)(nil).DeleteFailuresByEmail), ctx, tenantID, email)
+}
+
+// FindFailuresByEmail mocks base method.
+func (m *MockILoginAttemptRepository) FindFailuresByEmail(ctx context.Context, tenantID, email string, since time.Time) (*model.LoginFailures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFailuresByEmail", ctx, tenantID, email, since)
+	ret0, _ := ret[0].(*model.LoginFailures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFailuresByEmail indicates an expected call of FindFailuresByEmail.
+func (mr *MockILoginAttemptRepositoryMockRecorder) FindFailuresByEmail(ctx, tenantID, email, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFailuresByEmail", reflect.TypeOf((*MockILoginAttemptRepository)(nil).FindFailuresByEmail), ctx, tenantID, email, since)
+}
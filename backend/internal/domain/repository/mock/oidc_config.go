@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc_config.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc_config.go -destination=mock/oidc_config.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOIDCConfigRepository is a mock of IOIDCConfigRepository interface.
+type MockIOIDCConfigRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOIDCConfigRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIOIDCConfigRepositoryMockRecorder is the mock recorder for MockIOIDCConfigRepository.
+type MockIOIDCConfigRepositoryMockRecorder struct {
+	mock *MockIOIDCConfigRepository
+}
+
+// NewMockIOIDCConfigRepository creates a new mock instance.
+func NewMockIOIDCConfigRepository(ctrl *gomock.Controller) *MockIOIDCConfigRepository {
+	mock := &MockIOIDCConfigRepository{ctrl: ctrl}
+	mock.recorder = &MockIOIDCConfigRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOIDCConfigRepository) EXPECT() *MockIOIDCConfigRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIOIDCConfigRepository) Delete(ctx context.Context, tenantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tenantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIOIDCConfigRepositoryMockRecorder) Delete(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIOIDCConfigRepository)(nil).Delete), ctx, tenantID)
+}
+
+// FindByTenantID mocks base method.
+func (m *MockIOIDCConfigRepository) FindByTenantID(ctx context.Context, tenantID string) (*model.OIDCConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTenantID", ctx, tenantID)
+	ret0, _ := ret[0].(*model.OIDCConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTenantID indicates an expected call of FindByTenantID.
+func (mr *MockIOIDCConfigRepositoryMockRecorder) FindByTenantID(ctx, tenantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTenantID", reflect.TypeOf((*MockIOIDCConfigRepository)(nil).FindByTenantID), ctx, tenantID)
+}
+
+// Save mocks base method.
+func (m *MockIOIDCConfigRepository) Save(ctx context.Context, config *model.OIDCConfig) (*model.OIDCConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, config)
+	ret0, _ := ret[0].(*model.OIDCConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIOIDCConfigRepositoryMockRecorder) Save(ctx, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIOIDCConfigRepository)(nil).Save), ctx, config)
+}
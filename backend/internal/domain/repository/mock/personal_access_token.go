@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: personal_access_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=personal_access_token.go -destination=mock/personal_access_token.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "good-todo-go/internal/domain/model"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPersonalAccessTokenRepository is a mock of IPersonalAccessTokenRepository interface.
+type MockIPersonalAccessTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPersonalAccessTokenRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIPersonalAccessTokenRepositoryMockRecorder is the mock recorder for MockIPersonalAccessTokenRepository.
+type MockIPersonalAccessTokenRepositoryMockRecorder struct {
+	mock *MockIPersonalAccessTokenRepository
+}
+
+// NewMockIPersonalAccessTokenRepository creates a new mock instance.
+func NewMockIPersonalAccessTokenRepository(ctrl *gomock.Controller) *MockIPersonalAccessTokenRepository {
+	mock := &MockIPersonalAccessTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockIPersonalAccessTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPersonalAccessTokenRepository) EXPECT() *MockIPersonalAccessTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIPersonalAccessTokenRepository) Create(ctx context.Context, token *model.PersonalAccessToken) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPersonalAccessTokenRepositoryMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPersonalAccessTokenRepository)(nil).Create), ctx, token)
+}
+
+// Delete mocks base method.
+func (m *MockIPersonalAccessTokenRepository) Delete(ctx context.Context, tenantID, userID, tokenID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tenantID, userID, tokenID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIPersonalAccessTokenRepositoryMockRecorder) Delete(ctx, tenantID, userID, tokenID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIPersonalAccessTokenRepository)(nil).Delete), ctx, tenantID, userID, tokenID)
+}
+
+// FindByHash mocks base method.
+func (m *MockIPersonalAccessTokenRepository) FindByHash(ctx context.Context, tenantID, tokenHash string) (*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", ctx, tenantID, tokenHash)
+	ret0, _ := ret[0].(*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockIPersonalAccessTokenRepositoryMockRecorder) FindByHash(ctx, tenantID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockIPersonalAccessTokenRepository)(nil).FindByHash), ctx, tenantID, tokenHash)
+}
+
+// FindByUserID mocks base method.
+func (m *MockIPersonalAccessTokenRepository) FindByUserID(ctx context.Context, tenantID, userID string) ([]*model.PersonalAccessToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByUserID", ctx, tenantID, userID)
+	ret0, _ := ret[0].([]*model.PersonalAccessToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByUserID indicates an expected call of FindByUserID.
+func (mr *MockIPersonalAccessTokenRepositoryMockRecorder) FindByUserID(ctx, tenantID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockIPersonalAccessTokenRepository)(nil).FindByUserID), ctx, tenantID, userID)
+}
+
+// UpdateLastUsedAt mocks base method.
+func (m *MockIPersonalAccessTokenRepository) UpdateLastUsedAt(ctx context.Context, tenantID, tokenID string, usedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastUsedAt", ctx, tenantID, tokenID, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastUsedAt indicates an expected call of UpdateLastUsedAt.
+func (mr *MockIPersonalAccessTokenRepositoryMockRecorder) UpdateLastUsedAt(ctx, tenantID, tokenID, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastUsedAt", reflect.TypeOf((*MockIPersonalAccessTokenRepository)(nil).UpdateLastUsedAt), ctx, tenantID, tokenID, usedAt)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	model "good-todo-go/internal/domain/model"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -56,6 +57,21 @@ func (mr *MockITodoRepositoryMockRecorder) CountByUserID(ctx, userID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByUserID", reflect.TypeOf((*MockITodoRepository)(nil).CountByUserID), ctx, userID)
 }
 
+// CountDeletedByUserID mocks base method.
+func (m *MockITodoRepository) CountDeletedByUserID(ctx context.Context, userID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedByUserID", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedByUserID indicates an expected call of CountDeletedByUserID.
+func (mr *MockITodoRepositoryMockRecorder) CountDeletedByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedByUserID", reflect.TypeOf((*MockITodoRepository)(nil).CountDeletedByUserID), ctx, userID)
+}
+
 // CountPublic mocks base method.
 func (m *MockITodoRepository) CountPublic(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +116,21 @@ func (mr *MockITodoRepositoryMockRecorder) Delete(ctx, todoID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockITodoRepository)(nil).Delete), ctx, todoID)
 }
 
+// FindAllByUserID mocks base method.
+func (m *MockITodoRepository) FindAllByUserID(ctx context.Context, userID string) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByUserID", ctx, userID)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByUserID indicates an expected call of FindAllByUserID.
+func (mr *MockITodoRepositoryMockRecorder) FindAllByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindAllByUserID), ctx, userID)
+}
+
 // FindByID mocks base method.
 func (m *MockITodoRepository) FindByID(ctx context.Context, todoID string) (*model.Todo, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +161,36 @@ func (mr *MockITodoRepositoryMockRecorder) FindByUserID(ctx, userID, limit, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindByUserID), ctx, userID, limit, offset)
 }
 
+// FindDeletedByID mocks base method.
+func (m *MockITodoRepository) FindDeletedByID(ctx context.Context, todoID string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, todoID)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockITodoRepositoryMockRecorder) FindDeletedByID(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockITodoRepository)(nil).FindDeletedByID), ctx, todoID)
+}
+
+// FindDeletedByUserID mocks base method.
+func (m *MockITodoRepository) FindDeletedByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByUserID", ctx, userID, limit, offset)
+	ret0, _ := ret[0].([]*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByUserID indicates an expected call of FindDeletedByUserID.
+func (mr *MockITodoRepositoryMockRecorder) FindDeletedByUserID(ctx, userID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByUserID", reflect.TypeOf((*MockITodoRepository)(nil).FindDeletedByUserID), ctx, userID, limit, offset)
+}
+
 // FindPublic mocks base method.
 func (m *MockITodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +206,50 @@ func (mr *MockITodoRepositoryMockRecorder) FindPublic(ctx, limit, offset any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublic", reflect.TypeOf((*MockITodoRepository)(nil).FindPublic), ctx, limit, offset)
 }
 
+// Purge mocks base method.
+func (m *MockITodoRepository) Purge(ctx context.Context, todoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, todoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockITodoRepositoryMockRecorder) Purge(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockITodoRepository)(nil).Purge), ctx, todoID)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockITodoRepository) PurgeDeletedBefore(ctx context.Context, tenantID string, before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, tenantID, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockITodoRepositoryMockRecorder) PurgeDeletedBefore(ctx, tenantID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockITodoRepository)(nil).PurgeDeletedBefore), ctx, tenantID, before)
+}
+
+// Restore mocks base method.
+func (m *MockITodoRepository) Restore(ctx context.Context, todoID string) (*model.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, todoID)
+	ret0, _ := ret[0].(*model.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockITodoRepositoryMockRecorder) Restore(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockITodoRepository)(nil).Restore), ctx, todoID)
+}
+
 // Update mocks base method.
 func (m *MockITodoRepository) Update(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
 	m.ctrl.T.Helper()
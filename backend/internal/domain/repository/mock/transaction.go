@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: transaction.go
+//
+// Generated by this command:
+//
+//	mockgen -source=transaction.go -destination=mock/transaction.go -package=mock_repository
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITransactionManager is a mock of ITransactionManager interface.
+type MockITransactionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockITransactionManagerMockRecorder
+	isgomock struct{}
+}
+
+// MockITransactionManagerMockRecorder is the mock recorder for MockITransactionManager.
+type MockITransactionManagerMockRecorder struct {
+	mock *MockITransactionManager
+}
+
+// NewMockITransactionManager creates a new mock instance.
+func NewMockITransactionManager(ctrl *gomock.Controller) *MockITransactionManager {
+	mock := &MockITransactionManager{ctrl: ctrl}
+	mock.recorder = &MockITransactionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITransactionManager) EXPECT() *MockITransactionManagerMockRecorder {
+	return m.recorder
+}
+
+// InTx mocks base method.
+func (m *MockITransactionManager) InTx(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InTx indicates an expected call of InTx.
+func (mr *MockITransactionManagerMockRecorder) InTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InTx", reflect.TypeOf((*MockITransactionManager)(nil).InTx), ctx, fn)
+}
+
+// Savepoint mocks base method.
+func (m *MockITransactionManager) Savepoint(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Savepoint", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Savepoint indicates an expected call of Savepoint.
+func (mr *MockITransactionManagerMockRecorder) Savepoint(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Savepoint", reflect.TypeOf((*MockITransactionManager)(nil).Savepoint), ctx, fn)
+}
@@ -41,6 +41,20 @@ func (m *MockIUserRepository) EXPECT() *MockIUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockIUserRepository) Delete(ctx context.Context, userID, publicTodosOwnerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, publicTodosOwnerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIUserRepositoryMockRecorder) Delete(ctx, userID, publicTodosOwnerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIUserRepository)(nil).Delete), ctx, userID, publicTodosOwnerID)
+}
+
 // FindByID mocks base method.
 func (m *MockIUserRepository) FindByID(ctx context.Context, userID string) (*model.User, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +85,21 @@ func (mr *MockIUserRepositoryMockRecorder) FindByIDs(ctx, userIDs any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockIUserRepository)(nil).FindByIDs), ctx, userIDs)
 }
 
+// FindOldestAdmin mocks base method.
+func (m *MockIUserRepository) FindOldestAdmin(ctx context.Context, exceptUserID string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOldestAdmin", ctx, exceptUserID)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOldestAdmin indicates an expected call of FindOldestAdmin.
+func (mr *MockIUserRepositoryMockRecorder) FindOldestAdmin(ctx, exceptUserID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOldestAdmin", reflect.TypeOf((*MockIUserRepository)(nil).FindOldestAdmin), ctx, exceptUserID)
+}
+
 // Update mocks base method.
 func (m *MockIUserRepository) Update(ctx context.Context, user *model.User) (*model.User, error) {
 	m.ctrl.T.Helper()
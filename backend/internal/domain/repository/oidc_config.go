@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// IOIDCConfigRepository takes the tenant explicitly because sign-in runs before
+// there is a tenant context
+type IOIDCConfigRepository interface {
+	// FindByTenantID returns nil when the tenant has no config
+	FindByTenantID(ctx context.Context, tenantID string) (*model.OIDCConfig, error)
+	// Save creates the tenant's config or replaces it
+	Save(ctx context.Context, config *model.OIDCConfig) (*model.OIDCConfig, error)
+	Delete(ctx context.Context, tenantID string) error
+}
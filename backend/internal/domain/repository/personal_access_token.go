@@ -0,0 +1,22 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// IPersonalAccessTokenRepository takes the tenant explicitly because tokens are
+// looked up before there is a tenant context
+type IPersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *model.PersonalAccessToken) (*model.PersonalAccessToken, error)
+	// FindByHash returns nil when no token has the hash
+	FindByHash(ctx context.Context, tenantID, tokenHash string) (*model.PersonalAccessToken, error)
+	// FindByUserID returns the user's tokens, newest first
+	FindByUserID(ctx context.Context, tenantID, userID string) ([]*model.PersonalAccessToken, error)
+	// Delete returns false when the user has no token with the ID
+	Delete(ctx context.Context, tenantID, userID, tokenID string) (bool, error)
+	UpdateLastUsedAt(ctx context.Context, tenantID, tokenID string, usedAt time.Time) error
+}
@@ -0,0 +1,28 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+// ISessionRepository takes the tenant explicitly because sessions are started
+// and refreshed before there is a tenant context
+type ISessionRepository interface {
+	Create(ctx context.Context, session *model.Session) error
+	// FindByID returns nil when there is no such session
+	FindByID(ctx context.Context, tenantID, sessionID string) (*model.Session, error)
+	// FindActiveByUserID returns the user's sessions that are neither revoked nor expired, most recently used first
+	FindActiveByUserID(ctx context.Context, tenantID, userID string, now time.Time) ([]*model.Session, error)
+	// Rotate replaces the refresh token hash only if it is still oldHash and the
+	// session is not revoked. It returns false when another refresh won the race
+	Rotate(ctx context.Context, session *model.Session, oldHash string) (bool, error)
+	// Revoke ends one of the user's sessions. It returns false when there is no such active session
+	Revoke(ctx context.Context, tenantID, userID, sessionID string, now time.Time) (bool, error)
+	// RevokeAllByUserID ends the user's sessions except exceptID (empty for none) and returns how many were ended
+	RevokeAllByUserID(ctx context.Context, tenantID, userID, exceptID string, now time.Time) (int, error)
+	// DeleteInactiveBefore deletes sessions that expired or were revoked before the time
+	DeleteInactiveBefore(ctx context.Context, tenantID string, before time.Time) (int, error)
+}
@@ -3,20 +3,37 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 )
 
+// ErrVersionConflict is returned by Update when the todo was changed since it was read
+var ErrVersionConflict = errors.New("todo was modified concurrently")
+
 type ITodoRepository interface {
 	// Read operations use View with tenant context (tenantID from context)
 	FindByID(ctx context.Context, todoID string) (*model.Todo, error)
 	FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error)
 	CountByUserID(ctx context.Context, userID string) (int, error)
+	// FindAllByUserID includes the trash, for data exports
+	FindAllByUserID(ctx context.Context, userID string) ([]*model.Todo, error)
 	// Public todos (visible to all users in the same tenant)
 	FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error)
 	CountPublic(ctx context.Context) (int, error)
 	// Write operations use direct table access (RLS protected)
 	Create(ctx context.Context, todo *model.Todo) (*model.Todo, error)
+	// Update only applies if todo.Version still matches, and increments it
 	Update(ctx context.Context, todo *model.Todo) (*model.Todo, error)
+	// Delete moves the todo to the trash (soft delete)
 	Delete(ctx context.Context, todoID string) error
+	// Trash operations only see soft-deleted todos
+	FindDeletedByID(ctx context.Context, todoID string) (*model.Todo, error)
+	FindDeletedByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error)
+	CountDeletedByUserID(ctx context.Context, userID string) (int, error)
+	Restore(ctx context.Context, todoID string) (*model.Todo, error)
+	Purge(ctx context.Context, todoID string) error
+	// PurgeDeletedBefore has no request context, so tenantID is passed explicitly
+	PurgeDeletedBefore(ctx context.Context, tenantID string, before time.Time) (int, error)
 }
@@ -0,0 +1,12 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_repository
+package repository
+
+import "context"
+
+type ITransactionManager interface {
+	// InTx runs fn in one tenant-scoped transaction. Repository calls made with
+	// the ctx given to fn join it and are committed only if fn returns nil
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// Savepoint undoes only fn's writes when fn fails, keeping the InTx transaction usable
+	Savepoint(ctx context.Context, fn func(ctx context.Context) error) error
+}
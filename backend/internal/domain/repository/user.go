@@ -3,12 +3,22 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"good-todo-go/internal/domain/model"
 )
 
+// ErrEmailTaken is returned when a user would get the email of another user in the tenant
+var ErrEmailTaken = errors.New("email is already in use")
+
 type IUserRepository interface {
 	FindByID(ctx context.Context, userID string) (*model.User, error)
 	FindByIDs(ctx context.Context, userIDs []string) ([]*model.User, error)
 	Update(ctx context.Context, user *model.User) (*model.User, error)
+	// FindOldestAdmin returns nil when the tenant has no admin but exceptUserID
+	FindOldestAdmin(ctx context.Context, exceptUserID string) (*model.User, error)
+	// Delete permanently removes the user with their todos, sessions, tokens,
+	// idempotency keys and login attempts in one transaction. When
+	// publicTodosOwnerID is set, the user's public todos are given to that user instead
+	Delete(ctx context.Context, userID, publicTodosOwnerID string) error
 }
@@ -6,6 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/idempotencykey"
+	"good-todo-go/internal/ent/loginattempt"
+	"good-todo-go/internal/ent/oidcconfig"
+	"good-todo-go/internal/ent/personalaccesstoken"
+	"good-todo-go/internal/ent/session"
 	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/ent/todo"
 	"good-todo-go/internal/ent/user"
@@ -75,9 +81,15 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			tenant.Table: tenant.ValidColumn,
-			todo.Table:   todo.ValidColumn,
-			user.Table:   user.ValidColumn,
+			auditevent.Table:          auditevent.ValidColumn,
+			idempotencykey.Table:      idempotencykey.ValidColumn,
+			loginattempt.Table:        loginattempt.ValidColumn,
+			oidcconfig.Table:          oidcconfig.ValidColumn,
+			personalaccesstoken.Table: personalaccesstoken.ValidColumn,
+			session.Table:             session.ValidColumn,
+			tenant.Table:              tenant.ValidColumn,
+			todo.Table:                todo.ValidColumn,
+			user.Table:                user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,8 @@
+-- Add deleted_at column to todos table for soft delete (trash bin)
+ALTER TABLE "todos" ADD COLUMN "deleted_at" timestamptz NULL;
+
+-- Create index for tenant + deleted_at queries (trash listing and purge)
+CREATE INDEX "todo_tenant_id_deleted_at" ON "todos" ("tenant_id", "deleted_at");
+
+-- Add per-tenant trash retention override
+ALTER TABLE "tenants" ADD COLUMN "trash_retention_days" bigint NULL;
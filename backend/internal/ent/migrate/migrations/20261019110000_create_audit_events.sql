@@ -0,0 +1,34 @@
+-- Create "audit_events" table (append-only log of mutations per tenant)
+CREATE TABLE "audit_events" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "actor_id" character varying NULL,
+  "action" character varying NOT NULL,
+  "entity_type" character varying NOT NULL,
+  "entity_id" character varying NOT NULL,
+  "before" jsonb NULL,
+  "after" jsonb NULL,
+  "request_id" character varying NULL,
+  "ip" character varying NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "auditevent_tenant_id_created_at" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_created_at" ON "audit_events" ("tenant_id", "created_at");
+-- Create index "auditevent_tenant_id_entity_type_entity_id" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_entity_type_entity_id" ON "audit_events" ("tenant_id", "entity_type", "entity_id");
+-- Create index "auditevent_tenant_id_actor_id" to table: "audit_events"
+CREATE INDEX "auditevent_tenant_id_actor_id" ON "audit_events" ("tenant_id", "actor_id");
+
+-- Enable RLS on audit_events table
+ALTER TABLE "audit_events" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "audit_events" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for audit_events (ALL operations)
+CREATE POLICY "audit_events_tenant_isolation" ON "audit_events"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
+
+-- Audit events are append-only for the application user
+REVOKE UPDATE, DELETE ON "audit_events" FROM goodtodo_app;
@@ -0,0 +1,2 @@
+-- Add version column to todos table for optimistic concurrency control (ETag / If-Match)
+ALTER TABLE "todos" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
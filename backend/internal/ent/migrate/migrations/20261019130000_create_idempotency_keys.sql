@@ -0,0 +1,29 @@
+-- Create "idempotency_keys" table (stored responses for retried requests)
+CREATE TABLE "idempotency_keys" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL DEFAULT '',
+  "user_id" character varying NOT NULL DEFAULT '',
+  "key" character varying(255) NOT NULL,
+  "request_hash" character varying NOT NULL,
+  "status_code" bigint NULL,
+  "response_headers" jsonb NULL,
+  "response_body" bytea NULL,
+  "created_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "idempotencykey_tenant_id_user_id_key" to table: "idempotency_keys"
+CREATE UNIQUE INDEX "idempotencykey_tenant_id_user_id_key" ON "idempotency_keys" ("tenant_id", "user_id", "key");
+-- Create index "idempotencykey_tenant_id_expires_at" to table: "idempotency_keys"
+CREATE INDEX "idempotencykey_tenant_id_expires_at" ON "idempotency_keys" ("tenant_id", "expires_at");
+
+-- Enable RLS on idempotency_keys table
+ALTER TABLE "idempotency_keys" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "idempotency_keys" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for idempotency_keys (ALL operations)
+-- Unauthenticated requests run with an empty tenant and only see rows with tenant_id = ''
+CREATE POLICY "idempotency_keys_tenant_isolation" ON "idempotency_keys"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,2 @@
+-- Add per-tenant rate limit override
+ALTER TABLE "tenants" ADD COLUMN "rate_limit_per_minute" bigint NULL;
@@ -0,0 +1,27 @@
+-- Add lockout column to users table (set after too many failed logins)
+ALTER TABLE "users" ADD COLUMN "locked_until" timestamptz NULL;
+
+-- Create "login_attempts" table (password logins, for throttling and lockout)
+CREATE TABLE "login_attempts" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "email" character varying NOT NULL,
+  "ip_address" character varying NOT NULL DEFAULT '',
+  "succeeded" boolean NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "loginattempt_tenant_id_email_created_at" to table: "login_attempts"
+CREATE INDEX "loginattempt_tenant_id_email_created_at" ON "login_attempts" ("tenant_id", "email", "created_at");
+-- Create index "loginattempt_tenant_id_ip_address_created_at" to table: "login_attempts"
+CREATE INDEX "loginattempt_tenant_id_ip_address_created_at" ON "login_attempts" ("tenant_id", "ip_address", "created_at");
+
+-- Enable RLS on login_attempts table
+ALTER TABLE "login_attempts" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "login_attempts" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for login_attempts (ALL operations)
+CREATE POLICY "login_attempts_tenant_isolation" ON "login_attempts"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,8 @@
+-- Add TOTP two-factor authentication columns to users table
+ALTER TABLE "users" ADD COLUMN "mfa_enabled" boolean NOT NULL DEFAULT false;
+ALTER TABLE "users" ADD COLUMN "mfa_secret" character varying NULL;
+ALTER TABLE "users" ADD COLUMN "mfa_last_used_step" bigint NULL;
+ALTER TABLE "users" ADD COLUMN "mfa_recovery_code_hashes" jsonb NULL;
+
+-- Add per-tenant two-factor requirement
+ALTER TABLE "tenants" ADD COLUMN "require_mfa" boolean NOT NULL DEFAULT false;
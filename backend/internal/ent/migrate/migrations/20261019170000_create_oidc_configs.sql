@@ -0,0 +1,25 @@
+-- Create "oidc_configs" table (per-tenant OpenID Connect sign-in)
+CREATE TABLE "oidc_configs" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "issuer" character varying NOT NULL,
+  "client_id" character varying NOT NULL,
+  "client_secret" character varying NOT NULL,
+  "allowed_domains" jsonb NOT NULL,
+  "default_role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "oidc_configs_tenant_id_key" to table: "oidc_configs"
+CREATE UNIQUE INDEX "oidc_configs_tenant_id_key" ON "oidc_configs" ("tenant_id");
+
+-- Enable RLS on oidc_configs table
+ALTER TABLE "oidc_configs" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "oidc_configs" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for oidc_configs (ALL operations)
+CREATE POLICY "oidc_configs_tenant_isolation" ON "oidc_configs"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,28 @@
+-- Create "personal_access_tokens" table (API tokens for scripts, stored hashed)
+CREATE TABLE "personal_access_tokens" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  "name" character varying NOT NULL,
+  "token_hash" character varying NOT NULL,
+  "scopes" jsonb NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "last_used_at" timestamptz NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "personal_access_tokens_token_hash_key" to table: "personal_access_tokens"
+CREATE UNIQUE INDEX "personal_access_tokens_token_hash_key" ON "personal_access_tokens" ("token_hash");
+-- Create index "personalaccesstoken_tenant_id_user_id" to table: "personal_access_tokens"
+CREATE INDEX "personalaccesstoken_tenant_id_user_id" ON "personal_access_tokens" ("tenant_id", "user_id");
+
+-- Enable RLS on personal_access_tokens table
+-- Tokens carry their tenant, so lookups run in the tenant context like everything else
+ALTER TABLE "personal_access_tokens" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "personal_access_tokens" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for personal_access_tokens (ALL operations)
+CREATE POLICY "personal_access_tokens_tenant_isolation" ON "personal_access_tokens"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,26 @@
+-- Create "sessions" table (login sessions backing refresh tokens)
+CREATE TABLE "sessions" (
+  "id" character varying NOT NULL,
+  "tenant_id" character varying NOT NULL,
+  "user_id" character varying NOT NULL,
+  "refresh_token_hash" character varying NOT NULL,
+  "user_agent" character varying NOT NULL DEFAULT '',
+  "ip_address" character varying NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "last_used_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id")
+);
+-- Create index "session_tenant_id_user_id" to table: "sessions"
+CREATE INDEX "session_tenant_id_user_id" ON "sessions" ("tenant_id", "user_id");
+
+-- Enable RLS on sessions table
+ALTER TABLE "sessions" ENABLE ROW LEVEL SECURITY;
+ALTER TABLE "sessions" FORCE ROW LEVEL SECURITY;
+
+-- RLS Policy for sessions (ALL operations)
+CREATE POLICY "sessions_tenant_isolation" ON "sessions"
+    FOR ALL
+    USING ("tenant_id" = current_setting('app.current_tenant_id', true))
+    WITH CHECK ("tenant_id" = current_setting('app.current_tenant_id', true));
@@ -0,0 +1,4 @@
+-- Add pending email change columns to users table
+ALTER TABLE "users" ADD COLUMN "pending_email" character varying NULL;
+ALTER TABLE "users" ADD COLUMN "email_change_token_hash" character varying NULL;
+ALTER TABLE "users" ADD COLUMN "email_change_expires_at" timestamptz NULL;
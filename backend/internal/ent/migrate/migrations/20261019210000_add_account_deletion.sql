@@ -0,0 +1,6 @@
+-- Add emailed confirmation codes for account deletion
+ALTER TABLE "users" ADD COLUMN "deletion_code_hash" character varying NULL;
+ALTER TABLE "users" ADD COLUMN "deletion_code_expires_at" timestamptz NULL;
+
+-- Add per-tenant policy for the public todos of deleted accounts
+ALTER TABLE "tenants" ADD COLUMN "deleted_user_public_todos" character varying NOT NULL DEFAULT 'delete';
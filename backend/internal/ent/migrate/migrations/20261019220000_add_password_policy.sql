@@ -0,0 +1,7 @@
+-- Add per-tenant password policy
+ALTER TABLE "tenants" ADD COLUMN "password_min_length" bigint NULL;
+ALTER TABLE "tenants" ADD COLUMN "password_min_character_classes" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "tenants" ADD COLUMN "password_history" bigint NOT NULL DEFAULT 0;
+
+-- Add previous password hashes for the reuse policy
+ALTER TABLE "users" ADD COLUMN "password_history_hashes" jsonb NULL;
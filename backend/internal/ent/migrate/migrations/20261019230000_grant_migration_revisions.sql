@@ -0,0 +1,11 @@
+-- Let the application role read the applied revisions, checked by GET /readyz.
+-- Atlas creates its revisions schema before applying the first migration; it is
+-- missing when the files are run without Atlas (e.g. in tests)
+DO $$
+BEGIN
+    IF EXISTS (SELECT FROM pg_catalog.pg_namespace WHERE nspname = 'atlas_schema_revisions') THEN
+        GRANT USAGE ON SCHEMA atlas_schema_revisions TO goodtodo_app;
+        GRANT SELECT ON atlas_schema_revisions.atlas_schema_revisions TO goodtodo_app;
+    END IF;
+END
+$$;
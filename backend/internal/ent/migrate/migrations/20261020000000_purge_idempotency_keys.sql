@@ -0,0 +1,4 @@
+-- Drop every stored idempotent response. Before idempotency was limited to todo
+-- mutations, responses of login, refresh, registration and personal access token
+-- creation were stored with the tokens in plain text
+DELETE FROM "idempotency_keys";
@@ -0,0 +1,42 @@
+-- Redact personal data (user email, name and pending email, todo title and
+-- description) in existing audit snapshots, as newly written events do.
+-- The table owner skips RLS only without FORCE, so it is lifted for the update
+ALTER TABLE "audit_events" NO FORCE ROW LEVEL SECURITY;
+
+UPDATE "audit_events" SET
+  "before" = (
+    SELECT COALESCE(jsonb_object_agg(e.key, CASE
+      WHEN e.key IN ('email', 'name', 'pending_email') AND e.value <> 'null'::jsonb THEN '"[REDACTED]"'::jsonb
+      ELSE e.value END), '{}'::jsonb)
+    FROM jsonb_each("before") AS e
+  )
+WHERE "entity_type" = 'User' AND "before" IS NOT NULL;
+
+UPDATE "audit_events" SET
+  "after" = (
+    SELECT COALESCE(jsonb_object_agg(e.key, CASE
+      WHEN e.key IN ('email', 'name', 'pending_email') AND e.value <> 'null'::jsonb THEN '"[REDACTED]"'::jsonb
+      ELSE e.value END), '{}'::jsonb)
+    FROM jsonb_each("after") AS e
+  )
+WHERE "entity_type" = 'User' AND "after" IS NOT NULL;
+
+UPDATE "audit_events" SET
+  "before" = (
+    SELECT COALESCE(jsonb_object_agg(e.key, CASE
+      WHEN e.key IN ('title', 'description') AND e.value <> 'null'::jsonb THEN '"[REDACTED]"'::jsonb
+      ELSE e.value END), '{}'::jsonb)
+    FROM jsonb_each("before") AS e
+  )
+WHERE "entity_type" = 'Todo' AND "before" IS NOT NULL;
+
+UPDATE "audit_events" SET
+  "after" = (
+    SELECT COALESCE(jsonb_object_agg(e.key, CASE
+      WHEN e.key IN ('title', 'description') AND e.value <> 'null'::jsonb THEN '"[REDACTED]"'::jsonb
+      ELSE e.value END), '{}'::jsonb)
+    FROM jsonb_each("after") AS e
+  )
+WHERE "entity_type" = 'Todo' AND "after" IS NOT NULL;
+
+ALTER TABLE "audit_events" FORCE ROW LEVEL SECURITY;
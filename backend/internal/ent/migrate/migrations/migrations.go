@@ -0,0 +1,25 @@
+// Package migrations embeds the Atlas migration files, so that the API can tell
+// whether the database is at the revision it was built for.
+package migrations
+
+import (
+	"embed"
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// LatestVersion returns the version of the last migration, the timestamp that
+// prefixes its file name (e.g. 20261019220000), as Atlas records it once applied
+func LatestVersion() string {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil || len(names) == 0 {
+		return ""
+	}
+	sort.Strings(names)
+	version, _, _ := strings.Cut(names[len(names)-1], "_")
+	return version
+}
@@ -8,11 +8,182 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "soft_delete", "restore"}},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeString},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_tenant_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[10]},
+			},
+			{
+				Name:    "auditevent_tenant_id_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[4], AuditEventsColumns[5]},
+			},
+			{
+				Name:    "auditevent_tenant_id_actor_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1], AuditEventsColumns[2]},
+			},
+		},
+	}
+	// IdempotencyKeysColumns holds the columns for the "idempotency_keys" table.
+	IdempotencyKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString, Default: ""},
+		{Name: "user_id", Type: field.TypeString, Default: ""},
+		{Name: "key", Type: field.TypeString, Size: 255},
+		{Name: "request_hash", Type: field.TypeString},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// IdempotencyKeysTable holds the schema information for the "idempotency_keys" table.
+	IdempotencyKeysTable = &schema.Table{
+		Name:       "idempotency_keys",
+		Columns:    IdempotencyKeysColumns,
+		PrimaryKey: []*schema.Column{IdempotencyKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idempotencykey_tenant_id_user_id_key",
+				Unique:  true,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[2], IdempotencyKeysColumns[3]},
+			},
+			{
+				Name:    "idempotencykey_tenant_id_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{IdempotencyKeysColumns[1], IdempotencyKeysColumns[9]},
+			},
+		},
+	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "succeeded", Type: field.TypeBool},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_tenant_id_email_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[2], LoginAttemptsColumns[5]},
+			},
+			{
+				Name:    "loginattempt_tenant_id_ip_address_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[3], LoginAttemptsColumns[5]},
+			},
+		},
+	}
+	// OidcConfigsColumns holds the columns for the "oidc_configs" table.
+	OidcConfigsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString, Unique: true},
+		{Name: "issuer", Type: field.TypeString},
+		{Name: "client_id", Type: field.TypeString},
+		{Name: "client_secret", Type: field.TypeString},
+		{Name: "allowed_domains", Type: field.TypeJSON},
+		{Name: "default_role", Type: field.TypeString, Default: "member"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// OidcConfigsTable holds the schema information for the "oidc_configs" table.
+	OidcConfigsTable = &schema.Table{
+		Name:       "oidc_configs",
+		Columns:    OidcConfigsColumns,
+		PrimaryKey: []*schema.Column{OidcConfigsColumns[0]},
+	}
+	// PersonalAccessTokensColumns holds the columns for the "personal_access_tokens" table.
+	PersonalAccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PersonalAccessTokensTable holds the schema information for the "personal_access_tokens" table.
+	PersonalAccessTokensTable = &schema.Table{
+		Name:       "personal_access_tokens",
+		Columns:    PersonalAccessTokensColumns,
+		PrimaryKey: []*schema.Column{PersonalAccessTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "personalaccesstoken_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PersonalAccessTokensColumns[1], PersonalAccessTokensColumns[2]},
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "refresh_token_hash", Type: field.TypeString},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "ip_address", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_tenant_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[1], SessionsColumns[2]},
+			},
+		},
+	}
 	// TenantsColumns holds the columns for the "tenants" table.
 	TenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "trash_retention_days", Type: field.TypeInt, Nullable: true},
+		{Name: "rate_limit_per_minute", Type: field.TypeInt, Nullable: true},
+		{Name: "require_mfa", Type: field.TypeBool, Default: false},
+		{Name: "deleted_user_public_todos", Type: field.TypeEnum, Enums: []string{"delete", "reassign"}, Default: "delete"},
+		{Name: "password_min_length", Type: field.TypeInt, Nullable: true},
+		{Name: "password_min_character_classes", Type: field.TypeInt, Default: 0},
+		{Name: "password_history", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -25,6 +196,7 @@ var (
 	// TodosColumns holds the columns for the "todos" table.
 	TodosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "tenant_id", Type: field.TypeString},
 		{Name: "title", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647, Default: ""},
@@ -32,6 +204,7 @@ var (
 		{Name: "is_public", Type: field.TypeBool, Default: false},
 		{Name: "due_date", Type: field.TypeTime, Nullable: true},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeString},
@@ -44,7 +217,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "todos_users_todos",
-				Columns:    []*schema.Column{TodosColumns[10]},
+				Columns:    []*schema.Column{TodosColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -53,22 +226,27 @@ var (
 			{
 				Name:    "todo_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[1]},
+				Columns: []*schema.Column{TodosColumns[2]},
 			},
 			{
 				Name:    "todo_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[10]},
+				Columns: []*schema.Column{TodosColumns[12]},
 			},
 			{
 				Name:    "todo_tenant_id_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[1], TodosColumns[10]},
+				Columns: []*schema.Column{TodosColumns[2], TodosColumns[12]},
 			},
 			{
 				Name:    "todo_tenant_id_is_public",
 				Unique:  false,
-				Columns: []*schema.Column{TodosColumns[1], TodosColumns[5]},
+				Columns: []*schema.Column{TodosColumns[2], TodosColumns[6]},
+			},
+			{
+				Name:    "todo_tenant_id_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TodosColumns[2], TodosColumns[1]},
 			},
 		},
 	}
@@ -82,6 +260,17 @@ var (
 		{Name: "email_verified", Type: field.TypeBool, Default: false},
 		{Name: "verification_token", Type: field.TypeString, Nullable: true},
 		{Name: "verification_token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "mfa_enabled", Type: field.TypeBool, Default: false},
+		{Name: "mfa_secret", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_last_used_step", Type: field.TypeInt64, Nullable: true},
+		{Name: "mfa_recovery_code_hashes", Type: field.TypeJSON, Nullable: true},
+		{Name: "pending_email", Type: field.TypeString, Nullable: true},
+		{Name: "email_change_token_hash", Type: field.TypeString, Nullable: true},
+		{Name: "email_change_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_code_hash", Type: field.TypeString, Nullable: true},
+		{Name: "deletion_code_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "password_history_hashes", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "tenant_id", Type: field.TypeString},
@@ -94,7 +283,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_tenants_users",
-				Columns:    []*schema.Column{UsersColumns[10]},
+				Columns:    []*schema.Column{UsersColumns[21]},
 				RefColumns: []*schema.Column{TenantsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -103,17 +292,23 @@ var (
 			{
 				Name:    "user_tenant_id_email",
 				Unique:  true,
-				Columns: []*schema.Column{UsersColumns[10], UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[21], UsersColumns[1]},
 			},
 			{
 				Name:    "user_tenant_id",
 				Unique:  false,
-				Columns: []*schema.Column{UsersColumns[10]},
+				Columns: []*schema.Column{UsersColumns[21]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		IdempotencyKeysTable,
+		LoginAttemptsTable,
+		OidcConfigsTable,
+		PersonalAccessTokensTable,
+		SessionsTable,
 		TenantsTable,
 		TodosTable,
 		UsersTable,
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oidcconfig
+
+import (
+	"good-todo-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldID, id))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Issuer applies equality check predicate on the "issuer" field. It's identical to IssuerEQ.
+func Issuer(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldIssuer, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientSecret applies equality check predicate on the "client_secret" field. It's identical to ClientSecretEQ.
+func ClientSecret(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// DefaultRole applies equality check predicate on the "default_role" field. It's identical to DefaultRoleEQ.
+func DefaultRole(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldDefaultRole, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDContains applies the Contains predicate on the "tenant_id" field.
+func TenantIDContains(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContains(FieldTenantID, v))
+}
+
+// TenantIDHasPrefix applies the HasPrefix predicate on the "tenant_id" field.
+func TenantIDHasPrefix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasPrefix(FieldTenantID, v))
+}
+
+// TenantIDHasSuffix applies the HasSuffix predicate on the "tenant_id" field.
+func TenantIDHasSuffix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasSuffix(FieldTenantID, v))
+}
+
+// TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
+func TenantIDEqualFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldTenantID, v))
+}
+
+// TenantIDContainsFold applies the ContainsFold predicate on the "tenant_id" field.
+func TenantIDContainsFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldTenantID, v))
+}
+
+// IssuerEQ applies the EQ predicate on the "issuer" field.
+func IssuerEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldIssuer, v))
+}
+
+// IssuerNEQ applies the NEQ predicate on the "issuer" field.
+func IssuerNEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldIssuer, v))
+}
+
+// IssuerIn applies the In predicate on the "issuer" field.
+func IssuerIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldIssuer, vs...))
+}
+
+// IssuerNotIn applies the NotIn predicate on the "issuer" field.
+func IssuerNotIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldIssuer, vs...))
+}
+
+// IssuerGT applies the GT predicate on the "issuer" field.
+func IssuerGT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldIssuer, v))
+}
+
+// IssuerGTE applies the GTE predicate on the "issuer" field.
+func IssuerGTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldIssuer, v))
+}
+
+// IssuerLT applies the LT predicate on the "issuer" field.
+func IssuerLT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldIssuer, v))
+}
+
+// IssuerLTE applies the LTE predicate on the "issuer" field.
+func IssuerLTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldIssuer, v))
+}
+
+// IssuerContains applies the Contains predicate on the "issuer" field.
+func IssuerContains(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContains(FieldIssuer, v))
+}
+
+// IssuerHasPrefix applies the HasPrefix predicate on the "issuer" field.
+func IssuerHasPrefix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasPrefix(FieldIssuer, v))
+}
+
+// IssuerHasSuffix applies the HasSuffix predicate on the "issuer" field.
+func IssuerHasSuffix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasSuffix(FieldIssuer, v))
+}
+
+// IssuerEqualFold applies the EqualFold predicate on the "issuer" field.
+func IssuerEqualFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldIssuer, v))
+}
+
+// IssuerContainsFold applies the ContainsFold predicate on the "issuer" field.
+func IssuerContainsFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldIssuer, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldClientID, v))
+}
+
+// ClientIDContains applies the Contains predicate on the "client_id" field.
+func ClientIDContains(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContains(FieldClientID, v))
+}
+
+// ClientIDHasPrefix applies the HasPrefix predicate on the "client_id" field.
+func ClientIDHasPrefix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasPrefix(FieldClientID, v))
+}
+
+// ClientIDHasSuffix applies the HasSuffix predicate on the "client_id" field.
+func ClientIDHasSuffix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasSuffix(FieldClientID, v))
+}
+
+// ClientIDEqualFold applies the EqualFold predicate on the "client_id" field.
+func ClientIDEqualFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldClientID, v))
+}
+
+// ClientIDContainsFold applies the ContainsFold predicate on the "client_id" field.
+func ClientIDContainsFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldClientID, v))
+}
+
+// ClientSecretEQ applies the EQ predicate on the "client_secret" field.
+func ClientSecretEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldClientSecret, v))
+}
+
+// ClientSecretNEQ applies the NEQ predicate on the "client_secret" field.
+func ClientSecretNEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldClientSecret, v))
+}
+
+// ClientSecretIn applies the In predicate on the "client_secret" field.
+func ClientSecretIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretNotIn applies the NotIn predicate on the "client_secret" field.
+func ClientSecretNotIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldClientSecret, vs...))
+}
+
+// ClientSecretGT applies the GT predicate on the "client_secret" field.
+func ClientSecretGT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldClientSecret, v))
+}
+
+// ClientSecretGTE applies the GTE predicate on the "client_secret" field.
+func ClientSecretGTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldClientSecret, v))
+}
+
+// ClientSecretLT applies the LT predicate on the "client_secret" field.
+func ClientSecretLT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldClientSecret, v))
+}
+
+// ClientSecretLTE applies the LTE predicate on the "client_secret" field.
+func ClientSecretLTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldClientSecret, v))
+}
+
+// ClientSecretContains applies the Contains predicate on the "client_secret" field.
+func ClientSecretContains(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContains(FieldClientSecret, v))
+}
+
+// ClientSecretHasPrefix applies the HasPrefix predicate on the "client_secret" field.
+func ClientSecretHasPrefix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasPrefix(FieldClientSecret, v))
+}
+
+// ClientSecretHasSuffix applies the HasSuffix predicate on the "client_secret" field.
+func ClientSecretHasSuffix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasSuffix(FieldClientSecret, v))
+}
+
+// ClientSecretEqualFold applies the EqualFold predicate on the "client_secret" field.
+func ClientSecretEqualFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldClientSecret, v))
+}
+
+// ClientSecretContainsFold applies the ContainsFold predicate on the "client_secret" field.
+func ClientSecretContainsFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldClientSecret, v))
+}
+
+// DefaultRoleEQ applies the EQ predicate on the "default_role" field.
+func DefaultRoleEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldDefaultRole, v))
+}
+
+// DefaultRoleNEQ applies the NEQ predicate on the "default_role" field.
+func DefaultRoleNEQ(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldDefaultRole, v))
+}
+
+// DefaultRoleIn applies the In predicate on the "default_role" field.
+func DefaultRoleIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldDefaultRole, vs...))
+}
+
+// DefaultRoleNotIn applies the NotIn predicate on the "default_role" field.
+func DefaultRoleNotIn(vs ...string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldDefaultRole, vs...))
+}
+
+// DefaultRoleGT applies the GT predicate on the "default_role" field.
+func DefaultRoleGT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldDefaultRole, v))
+}
+
+// DefaultRoleGTE applies the GTE predicate on the "default_role" field.
+func DefaultRoleGTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldDefaultRole, v))
+}
+
+// DefaultRoleLT applies the LT predicate on the "default_role" field.
+func DefaultRoleLT(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldDefaultRole, v))
+}
+
+// DefaultRoleLTE applies the LTE predicate on the "default_role" field.
+func DefaultRoleLTE(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldDefaultRole, v))
+}
+
+// DefaultRoleContains applies the Contains predicate on the "default_role" field.
+func DefaultRoleContains(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContains(FieldDefaultRole, v))
+}
+
+// DefaultRoleHasPrefix applies the HasPrefix predicate on the "default_role" field.
+func DefaultRoleHasPrefix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasPrefix(FieldDefaultRole, v))
+}
+
+// DefaultRoleHasSuffix applies the HasSuffix predicate on the "default_role" field.
+func DefaultRoleHasSuffix(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldHasSuffix(FieldDefaultRole, v))
+}
+
+// DefaultRoleEqualFold applies the EqualFold predicate on the "default_role" field.
+func DefaultRoleEqualFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEqualFold(FieldDefaultRole, v))
+}
+
+// DefaultRoleContainsFold applies the ContainsFold predicate on the "default_role" field.
+func DefaultRoleContainsFold(v string) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldContainsFold(FieldDefaultRole, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OIDCConfig) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OIDCConfig) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OIDCConfig) predicate.OIDCConfig {
+	return predicate.OIDCConfig(sql.NotPredicates(p))
+}
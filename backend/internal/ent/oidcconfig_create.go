@@ -0,0 +1,377 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/oidcconfig"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCConfigCreate is the builder for creating a OIDCConfig entity.
+type OIDCConfigCreate struct {
+	config
+	mutation *OIDCConfigMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OIDCConfigCreate) SetTenantID(v string) *OIDCConfigCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetIssuer sets the "issuer" field.
+func (_c *OIDCConfigCreate) SetIssuer(v string) *OIDCConfigCreate {
+	_c.mutation.SetIssuer(v)
+	return _c
+}
+
+// SetClientID sets the "client_id" field.
+func (_c *OIDCConfigCreate) SetClientID(v string) *OIDCConfigCreate {
+	_c.mutation.SetClientID(v)
+	return _c
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_c *OIDCConfigCreate) SetClientSecret(v string) *OIDCConfigCreate {
+	_c.mutation.SetClientSecret(v)
+	return _c
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_c *OIDCConfigCreate) SetAllowedDomains(v []string) *OIDCConfigCreate {
+	_c.mutation.SetAllowedDomains(v)
+	return _c
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_c *OIDCConfigCreate) SetDefaultRole(v string) *OIDCConfigCreate {
+	_c.mutation.SetDefaultRole(v)
+	return _c
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_c *OIDCConfigCreate) SetNillableDefaultRole(v *string) *OIDCConfigCreate {
+	if v != nil {
+		_c.SetDefaultRole(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OIDCConfigCreate) SetCreatedAt(v time.Time) *OIDCConfigCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OIDCConfigCreate) SetNillableCreatedAt(v *time.Time) *OIDCConfigCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OIDCConfigCreate) SetUpdatedAt(v time.Time) *OIDCConfigCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OIDCConfigCreate) SetNillableUpdatedAt(v *time.Time) *OIDCConfigCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OIDCConfigCreate) SetID(v string) *OIDCConfigCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OIDCConfigCreate) SetNillableID(v *string) *OIDCConfigCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the OIDCConfigMutation object of the builder.
+func (_c *OIDCConfigCreate) Mutation() *OIDCConfigMutation {
+	return _c.mutation
+}
+
+// Save creates the OIDCConfig in the database.
+func (_c *OIDCConfigCreate) Save(ctx context.Context) (*OIDCConfig, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OIDCConfigCreate) SaveX(ctx context.Context) *OIDCConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OIDCConfigCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OIDCConfigCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OIDCConfigCreate) defaults() error {
+	if _, ok := _c.mutation.DefaultRole(); !ok {
+		v := oidcconfig.DefaultDefaultRole
+		_c.mutation.SetDefaultRole(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if oidcconfig.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcconfig.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcconfig.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if oidcconfig.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcconfig.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcconfig.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if oidcconfig.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized oidcconfig.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := oidcconfig.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OIDCConfigCreate) check() error {
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "OIDCConfig.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := oidcconfig.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "OIDCConfig.issuer"`)}
+	}
+	if v, ok := _c.mutation.Issuer(); ok {
+		if err := oidcconfig.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.issuer": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "OIDCConfig.client_id"`)}
+	}
+	if v, ok := _c.mutation.ClientID(); ok {
+		if err := oidcconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ClientSecret(); !ok {
+		return &ValidationError{Name: "client_secret", err: errors.New(`ent: missing required field "OIDCConfig.client_secret"`)}
+	}
+	if v, ok := _c.mutation.ClientSecret(); ok {
+		if err := oidcconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_secret": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AllowedDomains(); !ok {
+		return &ValidationError{Name: "allowed_domains", err: errors.New(`ent: missing required field "OIDCConfig.allowed_domains"`)}
+	}
+	if _, ok := _c.mutation.DefaultRole(); !ok {
+		return &ValidationError{Name: "default_role", err: errors.New(`ent: missing required field "OIDCConfig.default_role"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OIDCConfig.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OIDCConfig.updated_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := oidcconfig.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *OIDCConfigCreate) sqlSave(ctx context.Context) (*OIDCConfig, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OIDCConfig.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OIDCConfigCreate) createSpec() (*OIDCConfig, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OIDCConfig{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oidcconfig.Table, sqlgraph.NewFieldSpec(oidcconfig.FieldID, field.TypeString))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(oidcconfig.FieldTenantID, field.TypeString, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Issuer(); ok {
+		_spec.SetField(oidcconfig.FieldIssuer, field.TypeString, value)
+		_node.Issuer = value
+	}
+	if value, ok := _c.mutation.ClientID(); ok {
+		_spec.SetField(oidcconfig.FieldClientID, field.TypeString, value)
+		_node.ClientID = value
+	}
+	if value, ok := _c.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcconfig.FieldClientSecret, field.TypeString, value)
+		_node.ClientSecret = value
+	}
+	if value, ok := _c.mutation.AllowedDomains(); ok {
+		_spec.SetField(oidcconfig.FieldAllowedDomains, field.TypeJSON, value)
+		_node.AllowedDomains = value
+	}
+	if value, ok := _c.mutation.DefaultRole(); ok {
+		_spec.SetField(oidcconfig.FieldDefaultRole, field.TypeString, value)
+		_node.DefaultRole = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oidcconfig.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconfig.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OIDCConfigCreateBulk is the builder for creating many OIDCConfig entities in bulk.
+type OIDCConfigCreateBulk struct {
+	config
+	err      error
+	builders []*OIDCConfigCreate
+}
+
+// Save creates the OIDCConfig entities in the database.
+func (_c *OIDCConfigCreateBulk) Save(ctx context.Context) ([]*OIDCConfig, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OIDCConfig, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OIDCConfigMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OIDCConfigCreateBulk) SaveX(ctx context.Context) []*OIDCConfig {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OIDCConfigCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OIDCConfigCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"good-todo-go/internal/ent/oidcconfig"
+	"good-todo-go/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// OIDCConfigUpdate is the builder for updating OIDCConfig entities.
+type OIDCConfigUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OIDCConfigMutation
+}
+
+// Where appends a list predicates to the OIDCConfigUpdate builder.
+func (_u *OIDCConfigUpdate) Where(ps ...predicate.OIDCConfig) *OIDCConfigUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *OIDCConfigUpdate) SetIssuer(v string) *OIDCConfigUpdate {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *OIDCConfigUpdate) SetNillableIssuer(v *string) *OIDCConfigUpdate {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OIDCConfigUpdate) SetClientID(v string) *OIDCConfigUpdate {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OIDCConfigUpdate) SetNillableClientID(v *string) *OIDCConfigUpdate {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OIDCConfigUpdate) SetClientSecret(v string) *OIDCConfigUpdate {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OIDCConfigUpdate) SetNillableClientSecret(v *string) *OIDCConfigUpdate {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_u *OIDCConfigUpdate) SetAllowedDomains(v []string) *OIDCConfigUpdate {
+	_u.mutation.SetAllowedDomains(v)
+	return _u
+}
+
+// AppendAllowedDomains appends value to the "allowed_domains" field.
+func (_u *OIDCConfigUpdate) AppendAllowedDomains(v []string) *OIDCConfigUpdate {
+	_u.mutation.AppendAllowedDomains(v)
+	return _u
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_u *OIDCConfigUpdate) SetDefaultRole(v string) *OIDCConfigUpdate {
+	_u.mutation.SetDefaultRole(v)
+	return _u
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_u *OIDCConfigUpdate) SetNillableDefaultRole(v *string) *OIDCConfigUpdate {
+	if v != nil {
+		_u.SetDefaultRole(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OIDCConfigUpdate) SetUpdatedAt(v time.Time) *OIDCConfigUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OIDCConfigMutation object of the builder.
+func (_u *OIDCConfigUpdate) Mutation() *OIDCConfigMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OIDCConfigUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OIDCConfigUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OIDCConfigUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OIDCConfigUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OIDCConfigUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oidcconfig.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcconfig.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcconfig.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OIDCConfigUpdate) check() error {
+	if v, ok := _u.mutation.Issuer(); ok {
+		if err := oidcconfig.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.issuer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oidcconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := oidcconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OIDCConfigUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oidcconfig.Table, oidcconfig.Columns, sqlgraph.NewFieldSpec(oidcconfig.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(oidcconfig.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcconfig.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcconfig.FieldClientSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedDomains(); ok {
+		_spec.SetField(oidcconfig.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcconfig.FieldAllowedDomains, value)
+		})
+	}
+	if value, ok := _u.mutation.DefaultRole(); ok {
+		_spec.SetField(oidcconfig.FieldDefaultRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OIDCConfigUpdateOne is the builder for updating a single OIDCConfig entity.
+type OIDCConfigUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OIDCConfigMutation
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *OIDCConfigUpdateOne) SetIssuer(v string) *OIDCConfigUpdateOne {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *OIDCConfigUpdateOne) SetNillableIssuer(v *string) *OIDCConfigUpdateOne {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetClientID sets the "client_id" field.
+func (_u *OIDCConfigUpdateOne) SetClientID(v string) *OIDCConfigUpdateOne {
+	_u.mutation.SetClientID(v)
+	return _u
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (_u *OIDCConfigUpdateOne) SetNillableClientID(v *string) *OIDCConfigUpdateOne {
+	if v != nil {
+		_u.SetClientID(*v)
+	}
+	return _u
+}
+
+// SetClientSecret sets the "client_secret" field.
+func (_u *OIDCConfigUpdateOne) SetClientSecret(v string) *OIDCConfigUpdateOne {
+	_u.mutation.SetClientSecret(v)
+	return _u
+}
+
+// SetNillableClientSecret sets the "client_secret" field if the given value is not nil.
+func (_u *OIDCConfigUpdateOne) SetNillableClientSecret(v *string) *OIDCConfigUpdateOne {
+	if v != nil {
+		_u.SetClientSecret(*v)
+	}
+	return _u
+}
+
+// SetAllowedDomains sets the "allowed_domains" field.
+func (_u *OIDCConfigUpdateOne) SetAllowedDomains(v []string) *OIDCConfigUpdateOne {
+	_u.mutation.SetAllowedDomains(v)
+	return _u
+}
+
+// AppendAllowedDomains appends value to the "allowed_domains" field.
+func (_u *OIDCConfigUpdateOne) AppendAllowedDomains(v []string) *OIDCConfigUpdateOne {
+	_u.mutation.AppendAllowedDomains(v)
+	return _u
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_u *OIDCConfigUpdateOne) SetDefaultRole(v string) *OIDCConfigUpdateOne {
+	_u.mutation.SetDefaultRole(v)
+	return _u
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_u *OIDCConfigUpdateOne) SetNillableDefaultRole(v *string) *OIDCConfigUpdateOne {
+	if v != nil {
+		_u.SetDefaultRole(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OIDCConfigUpdateOne) SetUpdatedAt(v time.Time) *OIDCConfigUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the OIDCConfigMutation object of the builder.
+func (_u *OIDCConfigUpdateOne) Mutation() *OIDCConfigMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OIDCConfigUpdate builder.
+func (_u *OIDCConfigUpdateOne) Where(ps ...predicate.OIDCConfig) *OIDCConfigUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OIDCConfigUpdateOne) Select(field string, fields ...string) *OIDCConfigUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OIDCConfig entity.
+func (_u *OIDCConfigUpdateOne) Save(ctx context.Context) (*OIDCConfig, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OIDCConfigUpdateOne) SaveX(ctx context.Context) *OIDCConfig {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OIDCConfigUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OIDCConfigUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OIDCConfigUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if oidcconfig.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized oidcconfig.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := oidcconfig.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OIDCConfigUpdateOne) check() error {
+	if v, ok := _u.mutation.Issuer(); ok {
+		if err := oidcconfig.IssuerValidator(v); err != nil {
+			return &ValidationError{Name: "issuer", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.issuer": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientID(); ok {
+		if err := oidcconfig.ClientIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_id", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientSecret(); ok {
+		if err := oidcconfig.ClientSecretValidator(v); err != nil {
+			return &ValidationError{Name: "client_secret", err: fmt.Errorf(`ent: validator failed for field "OIDCConfig.client_secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *OIDCConfigUpdateOne) sqlSave(ctx context.Context) (_node *OIDCConfig, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oidcconfig.Table, oidcconfig.Columns, sqlgraph.NewFieldSpec(oidcconfig.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OIDCConfig.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oidcconfig.FieldID)
+		for _, f := range fields {
+			if !oidcconfig.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oidcconfig.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(oidcconfig.FieldIssuer, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientID(); ok {
+		_spec.SetField(oidcconfig.FieldClientID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientSecret(); ok {
+		_spec.SetField(oidcconfig.FieldClientSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AllowedDomains(); ok {
+		_spec.SetField(oidcconfig.FieldAllowedDomains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedDomains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oidcconfig.FieldAllowedDomains, value)
+		})
+	}
+	if value, ok := _u.mutation.DefaultRole(); ok {
+		_spec.SetField(oidcconfig.FieldDefaultRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(oidcconfig.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &OIDCConfig{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oidcconfig.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
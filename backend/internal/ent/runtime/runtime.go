@@ -2,7 +2,372 @@
 
 package runtime
 
-// The schema-stitching logic is generated in good-todo-go/internal/ent/runtime.go
+import (
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/idempotencykey"
+	"good-todo-go/internal/ent/loginattempt"
+	"good-todo-go/internal/ent/oidcconfig"
+	"good-todo-go/internal/ent/personalaccesstoken"
+	"good-todo-go/internal/ent/schema"
+	"good-todo-go/internal/ent/session"
+	"good-todo-go/internal/ent/tenant"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/user"
+	"time"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditeventHooks := schema.AuditEvent{}.Hooks()
+	auditevent.Hooks[0] = auditeventHooks[0]
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescTenantID is the schema descriptor for tenant_id field.
+	auditeventDescTenantID := auditeventFields[1].Descriptor()
+	// auditevent.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	auditevent.TenantIDValidator = auditeventDescTenantID.Validators[0].(func(string) error)
+	// auditeventDescEntityType is the schema descriptor for entity_type field.
+	auditeventDescEntityType := auditeventFields[4].Descriptor()
+	// auditevent.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditevent.EntityTypeValidator = auditeventDescEntityType.Validators[0].(func(string) error)
+	// auditeventDescEntityID is the schema descriptor for entity_id field.
+	auditeventDescEntityID := auditeventFields[5].Descriptor()
+	// auditevent.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	auditevent.EntityIDValidator = auditeventDescEntityID.Validators[0].(func(string) error)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[10].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() string)
+	// auditevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditevent.IDValidator = auditeventDescID.Validators[0].(func(string) error)
+	idempotencykeyFields := schema.IdempotencyKey{}.Fields()
+	_ = idempotencykeyFields
+	// idempotencykeyDescTenantID is the schema descriptor for tenant_id field.
+	idempotencykeyDescTenantID := idempotencykeyFields[1].Descriptor()
+	// idempotencykey.DefaultTenantID holds the default value on creation for the tenant_id field.
+	idempotencykey.DefaultTenantID = idempotencykeyDescTenantID.Default.(string)
+	// idempotencykeyDescUserID is the schema descriptor for user_id field.
+	idempotencykeyDescUserID := idempotencykeyFields[2].Descriptor()
+	// idempotencykey.DefaultUserID holds the default value on creation for the user_id field.
+	idempotencykey.DefaultUserID = idempotencykeyDescUserID.Default.(string)
+	// idempotencykeyDescKey is the schema descriptor for key field.
+	idempotencykeyDescKey := idempotencykeyFields[3].Descriptor()
+	// idempotencykey.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	idempotencykey.KeyValidator = func() func(string) error {
+		validators := idempotencykeyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// idempotencykeyDescRequestHash is the schema descriptor for request_hash field.
+	idempotencykeyDescRequestHash := idempotencykeyFields[4].Descriptor()
+	// idempotencykey.RequestHashValidator is a validator for the "request_hash" field. It is called by the builders before save.
+	idempotencykey.RequestHashValidator = idempotencykeyDescRequestHash.Validators[0].(func(string) error)
+	// idempotencykeyDescCreatedAt is the schema descriptor for created_at field.
+	idempotencykeyDescCreatedAt := idempotencykeyFields[8].Descriptor()
+	// idempotencykey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	idempotencykey.DefaultCreatedAt = idempotencykeyDescCreatedAt.Default.(func() time.Time)
+	// idempotencykeyDescID is the schema descriptor for id field.
+	idempotencykeyDescID := idempotencykeyFields[0].Descriptor()
+	// idempotencykey.DefaultID holds the default value on creation for the id field.
+	idempotencykey.DefaultID = idempotencykeyDescID.Default.(func() string)
+	// idempotencykey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	idempotencykey.IDValidator = idempotencykeyDescID.Validators[0].(func(string) error)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescTenantID is the schema descriptor for tenant_id field.
+	loginattemptDescTenantID := loginattemptFields[1].Descriptor()
+	// loginattempt.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	loginattempt.TenantIDValidator = loginattemptDescTenantID.Validators[0].(func(string) error)
+	// loginattemptDescEmail is the schema descriptor for email field.
+	loginattemptDescEmail := loginattemptFields[2].Descriptor()
+	// loginattempt.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	loginattempt.EmailValidator = loginattemptDescEmail.Validators[0].(func(string) error)
+	// loginattemptDescIPAddress is the schema descriptor for ip_address field.
+	loginattemptDescIPAddress := loginattemptFields[3].Descriptor()
+	// loginattempt.DefaultIPAddress holds the default value on creation for the ip_address field.
+	loginattempt.DefaultIPAddress = loginattemptDescIPAddress.Default.(string)
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[5].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
+	// loginattemptDescID is the schema descriptor for id field.
+	loginattemptDescID := loginattemptFields[0].Descriptor()
+	// loginattempt.DefaultID holds the default value on creation for the id field.
+	loginattempt.DefaultID = loginattemptDescID.Default.(func() string)
+	// loginattempt.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	loginattempt.IDValidator = loginattemptDescID.Validators[0].(func(string) error)
+	oidcconfigMixin := schema.OIDCConfig{}.Mixin()
+	oidcconfigMixinHooks0 := oidcconfigMixin[0].Hooks()
+	oidcconfig.Hooks[0] = oidcconfigMixinHooks0[0]
+	oidcconfigFields := schema.OIDCConfig{}.Fields()
+	_ = oidcconfigFields
+	// oidcconfigDescTenantID is the schema descriptor for tenant_id field.
+	oidcconfigDescTenantID := oidcconfigFields[1].Descriptor()
+	// oidcconfig.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	oidcconfig.TenantIDValidator = oidcconfigDescTenantID.Validators[0].(func(string) error)
+	// oidcconfigDescIssuer is the schema descriptor for issuer field.
+	oidcconfigDescIssuer := oidcconfigFields[2].Descriptor()
+	// oidcconfig.IssuerValidator is a validator for the "issuer" field. It is called by the builders before save.
+	oidcconfig.IssuerValidator = oidcconfigDescIssuer.Validators[0].(func(string) error)
+	// oidcconfigDescClientID is the schema descriptor for client_id field.
+	oidcconfigDescClientID := oidcconfigFields[3].Descriptor()
+	// oidcconfig.ClientIDValidator is a validator for the "client_id" field. It is called by the builders before save.
+	oidcconfig.ClientIDValidator = oidcconfigDescClientID.Validators[0].(func(string) error)
+	// oidcconfigDescClientSecret is the schema descriptor for client_secret field.
+	oidcconfigDescClientSecret := oidcconfigFields[4].Descriptor()
+	// oidcconfig.ClientSecretValidator is a validator for the "client_secret" field. It is called by the builders before save.
+	oidcconfig.ClientSecretValidator = oidcconfigDescClientSecret.Validators[0].(func(string) error)
+	// oidcconfigDescDefaultRole is the schema descriptor for default_role field.
+	oidcconfigDescDefaultRole := oidcconfigFields[6].Descriptor()
+	// oidcconfig.DefaultDefaultRole holds the default value on creation for the default_role field.
+	oidcconfig.DefaultDefaultRole = oidcconfigDescDefaultRole.Default.(string)
+	// oidcconfigDescCreatedAt is the schema descriptor for created_at field.
+	oidcconfigDescCreatedAt := oidcconfigFields[7].Descriptor()
+	// oidcconfig.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oidcconfig.DefaultCreatedAt = oidcconfigDescCreatedAt.Default.(func() time.Time)
+	// oidcconfigDescUpdatedAt is the schema descriptor for updated_at field.
+	oidcconfigDescUpdatedAt := oidcconfigFields[8].Descriptor()
+	// oidcconfig.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oidcconfig.DefaultUpdatedAt = oidcconfigDescUpdatedAt.Default.(func() time.Time)
+	// oidcconfig.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oidcconfig.UpdateDefaultUpdatedAt = oidcconfigDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oidcconfigDescID is the schema descriptor for id field.
+	oidcconfigDescID := oidcconfigFields[0].Descriptor()
+	// oidcconfig.DefaultID holds the default value on creation for the id field.
+	oidcconfig.DefaultID = oidcconfigDescID.Default.(func() string)
+	// oidcconfig.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	oidcconfig.IDValidator = oidcconfigDescID.Validators[0].(func(string) error)
+	personalaccesstokenMixin := schema.PersonalAccessToken{}.Mixin()
+	personalaccesstokenMixinHooks0 := personalaccesstokenMixin[0].Hooks()
+	personalaccesstoken.Hooks[0] = personalaccesstokenMixinHooks0[0]
+	personalaccesstokenFields := schema.PersonalAccessToken{}.Fields()
+	_ = personalaccesstokenFields
+	// personalaccesstokenDescTenantID is the schema descriptor for tenant_id field.
+	personalaccesstokenDescTenantID := personalaccesstokenFields[1].Descriptor()
+	// personalaccesstoken.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	personalaccesstoken.TenantIDValidator = personalaccesstokenDescTenantID.Validators[0].(func(string) error)
+	// personalaccesstokenDescUserID is the schema descriptor for user_id field.
+	personalaccesstokenDescUserID := personalaccesstokenFields[2].Descriptor()
+	// personalaccesstoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	personalaccesstoken.UserIDValidator = personalaccesstokenDescUserID.Validators[0].(func(string) error)
+	// personalaccesstokenDescName is the schema descriptor for name field.
+	personalaccesstokenDescName := personalaccesstokenFields[3].Descriptor()
+	// personalaccesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	personalaccesstoken.NameValidator = personalaccesstokenDescName.Validators[0].(func(string) error)
+	// personalaccesstokenDescTokenHash is the schema descriptor for token_hash field.
+	personalaccesstokenDescTokenHash := personalaccesstokenFields[4].Descriptor()
+	// personalaccesstoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	personalaccesstoken.TokenHashValidator = personalaccesstokenDescTokenHash.Validators[0].(func(string) error)
+	// personalaccesstokenDescCreatedAt is the schema descriptor for created_at field.
+	personalaccesstokenDescCreatedAt := personalaccesstokenFields[8].Descriptor()
+	// personalaccesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	personalaccesstoken.DefaultCreatedAt = personalaccesstokenDescCreatedAt.Default.(func() time.Time)
+	// personalaccesstokenDescID is the schema descriptor for id field.
+	personalaccesstokenDescID := personalaccesstokenFields[0].Descriptor()
+	// personalaccesstoken.DefaultID holds the default value on creation for the id field.
+	personalaccesstoken.DefaultID = personalaccesstokenDescID.Default.(func() string)
+	// personalaccesstoken.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	personalaccesstoken.IDValidator = personalaccesstokenDescID.Validators[0].(func(string) error)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescTenantID is the schema descriptor for tenant_id field.
+	sessionDescTenantID := sessionFields[1].Descriptor()
+	// session.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	session.TenantIDValidator = sessionDescTenantID.Validators[0].(func(string) error)
+	// sessionDescUserID is the schema descriptor for user_id field.
+	sessionDescUserID := sessionFields[2].Descriptor()
+	// session.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	session.UserIDValidator = sessionDescUserID.Validators[0].(func(string) error)
+	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
+	sessionDescRefreshTokenHash := sessionFields[3].Descriptor()
+	// session.RefreshTokenHashValidator is a validator for the "refresh_token_hash" field. It is called by the builders before save.
+	session.RefreshTokenHashValidator = sessionDescRefreshTokenHash.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[4].Descriptor()
+	// session.DefaultUserAgent holds the default value on creation for the user_agent field.
+	session.DefaultUserAgent = sessionDescUserAgent.Default.(string)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[5].Descriptor()
+	// session.DefaultIPAddress holds the default value on creation for the ip_address field.
+	session.DefaultIPAddress = sessionDescIPAddress.Default.(string)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.DefaultID holds the default value on creation for the id field.
+	session.DefaultID = sessionDescID.Default.(func() string)
+	// session.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	session.IDValidator = sessionDescID.Validators[0].(func(string) error)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinHooks0 := tenantMixin[0].Hooks()
+	tenant.Hooks[0] = tenantMixinHooks0[0]
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[1].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
+	// tenantDescSlug is the schema descriptor for slug field.
+	tenantDescSlug := tenantFields[2].Descriptor()
+	// tenant.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tenant.SlugValidator = tenantDescSlug.Validators[0].(func(string) error)
+	// tenantDescTrashRetentionDays is the schema descriptor for trash_retention_days field.
+	tenantDescTrashRetentionDays := tenantFields[3].Descriptor()
+	// tenant.TrashRetentionDaysValidator is a validator for the "trash_retention_days" field. It is called by the builders before save.
+	tenant.TrashRetentionDaysValidator = tenantDescTrashRetentionDays.Validators[0].(func(int) error)
+	// tenantDescRateLimitPerMinute is the schema descriptor for rate_limit_per_minute field.
+	tenantDescRateLimitPerMinute := tenantFields[4].Descriptor()
+	// tenant.RateLimitPerMinuteValidator is a validator for the "rate_limit_per_minute" field. It is called by the builders before save.
+	tenant.RateLimitPerMinuteValidator = tenantDescRateLimitPerMinute.Validators[0].(func(int) error)
+	// tenantDescRequireMfa is the schema descriptor for require_mfa field.
+	tenantDescRequireMfa := tenantFields[5].Descriptor()
+	// tenant.DefaultRequireMfa holds the default value on creation for the require_mfa field.
+	tenant.DefaultRequireMfa = tenantDescRequireMfa.Default.(bool)
+	// tenantDescPasswordMinLength is the schema descriptor for password_min_length field.
+	tenantDescPasswordMinLength := tenantFields[7].Descriptor()
+	// tenant.PasswordMinLengthValidator is a validator for the "password_min_length" field. It is called by the builders before save.
+	tenant.PasswordMinLengthValidator = tenantDescPasswordMinLength.Validators[0].(func(int) error)
+	// tenantDescPasswordMinCharacterClasses is the schema descriptor for password_min_character_classes field.
+	tenantDescPasswordMinCharacterClasses := tenantFields[8].Descriptor()
+	// tenant.DefaultPasswordMinCharacterClasses holds the default value on creation for the password_min_character_classes field.
+	tenant.DefaultPasswordMinCharacterClasses = tenantDescPasswordMinCharacterClasses.Default.(int)
+	// tenant.PasswordMinCharacterClassesValidator is a validator for the "password_min_character_classes" field. It is called by the builders before save.
+	tenant.PasswordMinCharacterClassesValidator = tenantDescPasswordMinCharacterClasses.Validators[0].(func(int) error)
+	// tenantDescPasswordHistory is the schema descriptor for password_history field.
+	tenantDescPasswordHistory := tenantFields[9].Descriptor()
+	// tenant.DefaultPasswordHistory holds the default value on creation for the password_history field.
+	tenant.DefaultPasswordHistory = tenantDescPasswordHistory.Default.(int)
+	// tenant.PasswordHistoryValidator is a validator for the "password_history" field. It is called by the builders before save.
+	tenant.PasswordHistoryValidator = tenantDescPasswordHistory.Validators[0].(func(int) error)
+	// tenantDescCreatedAt is the schema descriptor for created_at field.
+	tenantDescCreatedAt := tenantFields[10].Descriptor()
+	// tenant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tenant.DefaultCreatedAt = tenantDescCreatedAt.Default.(func() time.Time)
+	// tenantDescUpdatedAt is the schema descriptor for updated_at field.
+	tenantDescUpdatedAt := tenantFields[11].Descriptor()
+	// tenant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tenant.DefaultUpdatedAt = tenantDescUpdatedAt.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tenant.UpdateDefaultUpdatedAt = tenantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantFields[0].Descriptor()
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = tenantDescID.Validators[0].(func(string) error)
+	todoMixin := schema.Todo{}.Mixin()
+	todoMixinHooks0 := todoMixin[0].Hooks()
+	todoMixinHooks1 := todoMixin[1].Hooks()
+	todo.Hooks[0] = todoMixinHooks0[0]
+	todo.Hooks[1] = todoMixinHooks1[0]
+	todoMixinInters0 := todoMixin[0].Interceptors()
+	todo.Interceptors[0] = todoMixinInters0[0]
+	todoFields := schema.Todo{}.Fields()
+	_ = todoFields
+	// todoDescTenantID is the schema descriptor for tenant_id field.
+	todoDescTenantID := todoFields[1].Descriptor()
+	// todo.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	todo.TenantIDValidator = todoDescTenantID.Validators[0].(func(string) error)
+	// todoDescUserID is the schema descriptor for user_id field.
+	todoDescUserID := todoFields[2].Descriptor()
+	// todo.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	todo.UserIDValidator = todoDescUserID.Validators[0].(func(string) error)
+	// todoDescTitle is the schema descriptor for title field.
+	todoDescTitle := todoFields[3].Descriptor()
+	// todo.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	todo.TitleValidator = todoDescTitle.Validators[0].(func(string) error)
+	// todoDescDescription is the schema descriptor for description field.
+	todoDescDescription := todoFields[4].Descriptor()
+	// todo.DefaultDescription holds the default value on creation for the description field.
+	todo.DefaultDescription = todoDescDescription.Default.(string)
+	// todoDescCompleted is the schema descriptor for completed field.
+	todoDescCompleted := todoFields[5].Descriptor()
+	// todo.DefaultCompleted holds the default value on creation for the completed field.
+	todo.DefaultCompleted = todoDescCompleted.Default.(bool)
+	// todoDescIsPublic is the schema descriptor for is_public field.
+	todoDescIsPublic := todoFields[6].Descriptor()
+	// todo.DefaultIsPublic holds the default value on creation for the is_public field.
+	todo.DefaultIsPublic = todoDescIsPublic.Default.(bool)
+	// todoDescVersion is the schema descriptor for version field.
+	todoDescVersion := todoFields[9].Descriptor()
+	// todo.DefaultVersion holds the default value on creation for the version field.
+	todo.DefaultVersion = todoDescVersion.Default.(int)
+	// todo.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	todo.VersionValidator = todoDescVersion.Validators[0].(func(int) error)
+	// todoDescCreatedAt is the schema descriptor for created_at field.
+	todoDescCreatedAt := todoFields[10].Descriptor()
+	// todo.DefaultCreatedAt holds the default value on creation for the created_at field.
+	todo.DefaultCreatedAt = todoDescCreatedAt.Default.(func() time.Time)
+	// todoDescUpdatedAt is the schema descriptor for updated_at field.
+	todoDescUpdatedAt := todoFields[11].Descriptor()
+	// todo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	todo.DefaultUpdatedAt = todoDescUpdatedAt.Default.(func() time.Time)
+	// todo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	todo.UpdateDefaultUpdatedAt = todoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// todoDescID is the schema descriptor for id field.
+	todoDescID := todoFields[0].Descriptor()
+	// todo.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	todo.IDValidator = todoDescID.Validators[0].(func(string) error)
+	userMixin := schema.User{}.Mixin()
+	userMixinHooks0 := userMixin[0].Hooks()
+	user.Hooks[0] = userMixinHooks0[0]
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescTenantID is the schema descriptor for tenant_id field.
+	userDescTenantID := userFields[1].Descriptor()
+	// user.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	user.TenantIDValidator = userDescTenantID.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[2].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescPasswordHash is the schema descriptor for password_hash field.
+	userDescPasswordHash := userFields[3].Descriptor()
+	// user.PasswordHashValidator is a validator for the "password_hash" field. It is called by the builders before save.
+	user.PasswordHashValidator = userDescPasswordHash.Validators[0].(func(string) error)
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[4].Descriptor()
+	// user.DefaultName holds the default value on creation for the name field.
+	user.DefaultName = userDescName.Default.(string)
+	// userDescEmailVerified is the schema descriptor for email_verified field.
+	userDescEmailVerified := userFields[6].Descriptor()
+	// user.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	user.DefaultEmailVerified = userDescEmailVerified.Default.(bool)
+	// userDescMfaEnabled is the schema descriptor for mfa_enabled field.
+	userDescMfaEnabled := userFields[10].Descriptor()
+	// user.DefaultMfaEnabled holds the default value on creation for the mfa_enabled field.
+	user.DefaultMfaEnabled = userDescMfaEnabled.Default.(bool)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[20].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[21].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"good-todo-go/internal/ent/hook"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity.
+// Events are append-only and written by the AuditMixin hook.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("actor_id").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User who made the change. Nil for unauthenticated requests such as registration"),
+		field.Enum("action").
+			Values("create", "update", "delete", "soft_delete", "restore").
+			Immutable(),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable(),
+		field.String("entity_id").
+			NotEmpty().
+			Immutable(),
+		field.JSON("before", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields before the mutation. Nil on create"),
+		field.JSON("after", map[string]any{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields after the mutation. Nil on delete"),
+		field.String("request_id").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Hooks of the AuditEvent.
+func (AuditEvent) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Audit events are append-only
+		hook.On(
+			func(ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+					return nil, errors.New("audit events are append-only")
+				})
+			},
+			ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne,
+		),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "created_at"),
+		index.Fields("tenant_id", "entity_type", "entity_id"),
+		index.Fields("tenant_id", "actor_id"),
+	}
+}
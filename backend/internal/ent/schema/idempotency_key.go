@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey entity.
+// It remembers the response to a request sent with an Idempotency-Key header
+// so a retry gets the same response instead of running the request again.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			Default("").
+			Immutable().
+			Comment("Empty for unauthenticated requests such as registration"),
+		field.String("user_id").
+			Default("").
+			Immutable().
+			Comment("Empty for unauthenticated requests such as registration"),
+		field.String("key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.String("request_hash").
+			NotEmpty().
+			Immutable().
+			Comment("SHA-256 of method, path and body. A retry must match it"),
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Comment("Nil while the first request is still being processed"),
+		field.JSON("response_headers", map[string]string{}).
+			Optional(),
+		field.Bytes("response_body").
+			Optional(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Indexes of the IdempotencyKey.
+func (IdempotencyKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id", "key").
+			Unique(),
+		index.Fields("tenant_id", "expires_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LoginAttempt holds the schema definition for the LoginAttempt entity.
+// Every password login is recorded, including those for unknown emails,
+// so repeated failures can be throttled per email and per client IP.
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Fields of the LoginAttempt.
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("email").
+			NotEmpty().
+			Immutable(),
+		field.String("ip_address").
+			Default("").
+			Immutable(),
+		field.Bool("succeeded").
+			Immutable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Indexes of the LoginAttempt.
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "email", "created_at"),
+		index.Fields("tenant_id", "ip_address", "created_at"),
+	}
+}
@@ -0,0 +1,269 @@
+package mixin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	gen "good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/oidcconfig"
+	"good-todo-go/internal/ent/personalaccesstoken"
+	"good-todo-go/internal/ent/tenant"
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/ent/user"
+	"good-todo-go/internal/pkg/audit"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/mixin"
+	"github.com/lib/pq"
+)
+
+// AuditMixin writes an AuditEvent for every row changed by a mutation.
+// The event is created through the mutation's client, so it shares the
+// tenant transaction of the change it describes.
+type AuditMixin struct {
+	mixin.Schema
+}
+
+// auditTables maps mutation types to their tables
+var auditTables = map[string]string{
+	gen.TypeTenant:              tenant.Table,
+	gen.TypeUser:                user.Table,
+	gen.TypeTodo:                todo.Table,
+	gen.TypeOIDCConfig:          oidcconfig.Table,
+	gen.TypePersonalAccessToken: personalaccesstoken.Table,
+}
+
+// redactedColumns are never written to the audit log: secrets, and personal data,
+// which could not be erased from the append-only log when the user deletes their account.
+// The log still shows that such a column changed, and by whom
+var redactedColumns = map[string]bool{
+	user.FieldEmail:                    true,
+	user.FieldName:                     true,
+	user.FieldPendingEmail:             true,
+	todo.FieldTitle:                    true,
+	todo.FieldDescription:              true,
+	user.FieldPasswordHash:             true,
+	user.FieldVerificationToken:        true,
+	user.FieldMfaSecret:                true,
+	user.FieldMfaRecoveryCodeHashes:    true,
+	user.FieldEmailChangeTokenHash:     true,
+	user.FieldDeletionCodeHash:         true,
+	user.FieldPasswordHistoryHashes:    true,
+	oidcconfig.FieldClientSecret:       true,
+	personalaccesstoken.FieldTokenHash: true,
+}
+
+// ignoredColumns change on every write and are left out of diffs
+var ignoredColumns = map[string]bool{
+	"updated_at": true,
+	// personal access tokens are touched on use
+	"last_used_at": true,
+}
+
+const redacted = "[REDACTED]"
+
+type auditMutation interface {
+	ent.Mutation
+	Client() *gen.Client
+	ID() (string, bool)
+	IDs(ctx context.Context) ([]string, error)
+}
+
+// Hooks of the AuditMixin.
+func (AuditMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				mx, ok := m.(auditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				table, ok := auditTables[m.Type()]
+				if !ok {
+					return nil, fmt.Errorf("no audit table for %s", m.Type())
+				}
+
+				var (
+					ids    []string
+					before map[string]map[string]any
+					err    error
+				)
+				if !m.Op().Is(ent.OpCreate) {
+					if ids, err = mx.IDs(ctx); err != nil {
+						return nil, fmt.Errorf("audit: %w", err)
+					}
+					if before, err = snapshot(ctx, mx.Client(), table, ids); err != nil {
+						return nil, fmt.Errorf("audit: %w", err)
+					}
+				}
+
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+
+				if m.Op().Is(ent.OpCreate) {
+					id, _ := mx.ID()
+					ids = []string{id}
+				}
+				var after map[string]map[string]any
+				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if after, err = snapshot(ctx, mx.Client(), table, ids); err != nil {
+						return nil, fmt.Errorf("audit: %w", err)
+					}
+				}
+
+				info := audit.FromContext(ctx)
+				for _, id := range ids {
+					if err := writeAuditEvent(ctx, mx.Client(), m.Type(), id, before[id], after[id], info); err != nil {
+						return nil, fmt.Errorf("audit: %w", err)
+					}
+				}
+				return v, nil
+			})
+		},
+	}
+}
+
+// writeAuditEvent stores the diff between two snapshots of a row
+func writeAuditEvent(ctx context.Context, client *gen.Client, entityType, entityID string, before, after map[string]any, info audit.Info) error {
+	if before == nil && after == nil {
+		return nil
+	}
+
+	action := auditevent.ActionUpdate
+	row := after
+	switch {
+	case before == nil:
+		action = auditevent.ActionCreate
+	case after == nil:
+		action = auditevent.ActionDelete
+		row = before
+	}
+
+	if before != nil && after != nil {
+		before, after = diff(before, after)
+		if len(after) == 0 {
+			// Nothing but bookkeeping columns changed
+			return nil
+		}
+		if _, ok := after["deleted_at"]; ok && len(after) == 1 {
+			if after["deleted_at"] == nil {
+				action = auditevent.ActionRestore
+			} else {
+				action = auditevent.ActionSoftDelete
+			}
+		}
+	}
+
+	tenantID, _ := row["tenant_id"].(string)
+	if entityType == gen.TypeTenant {
+		tenantID = entityID
+	}
+
+	builder := client.AuditEvent.Create().
+		SetTenantID(tenantID).
+		SetAction(action).
+		SetEntityType(entityType).
+		SetEntityID(entityID).
+		SetNillableActorID(nonEmpty(info.ActorID)).
+		SetNillableRequestID(nonEmpty(info.RequestID)).
+		SetNillableIP(nonEmpty(info.IP))
+	if before != nil {
+		builder.SetBefore(redact(before))
+	}
+	if after != nil {
+		builder.SetAfter(redact(after))
+	}
+	return builder.Exec(ctx)
+}
+
+// snapshot reads the raw rows so soft-deleted rows and every column are included
+func snapshot(ctx context.Context, client *gen.Client, table string, ids []string) (map[string]map[string]any, error) {
+	rows := make(map[string]map[string]any, len(ids))
+	if len(ids) == 0 {
+		return rows, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+	query := fmt.Sprintf("SELECT * FROM %s WHERE id IN (%s)", pq.QuoteIdentifier(table), strings.Join(placeholders, ", "))
+
+	result, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer result.Close()
+
+	columns, err := result.Columns()
+	if err != nil {
+		return nil, err
+	}
+	for result.Next() {
+		values := make([]any, len(columns))
+		ptrs := make([]any, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := result.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+
+		row := make(map[string]any, len(columns))
+		for i, col := range columns {
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row[col] = values[i]
+		}
+		id, _ := row["id"].(string)
+		rows[id] = row
+	}
+	return rows, result.Err()
+}
+
+// diff keeps only the columns whose value changed
+func diff(before, after map[string]any) (map[string]any, map[string]any) {
+	b := make(map[string]any)
+	a := make(map[string]any)
+	for col, v := range after {
+		if ignoredColumns[col] || equal(before[col], v) {
+			continue
+		}
+		b[col] = before[col]
+		a[col] = v
+	}
+	return b, a
+}
+
+func equal(a, b any) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func redact(row map[string]any) map[string]any {
+	for col := range row {
+		if redactedColumns[col] && row[col] != nil {
+			row[col] = redacted
+		}
+	}
+	return row
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"good-todo-go/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// OIDCConfig holds the schema definition for the OIDCConfig entity.
+// A tenant with a config can sign in through its own OpenID Connect provider;
+// users are created on their first sign-in.
+type OIDCConfig struct {
+	ent.Schema
+}
+
+// Mixin of the OIDCConfig.
+func (OIDCConfig) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+	}
+}
+
+// Fields of the OIDCConfig.
+func (OIDCConfig) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable().
+			Unique(),
+		field.String("issuer").
+			NotEmpty(),
+		field.String("client_id").
+			NotEmpty(),
+		field.String("client_secret").
+			NotEmpty().
+			Sensitive(),
+		field.Strings("allowed_domains").
+			Comment("Email domains that may sign in. Users from other domains are refused"),
+		field.String("default_role").
+			Default("member").
+			Comment("Role given to users created on their first sign-in"),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("updated_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			UpdateDefault(func() time.Time {
+				return time.Now().UTC()
+			}),
+	}
+}
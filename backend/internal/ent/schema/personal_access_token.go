@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"good-todo-go/internal/ent/schema/mixin"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PersonalAccessToken holds the schema definition for the PersonalAccessToken entity.
+// A token lets scripts call the API as a user without their password. Only a
+// hash of the token is stored; the token itself is shown once on creation.
+type PersonalAccessToken struct {
+	ent.Schema
+}
+
+// Mixin of the PersonalAccessToken.
+func (PersonalAccessToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+	}
+}
+
+// Fields of the PersonalAccessToken.
+func (PersonalAccessToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.String("token_hash").
+			NotEmpty().
+			Immutable().
+			Unique().
+			Sensitive().
+			Comment("SHA-256 of the token"),
+		field.Strings("scopes").
+			Comment("What the token may do, such as read:todos"),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+	}
+}
+
+// Indexes of the PersonalAccessToken.
+func (PersonalAccessToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Session holds the schema definition for the Session entity.
+// A session starts at login and lives as long as its refresh token. Only the
+// latest refresh token is valid, so revoking the session ends it at the next refresh.
+type Session struct {
+	ent.Schema
+}
+
+// Fields of the Session.
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			NotEmpty().
+			Immutable().
+			DefaultFunc(func() string {
+				return uuid.New().String()
+			}),
+		field.String("tenant_id").
+			NotEmpty().
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			Immutable(),
+		field.String("refresh_token_hash").
+			NotEmpty().
+			Sensitive().
+			Comment("SHA-256 of the latest refresh token; older ones are rejected"),
+		field.String("user_agent").
+			Default(""),
+		field.String("ip_address").
+			Default(""),
+		field.Time("created_at").
+			Default(func() time.Time {
+				return time.Now().UTC()
+			}).
+			Immutable(),
+		field.Time("last_used_at"),
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the Session.
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id"),
+	}
+}
@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"good-todo-go/internal/ent/schema/mixin"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -13,6 +15,13 @@ type Tenant struct {
 	ent.Schema
 }
 
+// Mixin of the Tenant.
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+	}
+}
+
 // Fields of the Tenant.
 func (Tenant) Fields() []ent.Field {
 	return []ent.Field{
@@ -24,6 +33,36 @@ func (Tenant) Fields() []ent.Field {
 		field.String("slug").
 			NotEmpty().
 			Unique(),
+		field.Int("trash_retention_days").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Days before soft-deleted todos are purged. Falls back to TRASH_RETENTION_DAYS when nil"),
+		field.Int("rate_limit_per_minute").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Requests per minute allowed for each user of the tenant. Falls back to RATE_LIMIT_USER_PER_MINUTE when nil"),
+		field.Bool("require_mfa").
+			Default(false).
+			Comment("Members must enroll in two-factor authentication to log in"),
+		field.Enum("deleted_user_public_todos").
+			Values("delete", "reassign").
+			Default("delete").
+			Comment("What happens to the public todos of a user who deletes their account. reassign gives them to the oldest admin"),
+		field.Int("password_min_length").
+			Optional().
+			Nillable().
+			Range(8, 72).
+			Comment("Minimum password length. Falls back to PASSWORD_MIN_LENGTH when nil"),
+		field.Int("password_min_character_classes").
+			Default(0).
+			Range(0, 4).
+			Comment("How many of lowercase, uppercase, digits and symbols a password must contain"),
+		field.Int("password_history").
+			Default(0).
+			Range(0, 24).
+			Comment("Number of previous passwords that cannot be reused"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
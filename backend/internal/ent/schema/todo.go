@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"good-todo-go/internal/ent/schema/mixin"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -14,6 +16,14 @@ type Todo struct {
 	ent.Schema
 }
 
+// Mixin of the Todo.
+func (Todo) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.SoftDeleteMixin{},
+		mixin.AuditMixin{},
+	}
+}
+
 // Fields of the Todo.
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
@@ -25,7 +35,7 @@ func (Todo) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			NotEmpty().
-			Immutable(),
+			Comment("Changes only when the public todos of a deleted account are reassigned"),
 		field.String("title").
 			NotEmpty(),
 		field.Text("description").
@@ -42,6 +52,10 @@ func (Todo) Fields() []ent.Field {
 		field.Time("completed_at").
 			Optional().
 			Nillable(),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("Incremented on every update for optimistic concurrency control (ETag)"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
@@ -64,8 +78,7 @@ func (Todo) Edges() []ent.Edge {
 			Ref("todos").
 			Field("user_id").
 			Required().
-			Unique().
-			Immutable(),
+			Unique(),
 	}
 }
 
@@ -76,5 +89,6 @@ func (Todo) Indexes() []ent.Index {
 		index.Fields("user_id"),
 		index.Fields("tenant_id", "user_id"),
 		index.Fields("tenant_id", "is_public"),
+		index.Fields("tenant_id", "deleted_at"),
 	}
 }
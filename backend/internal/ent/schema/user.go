@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"good-todo-go/internal/ent/schema/mixin"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -14,6 +16,13 @@ type User struct {
 	ent.Schema
 }
 
+// Mixin of the User.
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AuditMixin{},
+	}
+}
+
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
@@ -41,6 +50,49 @@ func (User) Fields() []ent.Field {
 		field.Time("verification_token_expires_at").
 			Optional().
 			Nillable(),
+		field.Time("locked_until").
+			Optional().
+			Nillable().
+			Comment("Set after too many failed logins. Logins are refused until then"),
+		field.Bool("mfa_enabled").
+			Default(false),
+		field.String("mfa_secret").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("TOTP secret. Set during enrollment, before mfa_enabled"),
+		field.Int64("mfa_last_used_step").
+			Optional().
+			Nillable().
+			Comment("TOTP time step of the last accepted code, so a code cannot be replayed"),
+		field.Strings("mfa_recovery_code_hashes").
+			Optional().
+			Sensitive().
+			Comment("SHA-256 of the unused recovery codes"),
+		field.String("pending_email").
+			Optional().
+			Nillable().
+			Comment("New address waiting for confirmation. email changes only once it is confirmed"),
+		field.String("email_change_token_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("SHA-256 of the token mailed to pending_email"),
+		field.Time("email_change_expires_at").
+			Optional().
+			Nillable(),
+		field.String("deletion_code_hash").
+			Optional().
+			Nillable().
+			Sensitive().
+			Comment("SHA-256 of the code mailed to confirm deleting the account"),
+		field.Time("deletion_code_expires_at").
+			Optional().
+			Nillable(),
+		field.Strings("password_history_hashes").
+			Optional().
+			Sensitive().
+			Comment("Hashes of previous passwords, newest first, kept for the tenant's reuse policy"),
 		field.Time("created_at").
 			Default(func() time.Time {
 				return time.Now().UTC()
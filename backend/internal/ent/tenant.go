@@ -21,6 +21,20 @@ type Tenant struct {
 	Name string `json:"name,omitempty"`
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
+	// Days before soft-deleted todos are purged. Falls back to TRASH_RETENTION_DAYS when nil
+	TrashRetentionDays *int `json:"trash_retention_days,omitempty"`
+	// Requests per minute allowed for each user of the tenant. Falls back to RATE_LIMIT_USER_PER_MINUTE when nil
+	RateLimitPerMinute *int `json:"rate_limit_per_minute,omitempty"`
+	// Members must enroll in two-factor authentication to log in
+	RequireMfa bool `json:"require_mfa,omitempty"`
+	// What happens to the public todos of a user who deletes their account. reassign gives them to the oldest admin
+	DeletedUserPublicTodos tenant.DeletedUserPublicTodos `json:"deleted_user_public_todos,omitempty"`
+	// Minimum password length. Falls back to PASSWORD_MIN_LENGTH when nil
+	PasswordMinLength *int `json:"password_min_length,omitempty"`
+	// How many of lowercase, uppercase, digits and symbols a password must contain
+	PasswordMinCharacterClasses int `json:"password_min_character_classes,omitempty"`
+	// Number of previous passwords that cannot be reused
+	PasswordHistory int `json:"password_history,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -54,7 +68,11 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tenant.FieldID, tenant.FieldName, tenant.FieldSlug:
+		case tenant.FieldRequireMfa:
+			values[i] = new(sql.NullBool)
+		case tenant.FieldTrashRetentionDays, tenant.FieldRateLimitPerMinute, tenant.FieldPasswordMinLength, tenant.FieldPasswordMinCharacterClasses, tenant.FieldPasswordHistory:
+			values[i] = new(sql.NullInt64)
+		case tenant.FieldID, tenant.FieldName, tenant.FieldSlug, tenant.FieldDeletedUserPublicTodos:
 			values[i] = new(sql.NullString)
 		case tenant.FieldCreatedAt, tenant.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -91,6 +109,51 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Slug = value.String
 			}
+		case tenant.FieldTrashRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trash_retention_days", values[i])
+			} else if value.Valid {
+				_m.TrashRetentionDays = new(int)
+				*_m.TrashRetentionDays = int(value.Int64)
+			}
+		case tenant.FieldRateLimitPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_per_minute", values[i])
+			} else if value.Valid {
+				_m.RateLimitPerMinute = new(int)
+				*_m.RateLimitPerMinute = int(value.Int64)
+			}
+		case tenant.FieldRequireMfa:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_mfa", values[i])
+			} else if value.Valid {
+				_m.RequireMfa = value.Bool
+			}
+		case tenant.FieldDeletedUserPublicTodos:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_user_public_todos", values[i])
+			} else if value.Valid {
+				_m.DeletedUserPublicTodos = tenant.DeletedUserPublicTodos(value.String)
+			}
+		case tenant.FieldPasswordMinLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_min_length", values[i])
+			} else if value.Valid {
+				_m.PasswordMinLength = new(int)
+				*_m.PasswordMinLength = int(value.Int64)
+			}
+		case tenant.FieldPasswordMinCharacterClasses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_min_character_classes", values[i])
+			} else if value.Valid {
+				_m.PasswordMinCharacterClasses = int(value.Int64)
+			}
+		case tenant.FieldPasswordHistory:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history", values[i])
+			} else if value.Valid {
+				_m.PasswordHistory = int(value.Int64)
+			}
 		case tenant.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -150,6 +213,33 @@ func (_m *Tenant) String() string {
 	builder.WriteString("slug=")
 	builder.WriteString(_m.Slug)
 	builder.WriteString(", ")
+	if v := _m.TrashRetentionDays; v != nil {
+		builder.WriteString("trash_retention_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RateLimitPerMinute; v != nil {
+		builder.WriteString("rate_limit_per_minute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("require_mfa=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireMfa))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_user_public_todos=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedUserPublicTodos))
+	builder.WriteString(", ")
+	if v := _m.PasswordMinLength; v != nil {
+		builder.WriteString("password_min_length=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_min_character_classes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PasswordMinCharacterClasses))
+	builder.WriteString(", ")
+	builder.WriteString("password_history=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PasswordHistory))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
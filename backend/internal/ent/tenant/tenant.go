@@ -3,8 +3,10 @@
 package tenant
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -18,6 +20,20 @@ const (
 	FieldName = "name"
 	// FieldSlug holds the string denoting the slug field in the database.
 	FieldSlug = "slug"
+	// FieldTrashRetentionDays holds the string denoting the trash_retention_days field in the database.
+	FieldTrashRetentionDays = "trash_retention_days"
+	// FieldRateLimitPerMinute holds the string denoting the rate_limit_per_minute field in the database.
+	FieldRateLimitPerMinute = "rate_limit_per_minute"
+	// FieldRequireMfa holds the string denoting the require_mfa field in the database.
+	FieldRequireMfa = "require_mfa"
+	// FieldDeletedUserPublicTodos holds the string denoting the deleted_user_public_todos field in the database.
+	FieldDeletedUserPublicTodos = "deleted_user_public_todos"
+	// FieldPasswordMinLength holds the string denoting the password_min_length field in the database.
+	FieldPasswordMinLength = "password_min_length"
+	// FieldPasswordMinCharacterClasses holds the string denoting the password_min_character_classes field in the database.
+	FieldPasswordMinCharacterClasses = "password_min_character_classes"
+	// FieldPasswordHistory holds the string denoting the password_history field in the database.
+	FieldPasswordHistory = "password_history"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -40,6 +56,13 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldSlug,
+	FieldTrashRetentionDays,
+	FieldRateLimitPerMinute,
+	FieldRequireMfa,
+	FieldDeletedUserPublicTodos,
+	FieldPasswordMinLength,
+	FieldPasswordMinCharacterClasses,
+	FieldPasswordHistory,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -54,11 +77,33 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "good-todo-go/internal/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	SlugValidator func(string) error
+	// TrashRetentionDaysValidator is a validator for the "trash_retention_days" field. It is called by the builders before save.
+	TrashRetentionDaysValidator func(int) error
+	// RateLimitPerMinuteValidator is a validator for the "rate_limit_per_minute" field. It is called by the builders before save.
+	RateLimitPerMinuteValidator func(int) error
+	// DefaultRequireMfa holds the default value on creation for the "require_mfa" field.
+	DefaultRequireMfa bool
+	// PasswordMinLengthValidator is a validator for the "password_min_length" field. It is called by the builders before save.
+	PasswordMinLengthValidator func(int) error
+	// DefaultPasswordMinCharacterClasses holds the default value on creation for the "password_min_character_classes" field.
+	DefaultPasswordMinCharacterClasses int
+	// PasswordMinCharacterClassesValidator is a validator for the "password_min_character_classes" field. It is called by the builders before save.
+	PasswordMinCharacterClassesValidator func(int) error
+	// DefaultPasswordHistory holds the default value on creation for the "password_history" field.
+	DefaultPasswordHistory int
+	// PasswordHistoryValidator is a validator for the "password_history" field. It is called by the builders before save.
+	PasswordHistoryValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -69,6 +114,32 @@ var (
 	IDValidator func(string) error
 )
 
+// DeletedUserPublicTodos defines the type for the "deleted_user_public_todos" enum field.
+type DeletedUserPublicTodos string
+
+// DeletedUserPublicTodosDelete is the default value of the DeletedUserPublicTodos enum.
+const DefaultDeletedUserPublicTodos = DeletedUserPublicTodosDelete
+
+// DeletedUserPublicTodos values.
+const (
+	DeletedUserPublicTodosDelete   DeletedUserPublicTodos = "delete"
+	DeletedUserPublicTodosReassign DeletedUserPublicTodos = "reassign"
+)
+
+func (dupt DeletedUserPublicTodos) String() string {
+	return string(dupt)
+}
+
+// DeletedUserPublicTodosValidator is a validator for the "deleted_user_public_todos" field enum values. It is called by the builders before save.
+func DeletedUserPublicTodosValidator(dupt DeletedUserPublicTodos) error {
+	switch dupt {
+	case DeletedUserPublicTodosDelete, DeletedUserPublicTodosReassign:
+		return nil
+	default:
+		return fmt.Errorf("tenant: invalid enum value for deleted_user_public_todos field: %q", dupt)
+	}
+}
+
 // OrderOption defines the ordering options for the Tenant queries.
 type OrderOption func(*sql.Selector)
 
@@ -87,6 +158,41 @@ func BySlug(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSlug, opts...).ToFunc()
 }
 
+// ByTrashRetentionDays orders the results by the trash_retention_days field.
+func ByTrashRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrashRetentionDays, opts...).ToFunc()
+}
+
+// ByRateLimitPerMinute orders the results by the rate_limit_per_minute field.
+func ByRateLimitPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitPerMinute, opts...).ToFunc()
+}
+
+// ByRequireMfa orders the results by the require_mfa field.
+func ByRequireMfa(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireMfa, opts...).ToFunc()
+}
+
+// ByDeletedUserPublicTodos orders the results by the deleted_user_public_todos field.
+func ByDeletedUserPublicTodos(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedUserPublicTodos, opts...).ToFunc()
+}
+
+// ByPasswordMinLength orders the results by the password_min_length field.
+func ByPasswordMinLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordMinLength, opts...).ToFunc()
+}
+
+// ByPasswordMinCharacterClasses orders the results by the password_min_character_classes field.
+func ByPasswordMinCharacterClasses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordMinCharacterClasses, opts...).ToFunc()
+}
+
+// ByPasswordHistory orders the results by the password_history field.
+func ByPasswordHistory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHistory, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -75,6 +75,36 @@ func Slug(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldSlug, v))
 }
 
+// TrashRetentionDays applies equality check predicate on the "trash_retention_days" field. It's identical to TrashRetentionDaysEQ.
+func TrashRetentionDays(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTrashRetentionDays, v))
+}
+
+// RateLimitPerMinute applies equality check predicate on the "rate_limit_per_minute" field. It's identical to RateLimitPerMinuteEQ.
+func RateLimitPerMinute(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RequireMfa applies equality check predicate on the "require_mfa" field. It's identical to RequireMfaEQ.
+func RequireMfa(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRequireMfa, v))
+}
+
+// PasswordMinLength applies equality check predicate on the "password_min_length" field. It's identical to PasswordMinLengthEQ.
+func PasswordMinLength(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordMinCharacterClasses applies equality check predicate on the "password_min_character_classes" field. It's identical to PasswordMinCharacterClassesEQ.
+func PasswordMinCharacterClasses(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordHistory applies equality check predicate on the "password_history" field. It's identical to PasswordHistoryEQ.
+func PasswordHistory(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordHistory, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedAt, v))
@@ -215,6 +245,266 @@ func SlugContainsFold(v string) predicate.Tenant {
 	return predicate.Tenant(sql.FieldContainsFold(FieldSlug, v))
 }
 
+// TrashRetentionDaysEQ applies the EQ predicate on the "trash_retention_days" field.
+func TrashRetentionDaysEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysNEQ applies the NEQ predicate on the "trash_retention_days" field.
+func TrashRetentionDaysNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysIn applies the In predicate on the "trash_retention_days" field.
+func TrashRetentionDaysIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldTrashRetentionDays, vs...))
+}
+
+// TrashRetentionDaysNotIn applies the NotIn predicate on the "trash_retention_days" field.
+func TrashRetentionDaysNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldTrashRetentionDays, vs...))
+}
+
+// TrashRetentionDaysGT applies the GT predicate on the "trash_retention_days" field.
+func TrashRetentionDaysGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysGTE applies the GTE predicate on the "trash_retention_days" field.
+func TrashRetentionDaysGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysLT applies the LT predicate on the "trash_retention_days" field.
+func TrashRetentionDaysLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysLTE applies the LTE predicate on the "trash_retention_days" field.
+func TrashRetentionDaysLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldTrashRetentionDays, v))
+}
+
+// TrashRetentionDaysIsNil applies the IsNil predicate on the "trash_retention_days" field.
+func TrashRetentionDaysIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldTrashRetentionDays))
+}
+
+// TrashRetentionDaysNotNil applies the NotNil predicate on the "trash_retention_days" field.
+func TrashRetentionDaysNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldTrashRetentionDays))
+}
+
+// RateLimitPerMinuteEQ applies the EQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteNEQ applies the NEQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIn applies the In predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteNotIn applies the NotIn predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteGT applies the GT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteGTE applies the GTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLT applies the LT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLTE applies the LTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIsNil applies the IsNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldRateLimitPerMinute))
+}
+
+// RateLimitPerMinuteNotNil applies the NotNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldRateLimitPerMinute))
+}
+
+// RequireMfaEQ applies the EQ predicate on the "require_mfa" field.
+func RequireMfaEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldRequireMfa, v))
+}
+
+// RequireMfaNEQ applies the NEQ predicate on the "require_mfa" field.
+func RequireMfaNEQ(v bool) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldRequireMfa, v))
+}
+
+// DeletedUserPublicTodosEQ applies the EQ predicate on the "deleted_user_public_todos" field.
+func DeletedUserPublicTodosEQ(v DeletedUserPublicTodos) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldDeletedUserPublicTodos, v))
+}
+
+// DeletedUserPublicTodosNEQ applies the NEQ predicate on the "deleted_user_public_todos" field.
+func DeletedUserPublicTodosNEQ(v DeletedUserPublicTodos) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldDeletedUserPublicTodos, v))
+}
+
+// DeletedUserPublicTodosIn applies the In predicate on the "deleted_user_public_todos" field.
+func DeletedUserPublicTodosIn(vs ...DeletedUserPublicTodos) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldDeletedUserPublicTodos, vs...))
+}
+
+// DeletedUserPublicTodosNotIn applies the NotIn predicate on the "deleted_user_public_todos" field.
+func DeletedUserPublicTodosNotIn(vs ...DeletedUserPublicTodos) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldDeletedUserPublicTodos, vs...))
+}
+
+// PasswordMinLengthEQ applies the EQ predicate on the "password_min_length" field.
+func PasswordMinLengthEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthNEQ applies the NEQ predicate on the "password_min_length" field.
+func PasswordMinLengthNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthIn applies the In predicate on the "password_min_length" field.
+func PasswordMinLengthIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldPasswordMinLength, vs...))
+}
+
+// PasswordMinLengthNotIn applies the NotIn predicate on the "password_min_length" field.
+func PasswordMinLengthNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldPasswordMinLength, vs...))
+}
+
+// PasswordMinLengthGT applies the GT predicate on the "password_min_length" field.
+func PasswordMinLengthGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthGTE applies the GTE predicate on the "password_min_length" field.
+func PasswordMinLengthGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthLT applies the LT predicate on the "password_min_length" field.
+func PasswordMinLengthLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthLTE applies the LTE predicate on the "password_min_length" field.
+func PasswordMinLengthLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthIsNil applies the IsNil predicate on the "password_min_length" field.
+func PasswordMinLengthIsNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldIsNull(FieldPasswordMinLength))
+}
+
+// PasswordMinLengthNotNil applies the NotNil predicate on the "password_min_length" field.
+func PasswordMinLengthNotNil() predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotNull(FieldPasswordMinLength))
+}
+
+// PasswordMinCharacterClassesEQ applies the EQ predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordMinCharacterClassesNEQ applies the NEQ predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordMinCharacterClassesIn applies the In predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldPasswordMinCharacterClasses, vs...))
+}
+
+// PasswordMinCharacterClassesNotIn applies the NotIn predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldPasswordMinCharacterClasses, vs...))
+}
+
+// PasswordMinCharacterClassesGT applies the GT predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordMinCharacterClassesGTE applies the GTE predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordMinCharacterClassesLT applies the LT predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordMinCharacterClassesLTE applies the LTE predicate on the "password_min_character_classes" field.
+func PasswordMinCharacterClassesLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldPasswordMinCharacterClasses, v))
+}
+
+// PasswordHistoryEQ applies the EQ predicate on the "password_history" field.
+func PasswordHistoryEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldEQ(FieldPasswordHistory, v))
+}
+
+// PasswordHistoryNEQ applies the NEQ predicate on the "password_history" field.
+func PasswordHistoryNEQ(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNEQ(FieldPasswordHistory, v))
+}
+
+// PasswordHistoryIn applies the In predicate on the "password_history" field.
+func PasswordHistoryIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldIn(FieldPasswordHistory, vs...))
+}
+
+// PasswordHistoryNotIn applies the NotIn predicate on the "password_history" field.
+func PasswordHistoryNotIn(vs ...int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldNotIn(FieldPasswordHistory, vs...))
+}
+
+// PasswordHistoryGT applies the GT predicate on the "password_history" field.
+func PasswordHistoryGT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGT(FieldPasswordHistory, v))
+}
+
+// PasswordHistoryGTE applies the GTE predicate on the "password_history" field.
+func PasswordHistoryGTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldGTE(FieldPasswordHistory, v))
+}
+
+// PasswordHistoryLT applies the LT predicate on the "password_history" field.
+func PasswordHistoryLT(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLT(FieldPasswordHistory, v))
+}
+
+// PasswordHistoryLTE applies the LTE predicate on the "password_history" field.
+func PasswordHistoryLTE(v int) predicate.Tenant {
+	return predicate.Tenant(sql.FieldLTE(FieldPasswordHistory, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Tenant {
 	return predicate.Tenant(sql.FieldEQ(FieldCreatedAt, v))
@@ -33,6 +33,104 @@ func (_c *TenantCreate) SetSlug(v string) *TenantCreate {
 	return _c
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_c *TenantCreate) SetTrashRetentionDays(v int) *TenantCreate {
+	_c.mutation.SetTrashRetentionDays(v)
+	return _c
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableTrashRetentionDays(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetTrashRetentionDays(*v)
+	}
+	return _c
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_c *TenantCreate) SetRateLimitPerMinute(v int) *TenantCreate {
+	_c.mutation.SetRateLimitPerMinute(v)
+	return _c
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableRateLimitPerMinute(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetRateLimitPerMinute(*v)
+	}
+	return _c
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_c *TenantCreate) SetRequireMfa(v bool) *TenantCreate {
+	_c.mutation.SetRequireMfa(v)
+	return _c
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableRequireMfa(v *bool) *TenantCreate {
+	if v != nil {
+		_c.SetRequireMfa(*v)
+	}
+	return _c
+}
+
+// SetDeletedUserPublicTodos sets the "deleted_user_public_todos" field.
+func (_c *TenantCreate) SetDeletedUserPublicTodos(v tenant.DeletedUserPublicTodos) *TenantCreate {
+	_c.mutation.SetDeletedUserPublicTodos(v)
+	return _c
+}
+
+// SetNillableDeletedUserPublicTodos sets the "deleted_user_public_todos" field if the given value is not nil.
+func (_c *TenantCreate) SetNillableDeletedUserPublicTodos(v *tenant.DeletedUserPublicTodos) *TenantCreate {
+	if v != nil {
+		_c.SetDeletedUserPublicTodos(*v)
+	}
+	return _c
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (_c *TenantCreate) SetPasswordMinLength(v int) *TenantCreate {
+	_c.mutation.SetPasswordMinLength(v)
+	return _c
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePasswordMinLength(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetPasswordMinLength(*v)
+	}
+	return _c
+}
+
+// SetPasswordMinCharacterClasses sets the "password_min_character_classes" field.
+func (_c *TenantCreate) SetPasswordMinCharacterClasses(v int) *TenantCreate {
+	_c.mutation.SetPasswordMinCharacterClasses(v)
+	return _c
+}
+
+// SetNillablePasswordMinCharacterClasses sets the "password_min_character_classes" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePasswordMinCharacterClasses(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetPasswordMinCharacterClasses(*v)
+	}
+	return _c
+}
+
+// SetPasswordHistory sets the "password_history" field.
+func (_c *TenantCreate) SetPasswordHistory(v int) *TenantCreate {
+	_c.mutation.SetPasswordHistory(v)
+	return _c
+}
+
+// SetNillablePasswordHistory sets the "password_history" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePasswordHistory(v *int) *TenantCreate {
+	if v != nil {
+		_c.SetPasswordHistory(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TenantCreate) SetCreatedAt(v time.Time) *TenantCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -89,7 +187,9 @@ func (_c *TenantCreate) Mutation() *TenantMutation {
 
 // Save creates the Tenant in the database.
 func (_c *TenantCreate) Save(ctx context.Context) (*Tenant, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -116,15 +216,38 @@ func (_c *TenantCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TenantCreate) defaults() {
+func (_c *TenantCreate) defaults() error {
+	if _, ok := _c.mutation.RequireMfa(); !ok {
+		v := tenant.DefaultRequireMfa
+		_c.mutation.SetRequireMfa(v)
+	}
+	if _, ok := _c.mutation.DeletedUserPublicTodos(); !ok {
+		v := tenant.DefaultDeletedUserPublicTodos
+		_c.mutation.SetDeletedUserPublicTodos(v)
+	}
+	if _, ok := _c.mutation.PasswordMinCharacterClasses(); !ok {
+		v := tenant.DefaultPasswordMinCharacterClasses
+		_c.mutation.SetPasswordMinCharacterClasses(v)
+	}
+	if _, ok := _c.mutation.PasswordHistory(); !ok {
+		v := tenant.DefaultPasswordHistory
+		_c.mutation.SetPasswordHistory(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if tenant.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized tenant.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := tenant.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if tenant.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized tenant.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := tenant.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -145,6 +268,48 @@ func (_c *TenantCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RateLimitPerMinute(); ok {
+		if err := tenant.RateLimitPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limit_per_minute", err: fmt.Errorf(`ent: validator failed for field "Tenant.rate_limit_per_minute": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequireMfa(); !ok {
+		return &ValidationError{Name: "require_mfa", err: errors.New(`ent: missing required field "Tenant.require_mfa"`)}
+	}
+	if _, ok := _c.mutation.DeletedUserPublicTodos(); !ok {
+		return &ValidationError{Name: "deleted_user_public_todos", err: errors.New(`ent: missing required field "Tenant.deleted_user_public_todos"`)}
+	}
+	if v, ok := _c.mutation.DeletedUserPublicTodos(); ok {
+		if err := tenant.DeletedUserPublicTodosValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_user_public_todos", err: fmt.Errorf(`ent: validator failed for field "Tenant.deleted_user_public_todos": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PasswordMinLength(); ok {
+		if err := tenant.PasswordMinLengthValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_length", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_length": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PasswordMinCharacterClasses(); !ok {
+		return &ValidationError{Name: "password_min_character_classes", err: errors.New(`ent: missing required field "Tenant.password_min_character_classes"`)}
+	}
+	if v, ok := _c.mutation.PasswordMinCharacterClasses(); ok {
+		if err := tenant.PasswordMinCharacterClassesValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_character_classes", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_character_classes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PasswordHistory(); !ok {
+		return &ValidationError{Name: "password_history", err: errors.New(`ent: missing required field "Tenant.password_history"`)}
+	}
+	if v, ok := _c.mutation.PasswordHistory(); ok {
+		if err := tenant.PasswordHistoryValidator(v); err != nil {
+			return &ValidationError{Name: "password_history", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_history": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tenant.created_at"`)}
 	}
@@ -199,6 +364,34 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 		_node.Slug = value
 	}
+	if value, ok := _c.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+		_node.TrashRetentionDays = &value
+	}
+	if value, ok := _c.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(tenant.FieldRateLimitPerMinute, field.TypeInt, value)
+		_node.RateLimitPerMinute = &value
+	}
+	if value, ok := _c.mutation.RequireMfa(); ok {
+		_spec.SetField(tenant.FieldRequireMfa, field.TypeBool, value)
+		_node.RequireMfa = value
+	}
+	if value, ok := _c.mutation.DeletedUserPublicTodos(); ok {
+		_spec.SetField(tenant.FieldDeletedUserPublicTodos, field.TypeEnum, value)
+		_node.DeletedUserPublicTodos = value
+	}
+	if value, ok := _c.mutation.PasswordMinLength(); ok {
+		_spec.SetField(tenant.FieldPasswordMinLength, field.TypeInt, value)
+		_node.PasswordMinLength = &value
+	}
+	if value, ok := _c.mutation.PasswordMinCharacterClasses(); ok {
+		_spec.SetField(tenant.FieldPasswordMinCharacterClasses, field.TypeInt, value)
+		_node.PasswordMinCharacterClasses = value
+	}
+	if value, ok := _c.mutation.PasswordHistory(); ok {
+		_spec.SetField(tenant.FieldPasswordHistory, field.TypeInt, value)
+		_node.PasswordHistory = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(tenant.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
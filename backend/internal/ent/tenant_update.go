@@ -57,6 +57,157 @@ func (_u *TenantUpdate) SetNillableSlug(v *string) *TenantUpdate {
 	return _u
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_u *TenantUpdate) SetTrashRetentionDays(v int) *TenantUpdate {
+	_u.mutation.ResetTrashRetentionDays()
+	_u.mutation.SetTrashRetentionDays(v)
+	return _u
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableTrashRetentionDays(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetTrashRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddTrashRetentionDays adds value to the "trash_retention_days" field.
+func (_u *TenantUpdate) AddTrashRetentionDays(v int) *TenantUpdate {
+	_u.mutation.AddTrashRetentionDays(v)
+	return _u
+}
+
+// ClearTrashRetentionDays clears the value of the "trash_retention_days" field.
+func (_u *TenantUpdate) ClearTrashRetentionDays() *TenantUpdate {
+	_u.mutation.ClearTrashRetentionDays()
+	return _u
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_u *TenantUpdate) SetRateLimitPerMinute(v int) *TenantUpdate {
+	_u.mutation.ResetRateLimitPerMinute()
+	_u.mutation.SetRateLimitPerMinute(v)
+	return _u
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableRateLimitPerMinute(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetRateLimitPerMinute(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerMinute adds value to the "rate_limit_per_minute" field.
+func (_u *TenantUpdate) AddRateLimitPerMinute(v int) *TenantUpdate {
+	_u.mutation.AddRateLimitPerMinute(v)
+	return _u
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (_u *TenantUpdate) ClearRateLimitPerMinute() *TenantUpdate {
+	_u.mutation.ClearRateLimitPerMinute()
+	return _u
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_u *TenantUpdate) SetRequireMfa(v bool) *TenantUpdate {
+	_u.mutation.SetRequireMfa(v)
+	return _u
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableRequireMfa(v *bool) *TenantUpdate {
+	if v != nil {
+		_u.SetRequireMfa(*v)
+	}
+	return _u
+}
+
+// SetDeletedUserPublicTodos sets the "deleted_user_public_todos" field.
+func (_u *TenantUpdate) SetDeletedUserPublicTodos(v tenant.DeletedUserPublicTodos) *TenantUpdate {
+	_u.mutation.SetDeletedUserPublicTodos(v)
+	return _u
+}
+
+// SetNillableDeletedUserPublicTodos sets the "deleted_user_public_todos" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableDeletedUserPublicTodos(v *tenant.DeletedUserPublicTodos) *TenantUpdate {
+	if v != nil {
+		_u.SetDeletedUserPublicTodos(*v)
+	}
+	return _u
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (_u *TenantUpdate) SetPasswordMinLength(v int) *TenantUpdate {
+	_u.mutation.ResetPasswordMinLength()
+	_u.mutation.SetPasswordMinLength(v)
+	return _u
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePasswordMinLength(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetPasswordMinLength(*v)
+	}
+	return _u
+}
+
+// AddPasswordMinLength adds value to the "password_min_length" field.
+func (_u *TenantUpdate) AddPasswordMinLength(v int) *TenantUpdate {
+	_u.mutation.AddPasswordMinLength(v)
+	return _u
+}
+
+// ClearPasswordMinLength clears the value of the "password_min_length" field.
+func (_u *TenantUpdate) ClearPasswordMinLength() *TenantUpdate {
+	_u.mutation.ClearPasswordMinLength()
+	return _u
+}
+
+// SetPasswordMinCharacterClasses sets the "password_min_character_classes" field.
+func (_u *TenantUpdate) SetPasswordMinCharacterClasses(v int) *TenantUpdate {
+	_u.mutation.ResetPasswordMinCharacterClasses()
+	_u.mutation.SetPasswordMinCharacterClasses(v)
+	return _u
+}
+
+// SetNillablePasswordMinCharacterClasses sets the "password_min_character_classes" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePasswordMinCharacterClasses(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetPasswordMinCharacterClasses(*v)
+	}
+	return _u
+}
+
+// AddPasswordMinCharacterClasses adds value to the "password_min_character_classes" field.
+func (_u *TenantUpdate) AddPasswordMinCharacterClasses(v int) *TenantUpdate {
+	_u.mutation.AddPasswordMinCharacterClasses(v)
+	return _u
+}
+
+// SetPasswordHistory sets the "password_history" field.
+func (_u *TenantUpdate) SetPasswordHistory(v int) *TenantUpdate {
+	_u.mutation.ResetPasswordHistory()
+	_u.mutation.SetPasswordHistory(v)
+	return _u
+}
+
+// SetNillablePasswordHistory sets the "password_history" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePasswordHistory(v *int) *TenantUpdate {
+	if v != nil {
+		_u.SetPasswordHistory(*v)
+	}
+	return _u
+}
+
+// AddPasswordHistory adds value to the "password_history" field.
+func (_u *TenantUpdate) AddPasswordHistory(v int) *TenantUpdate {
+	_u.mutation.AddPasswordHistory(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TenantUpdate) SetUpdatedAt(v time.Time) *TenantUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -106,7 +257,9 @@ func (_u *TenantUpdate) RemoveUsers(v ...*User) *TenantUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -133,11 +286,15 @@ func (_u *TenantUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *TenantUpdate) defaults() {
+func (_u *TenantUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if tenant.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := tenant.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -152,6 +309,36 @@ func (_u *TenantUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RateLimitPerMinute(); ok {
+		if err := tenant.RateLimitPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limit_per_minute", err: fmt.Errorf(`ent: validator failed for field "Tenant.rate_limit_per_minute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedUserPublicTodos(); ok {
+		if err := tenant.DeletedUserPublicTodosValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_user_public_todos", err: fmt.Errorf(`ent: validator failed for field "Tenant.deleted_user_public_todos": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordMinLength(); ok {
+		if err := tenant.PasswordMinLengthValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_length", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_length": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordMinCharacterClasses(); ok {
+		if err := tenant.PasswordMinCharacterClassesValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_character_classes", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_character_classes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordHistory(); ok {
+		if err := tenant.PasswordHistoryValidator(v); err != nil {
+			return &ValidationError{Name: "password_history", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_history": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -173,6 +360,51 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashRetentionDays(); ok {
+		_spec.AddField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.TrashRetentionDaysCleared() {
+		_spec.ClearField(tenant.FieldTrashRetentionDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(tenant.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(tenant.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(tenant.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireMfa(); ok {
+		_spec.SetField(tenant.FieldRequireMfa, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletedUserPublicTodos(); ok {
+		_spec.SetField(tenant.FieldDeletedUserPublicTodos, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PasswordMinLength(); ok {
+		_spec.SetField(tenant.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordMinLength(); ok {
+		_spec.AddField(tenant.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if _u.mutation.PasswordMinLengthCleared() {
+		_spec.ClearField(tenant.FieldPasswordMinLength, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PasswordMinCharacterClasses(); ok {
+		_spec.SetField(tenant.FieldPasswordMinCharacterClasses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordMinCharacterClasses(); ok {
+		_spec.AddField(tenant.FieldPasswordMinCharacterClasses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordHistory(); ok {
+		_spec.SetField(tenant.FieldPasswordHistory, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordHistory(); ok {
+		_spec.AddField(tenant.FieldPasswordHistory, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -269,6 +501,157 @@ func (_u *TenantUpdateOne) SetNillableSlug(v *string) *TenantUpdateOne {
 	return _u
 }
 
+// SetTrashRetentionDays sets the "trash_retention_days" field.
+func (_u *TenantUpdateOne) SetTrashRetentionDays(v int) *TenantUpdateOne {
+	_u.mutation.ResetTrashRetentionDays()
+	_u.mutation.SetTrashRetentionDays(v)
+	return _u
+}
+
+// SetNillableTrashRetentionDays sets the "trash_retention_days" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableTrashRetentionDays(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetTrashRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddTrashRetentionDays adds value to the "trash_retention_days" field.
+func (_u *TenantUpdateOne) AddTrashRetentionDays(v int) *TenantUpdateOne {
+	_u.mutation.AddTrashRetentionDays(v)
+	return _u
+}
+
+// ClearTrashRetentionDays clears the value of the "trash_retention_days" field.
+func (_u *TenantUpdateOne) ClearTrashRetentionDays() *TenantUpdateOne {
+	_u.mutation.ClearTrashRetentionDays()
+	return _u
+}
+
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_u *TenantUpdateOne) SetRateLimitPerMinute(v int) *TenantUpdateOne {
+	_u.mutation.ResetRateLimitPerMinute()
+	_u.mutation.SetRateLimitPerMinute(v)
+	return _u
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableRateLimitPerMinute(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetRateLimitPerMinute(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerMinute adds value to the "rate_limit_per_minute" field.
+func (_u *TenantUpdateOne) AddRateLimitPerMinute(v int) *TenantUpdateOne {
+	_u.mutation.AddRateLimitPerMinute(v)
+	return _u
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (_u *TenantUpdateOne) ClearRateLimitPerMinute() *TenantUpdateOne {
+	_u.mutation.ClearRateLimitPerMinute()
+	return _u
+}
+
+// SetRequireMfa sets the "require_mfa" field.
+func (_u *TenantUpdateOne) SetRequireMfa(v bool) *TenantUpdateOne {
+	_u.mutation.SetRequireMfa(v)
+	return _u
+}
+
+// SetNillableRequireMfa sets the "require_mfa" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableRequireMfa(v *bool) *TenantUpdateOne {
+	if v != nil {
+		_u.SetRequireMfa(*v)
+	}
+	return _u
+}
+
+// SetDeletedUserPublicTodos sets the "deleted_user_public_todos" field.
+func (_u *TenantUpdateOne) SetDeletedUserPublicTodos(v tenant.DeletedUserPublicTodos) *TenantUpdateOne {
+	_u.mutation.SetDeletedUserPublicTodos(v)
+	return _u
+}
+
+// SetNillableDeletedUserPublicTodos sets the "deleted_user_public_todos" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableDeletedUserPublicTodos(v *tenant.DeletedUserPublicTodos) *TenantUpdateOne {
+	if v != nil {
+		_u.SetDeletedUserPublicTodos(*v)
+	}
+	return _u
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (_u *TenantUpdateOne) SetPasswordMinLength(v int) *TenantUpdateOne {
+	_u.mutation.ResetPasswordMinLength()
+	_u.mutation.SetPasswordMinLength(v)
+	return _u
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePasswordMinLength(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPasswordMinLength(*v)
+	}
+	return _u
+}
+
+// AddPasswordMinLength adds value to the "password_min_length" field.
+func (_u *TenantUpdateOne) AddPasswordMinLength(v int) *TenantUpdateOne {
+	_u.mutation.AddPasswordMinLength(v)
+	return _u
+}
+
+// ClearPasswordMinLength clears the value of the "password_min_length" field.
+func (_u *TenantUpdateOne) ClearPasswordMinLength() *TenantUpdateOne {
+	_u.mutation.ClearPasswordMinLength()
+	return _u
+}
+
+// SetPasswordMinCharacterClasses sets the "password_min_character_classes" field.
+func (_u *TenantUpdateOne) SetPasswordMinCharacterClasses(v int) *TenantUpdateOne {
+	_u.mutation.ResetPasswordMinCharacterClasses()
+	_u.mutation.SetPasswordMinCharacterClasses(v)
+	return _u
+}
+
+// SetNillablePasswordMinCharacterClasses sets the "password_min_character_classes" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePasswordMinCharacterClasses(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPasswordMinCharacterClasses(*v)
+	}
+	return _u
+}
+
+// AddPasswordMinCharacterClasses adds value to the "password_min_character_classes" field.
+func (_u *TenantUpdateOne) AddPasswordMinCharacterClasses(v int) *TenantUpdateOne {
+	_u.mutation.AddPasswordMinCharacterClasses(v)
+	return _u
+}
+
+// SetPasswordHistory sets the "password_history" field.
+func (_u *TenantUpdateOne) SetPasswordHistory(v int) *TenantUpdateOne {
+	_u.mutation.ResetPasswordHistory()
+	_u.mutation.SetPasswordHistory(v)
+	return _u
+}
+
+// SetNillablePasswordHistory sets the "password_history" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePasswordHistory(v *int) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPasswordHistory(*v)
+	}
+	return _u
+}
+
+// AddPasswordHistory adds value to the "password_history" field.
+func (_u *TenantUpdateOne) AddPasswordHistory(v int) *TenantUpdateOne {
+	_u.mutation.AddPasswordHistory(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TenantUpdateOne) SetUpdatedAt(v time.Time) *TenantUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -331,7 +714,9 @@ func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateO
 
 // Save executes the query and returns the updated Tenant entity.
 func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -358,11 +743,15 @@ func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *TenantUpdateOne) defaults() {
+func (_u *TenantUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if tenant.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := tenant.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -377,6 +766,36 @@ func (_u *TenantUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "Tenant.slug": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.TrashRetentionDays(); ok {
+		if err := tenant.TrashRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "trash_retention_days", err: fmt.Errorf(`ent: validator failed for field "Tenant.trash_retention_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RateLimitPerMinute(); ok {
+		if err := tenant.RateLimitPerMinuteValidator(v); err != nil {
+			return &ValidationError{Name: "rate_limit_per_minute", err: fmt.Errorf(`ent: validator failed for field "Tenant.rate_limit_per_minute": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedUserPublicTodos(); ok {
+		if err := tenant.DeletedUserPublicTodosValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_user_public_todos", err: fmt.Errorf(`ent: validator failed for field "Tenant.deleted_user_public_todos": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordMinLength(); ok {
+		if err := tenant.PasswordMinLengthValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_length", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_length": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordMinCharacterClasses(); ok {
+		if err := tenant.PasswordMinCharacterClassesValidator(v); err != nil {
+			return &ValidationError{Name: "password_min_character_classes", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_min_character_classes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PasswordHistory(); ok {
+		if err := tenant.PasswordHistoryValidator(v); err != nil {
+			return &ValidationError{Name: "password_history", err: fmt.Errorf(`ent: validator failed for field "Tenant.password_history": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -415,6 +834,51 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if value, ok := _u.mutation.Slug(); ok {
 		_spec.SetField(tenant.FieldSlug, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrashRetentionDays(); ok {
+		_spec.SetField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTrashRetentionDays(); ok {
+		_spec.AddField(tenant.FieldTrashRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.TrashRetentionDaysCleared() {
+		_spec.ClearField(tenant.FieldTrashRetentionDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(tenant.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(tenant.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(tenant.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireMfa(); ok {
+		_spec.SetField(tenant.FieldRequireMfa, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletedUserPublicTodos(); ok {
+		_spec.SetField(tenant.FieldDeletedUserPublicTodos, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PasswordMinLength(); ok {
+		_spec.SetField(tenant.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordMinLength(); ok {
+		_spec.AddField(tenant.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if _u.mutation.PasswordMinLengthCleared() {
+		_spec.ClearField(tenant.FieldPasswordMinLength, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PasswordMinCharacterClasses(); ok {
+		_spec.SetField(tenant.FieldPasswordMinCharacterClasses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordMinCharacterClasses(); ok {
+		_spec.AddField(tenant.FieldPasswordMinCharacterClasses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PasswordHistory(); ok {
+		_spec.SetField(tenant.FieldPasswordHistory, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPasswordHistory(); ok {
+		_spec.AddField(tenant.FieldPasswordHistory, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(tenant.FieldUpdatedAt, field.TypeTime, value)
 	}
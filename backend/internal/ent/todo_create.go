@@ -21,6 +21,20 @@ type TodoCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TodoCreate) SetDeletedAt(v time.Time) *TodoCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableDeletedAt(v *time.Time) *TodoCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetTenantID sets the "tenant_id" field.
 func (_c *TodoCreate) SetTenantID(v string) *TodoCreate {
 	_c.mutation.SetTenantID(v)
@@ -109,6 +123,20 @@ func (_c *TodoCreate) SetNillableCompletedAt(v *time.Time) *TodoCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *TodoCreate) SetVersion(v int) *TodoCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *TodoCreate) SetNillableVersion(v *int) *TodoCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TodoCreate) SetCreatedAt(v time.Time) *TodoCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -155,7 +183,9 @@ func (_c *TodoCreate) Mutation() *TodoMutation {
 
 // Save creates the Todo in the database.
 func (_c *TodoCreate) Save(ctx context.Context) (*Todo, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -182,7 +212,7 @@ func (_c *TodoCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *TodoCreate) defaults() {
+func (_c *TodoCreate) defaults() error {
 	if _, ok := _c.mutation.Description(); !ok {
 		v := todo.DefaultDescription
 		_c.mutation.SetDescription(v)
@@ -195,14 +225,25 @@ func (_c *TodoCreate) defaults() {
 		v := todo.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := todo.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if todo.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := todo.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if todo.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized todo.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := todo.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -237,6 +278,14 @@ func (_c *TodoCreate) check() error {
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "Todo.is_public"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Todo.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := todo.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Todo.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Todo.created_at"`)}
 	}
@@ -286,6 +335,10 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(todo.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.TenantID(); ok {
 		_spec.SetField(todo.FieldTenantID, field.TypeString, value)
 		_node.TenantID = value
@@ -314,6 +367,10 @@ func (_c *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_spec.SetField(todo.FieldCompletedAt, field.TypeTime, value)
 		_node.CompletedAt = &value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(todo.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(todo.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
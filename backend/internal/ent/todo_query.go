@@ -298,12 +298,12 @@ func (_q *TodoQuery) WithUser(opts ...func(*UserQuery)) *TodoQuery {
 // Example:
 //
 //	var v []struct {
-//		TenantID string `json:"tenant_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Todo.Query().
-//		GroupBy(todo.FieldTenantID).
+//		GroupBy(todo.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *TodoQuery) GroupBy(field string, fields ...string) *TodoGroupBy {
@@ -321,11 +321,11 @@ func (_q *TodoQuery) GroupBy(field string, fields ...string) *TodoGroupBy {
 // Example:
 //
 //	var v []struct {
-//		TenantID string `json:"tenant_id,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Todo.Query().
-//		Select(todo.FieldTenantID).
+//		Select(todo.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *TodoQuery) Select(fields ...string) *TodoSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
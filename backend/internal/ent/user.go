@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/ent/user"
@@ -34,6 +35,28 @@ type User struct {
 	VerificationToken *string `json:"verification_token,omitempty"`
 	// VerificationTokenExpiresAt holds the value of the "verification_token_expires_at" field.
 	VerificationTokenExpiresAt *time.Time `json:"verification_token_expires_at,omitempty"`
+	// Set after too many failed logins. Logins are refused until then
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	// MfaEnabled holds the value of the "mfa_enabled" field.
+	MfaEnabled bool `json:"mfa_enabled,omitempty"`
+	// TOTP secret. Set during enrollment, before mfa_enabled
+	MfaSecret *string `json:"-"`
+	// TOTP time step of the last accepted code, so a code cannot be replayed
+	MfaLastUsedStep *int64 `json:"mfa_last_used_step,omitempty"`
+	// SHA-256 of the unused recovery codes
+	MfaRecoveryCodeHashes []string `json:"-"`
+	// New address waiting for confirmation. email changes only once it is confirmed
+	PendingEmail *string `json:"pending_email,omitempty"`
+	// SHA-256 of the token mailed to pending_email
+	EmailChangeTokenHash *string `json:"-"`
+	// EmailChangeExpiresAt holds the value of the "email_change_expires_at" field.
+	EmailChangeExpiresAt *time.Time `json:"email_change_expires_at,omitempty"`
+	// SHA-256 of the code mailed to confirm deleting the account
+	DeletionCodeHash *string `json:"-"`
+	// DeletionCodeExpiresAt holds the value of the "deletion_code_expires_at" field.
+	DeletionCodeExpiresAt *time.Time `json:"deletion_code_expires_at,omitempty"`
+	// Hashes of previous passwords, newest first, kept for the tenant's reuse policy
+	PasswordHistoryHashes []string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -80,11 +103,15 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmailVerified:
+		case user.FieldMfaRecoveryCodeHashes, user.FieldPasswordHistoryHashes:
+			values[i] = new([]byte)
+		case user.FieldEmailVerified, user.FieldMfaEnabled:
 			values[i] = new(sql.NullBool)
-		case user.FieldID, user.FieldTenantID, user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldRole, user.FieldVerificationToken:
+		case user.FieldMfaLastUsedStep:
+			values[i] = new(sql.NullInt64)
+		case user.FieldID, user.FieldTenantID, user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldRole, user.FieldVerificationToken, user.FieldMfaSecret, user.FieldPendingEmail, user.FieldEmailChangeTokenHash, user.FieldDeletionCodeHash:
 			values[i] = new(sql.NullString)
-		case user.FieldVerificationTokenExpiresAt, user.FieldCreatedAt, user.FieldUpdatedAt:
+		case user.FieldVerificationTokenExpiresAt, user.FieldLockedUntil, user.FieldEmailChangeExpiresAt, user.FieldDeletionCodeExpiresAt, user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -157,6 +184,84 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.VerificationTokenExpiresAt = new(time.Time)
 				*_m.VerificationTokenExpiresAt = value.Time
 			}
+		case user.FieldLockedUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field locked_until", values[i])
+			} else if value.Valid {
+				_m.LockedUntil = new(time.Time)
+				*_m.LockedUntil = value.Time
+			}
+		case user.FieldMfaEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_enabled", values[i])
+			} else if value.Valid {
+				_m.MfaEnabled = value.Bool
+			}
+		case user.FieldMfaSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_secret", values[i])
+			} else if value.Valid {
+				_m.MfaSecret = new(string)
+				*_m.MfaSecret = value.String
+			}
+		case user.FieldMfaLastUsedStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_last_used_step", values[i])
+			} else if value.Valid {
+				_m.MfaLastUsedStep = new(int64)
+				*_m.MfaLastUsedStep = value.Int64
+			}
+		case user.FieldMfaRecoveryCodeHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_recovery_code_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MfaRecoveryCodeHashes); err != nil {
+					return fmt.Errorf("unmarshal field mfa_recovery_code_hashes: %w", err)
+				}
+			}
+		case user.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				_m.PendingEmail = new(string)
+				*_m.PendingEmail = value.String
+			}
+		case user.FieldEmailChangeTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email_change_token_hash", values[i])
+			} else if value.Valid {
+				_m.EmailChangeTokenHash = new(string)
+				*_m.EmailChangeTokenHash = value.String
+			}
+		case user.FieldEmailChangeExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_change_expires_at", values[i])
+			} else if value.Valid {
+				_m.EmailChangeExpiresAt = new(time.Time)
+				*_m.EmailChangeExpiresAt = value.Time
+			}
+		case user.FieldDeletionCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_code_hash", values[i])
+			} else if value.Valid {
+				_m.DeletionCodeHash = new(string)
+				*_m.DeletionCodeHash = value.String
+			}
+		case user.FieldDeletionCodeExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_code_expires_at", values[i])
+			} else if value.Valid {
+				_m.DeletionCodeExpiresAt = new(time.Time)
+				*_m.DeletionCodeExpiresAt = value.Time
+			}
+		case user.FieldPasswordHistoryHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PasswordHistoryHashes); err != nil {
+					return fmt.Errorf("unmarshal field password_history_hashes: %w", err)
+				}
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -242,6 +347,44 @@ func (_m *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := _m.LockedUntil; v != nil {
+		builder.WriteString("locked_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_secret=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.MfaLastUsedStep; v != nil {
+		builder.WriteString("mfa_last_used_step=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("mfa_recovery_code_hashes=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.PendingEmail; v != nil {
+		builder.WriteString("pending_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("email_change_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.EmailChangeExpiresAt; v != nil {
+		builder.WriteString("email_change_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deletion_code_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.DeletionCodeExpiresAt; v != nil {
+		builder.WriteString("deletion_code_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_history_hashes=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -31,6 +32,28 @@ const (
 	FieldVerificationToken = "verification_token"
 	// FieldVerificationTokenExpiresAt holds the string denoting the verification_token_expires_at field in the database.
 	FieldVerificationTokenExpiresAt = "verification_token_expires_at"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldMfaEnabled holds the string denoting the mfa_enabled field in the database.
+	FieldMfaEnabled = "mfa_enabled"
+	// FieldMfaSecret holds the string denoting the mfa_secret field in the database.
+	FieldMfaSecret = "mfa_secret"
+	// FieldMfaLastUsedStep holds the string denoting the mfa_last_used_step field in the database.
+	FieldMfaLastUsedStep = "mfa_last_used_step"
+	// FieldMfaRecoveryCodeHashes holds the string denoting the mfa_recovery_code_hashes field in the database.
+	FieldMfaRecoveryCodeHashes = "mfa_recovery_code_hashes"
+	// FieldPendingEmail holds the string denoting the pending_email field in the database.
+	FieldPendingEmail = "pending_email"
+	// FieldEmailChangeTokenHash holds the string denoting the email_change_token_hash field in the database.
+	FieldEmailChangeTokenHash = "email_change_token_hash"
+	// FieldEmailChangeExpiresAt holds the string denoting the email_change_expires_at field in the database.
+	FieldEmailChangeExpiresAt = "email_change_expires_at"
+	// FieldDeletionCodeHash holds the string denoting the deletion_code_hash field in the database.
+	FieldDeletionCodeHash = "deletion_code_hash"
+	// FieldDeletionCodeExpiresAt holds the string denoting the deletion_code_expires_at field in the database.
+	FieldDeletionCodeExpiresAt = "deletion_code_expires_at"
+	// FieldPasswordHistoryHashes holds the string denoting the password_history_hashes field in the database.
+	FieldPasswordHistoryHashes = "password_history_hashes"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -68,6 +91,17 @@ var Columns = []string{
 	FieldEmailVerified,
 	FieldVerificationToken,
 	FieldVerificationTokenExpiresAt,
+	FieldLockedUntil,
+	FieldMfaEnabled,
+	FieldMfaSecret,
+	FieldMfaLastUsedStep,
+	FieldMfaRecoveryCodeHashes,
+	FieldPendingEmail,
+	FieldEmailChangeTokenHash,
+	FieldEmailChangeExpiresAt,
+	FieldDeletionCodeHash,
+	FieldDeletionCodeExpiresAt,
+	FieldPasswordHistoryHashes,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -82,7 +116,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "good-todo-go/internal/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
 	TenantIDValidator func(string) error
 	// EmailValidator is a validator for the "email" field. It is called by the builders before save.
@@ -93,6 +133,8 @@ var (
 	DefaultName string
 	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
 	DefaultEmailVerified bool
+	// DefaultMfaEnabled holds the default value on creation for the "mfa_enabled" field.
+	DefaultMfaEnabled bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -177,6 +219,51 @@ func ByVerificationTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerificationTokenExpiresAt, opts...).ToFunc()
 }
 
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByMfaEnabled orders the results by the mfa_enabled field.
+func ByMfaEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaEnabled, opts...).ToFunc()
+}
+
+// ByMfaSecret orders the results by the mfa_secret field.
+func ByMfaSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaSecret, opts...).ToFunc()
+}
+
+// ByMfaLastUsedStep orders the results by the mfa_last_used_step field.
+func ByMfaLastUsedStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaLastUsedStep, opts...).ToFunc()
+}
+
+// ByPendingEmail orders the results by the pending_email field.
+func ByPendingEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingEmail, opts...).ToFunc()
+}
+
+// ByEmailChangeTokenHash orders the results by the email_change_token_hash field.
+func ByEmailChangeTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailChangeTokenHash, opts...).ToFunc()
+}
+
+// ByEmailChangeExpiresAt orders the results by the email_change_expires_at field.
+func ByEmailChangeExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailChangeExpiresAt, opts...).ToFunc()
+}
+
+// ByDeletionCodeHash orders the results by the deletion_code_hash field.
+func ByDeletionCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionCodeHash, opts...).ToFunc()
+}
+
+// ByDeletionCodeExpiresAt orders the results by the deletion_code_expires_at field.
+func ByDeletionCodeExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionCodeExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -100,6 +100,51 @@ func VerificationTokenExpiresAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldVerificationTokenExpiresAt, v))
 }
 
+// LockedUntil applies equality check predicate on the "locked_until" field. It's identical to LockedUntilEQ.
+func LockedUntil(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// MfaEnabled applies equality check predicate on the "mfa_enabled" field. It's identical to MfaEnabledEQ.
+func MfaEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
+// MfaSecret applies equality check predicate on the "mfa_secret" field. It's identical to MfaSecretEQ.
+func MfaSecret(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaSecret, v))
+}
+
+// MfaLastUsedStep applies equality check predicate on the "mfa_last_used_step" field. It's identical to MfaLastUsedStepEQ.
+func MfaLastUsedStep(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLastUsedStep, v))
+}
+
+// PendingEmail applies equality check predicate on the "pending_email" field. It's identical to PendingEmailEQ.
+func PendingEmail(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// EmailChangeTokenHash applies equality check predicate on the "email_change_token_hash" field. It's identical to EmailChangeTokenHashEQ.
+func EmailChangeTokenHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeExpiresAt applies equality check predicate on the "email_change_expires_at" field. It's identical to EmailChangeExpiresAtEQ.
+func EmailChangeExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// DeletionCodeHash applies equality check predicate on the "deletion_code_hash" field. It's identical to DeletionCodeHashEQ.
+func DeletionCodeHash(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeExpiresAt applies equality check predicate on the "deletion_code_expires_at" field. It's identical to DeletionCodeExpiresAtEQ.
+func DeletionCodeExpiresAt(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionCodeExpiresAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -525,6 +570,536 @@ func VerificationTokenExpiresAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldVerificationTokenExpiresAt))
 }
 
+// LockedUntilEQ applies the EQ predicate on the "locked_until" field.
+func LockedUntilEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilNEQ applies the NEQ predicate on the "locked_until" field.
+func LockedUntilNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldLockedUntil, v))
+}
+
+// LockedUntilIn applies the In predicate on the "locked_until" field.
+func LockedUntilIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilNotIn applies the NotIn predicate on the "locked_until" field.
+func LockedUntilNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldLockedUntil, vs...))
+}
+
+// LockedUntilGT applies the GT predicate on the "locked_until" field.
+func LockedUntilGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldLockedUntil, v))
+}
+
+// LockedUntilGTE applies the GTE predicate on the "locked_until" field.
+func LockedUntilGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldLockedUntil, v))
+}
+
+// LockedUntilLT applies the LT predicate on the "locked_until" field.
+func LockedUntilLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldLockedUntil, v))
+}
+
+// LockedUntilLTE applies the LTE predicate on the "locked_until" field.
+func LockedUntilLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldLockedUntil, v))
+}
+
+// LockedUntilIsNil applies the IsNil predicate on the "locked_until" field.
+func LockedUntilIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldLockedUntil))
+}
+
+// LockedUntilNotNil applies the NotNil predicate on the "locked_until" field.
+func LockedUntilNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldLockedUntil))
+}
+
+// MfaEnabledEQ applies the EQ predicate on the "mfa_enabled" field.
+func MfaEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
+// MfaEnabledNEQ applies the NEQ predicate on the "mfa_enabled" field.
+func MfaEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaEnabled, v))
+}
+
+// MfaSecretEQ applies the EQ predicate on the "mfa_secret" field.
+func MfaSecretEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaSecret, v))
+}
+
+// MfaSecretNEQ applies the NEQ predicate on the "mfa_secret" field.
+func MfaSecretNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaSecret, v))
+}
+
+// MfaSecretIn applies the In predicate on the "mfa_secret" field.
+func MfaSecretIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaSecret, vs...))
+}
+
+// MfaSecretNotIn applies the NotIn predicate on the "mfa_secret" field.
+func MfaSecretNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaSecret, vs...))
+}
+
+// MfaSecretGT applies the GT predicate on the "mfa_secret" field.
+func MfaSecretGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaSecret, v))
+}
+
+// MfaSecretGTE applies the GTE predicate on the "mfa_secret" field.
+func MfaSecretGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaSecret, v))
+}
+
+// MfaSecretLT applies the LT predicate on the "mfa_secret" field.
+func MfaSecretLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaSecret, v))
+}
+
+// MfaSecretLTE applies the LTE predicate on the "mfa_secret" field.
+func MfaSecretLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaSecret, v))
+}
+
+// MfaSecretContains applies the Contains predicate on the "mfa_secret" field.
+func MfaSecretContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldMfaSecret, v))
+}
+
+// MfaSecretHasPrefix applies the HasPrefix predicate on the "mfa_secret" field.
+func MfaSecretHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldMfaSecret, v))
+}
+
+// MfaSecretHasSuffix applies the HasSuffix predicate on the "mfa_secret" field.
+func MfaSecretHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldMfaSecret, v))
+}
+
+// MfaSecretIsNil applies the IsNil predicate on the "mfa_secret" field.
+func MfaSecretIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaSecret))
+}
+
+// MfaSecretNotNil applies the NotNil predicate on the "mfa_secret" field.
+func MfaSecretNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaSecret))
+}
+
+// MfaSecretEqualFold applies the EqualFold predicate on the "mfa_secret" field.
+func MfaSecretEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldMfaSecret, v))
+}
+
+// MfaSecretContainsFold applies the ContainsFold predicate on the "mfa_secret" field.
+func MfaSecretContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldMfaSecret, v))
+}
+
+// MfaLastUsedStepEQ applies the EQ predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepNEQ applies the NEQ predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepIn applies the In predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMfaLastUsedStep, vs...))
+}
+
+// MfaLastUsedStepNotIn applies the NotIn predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMfaLastUsedStep, vs...))
+}
+
+// MfaLastUsedStepGT applies the GT predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepGTE applies the GTE predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepLT applies the LT predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepLTE applies the LTE predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMfaLastUsedStep, v))
+}
+
+// MfaLastUsedStepIsNil applies the IsNil predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaLastUsedStep))
+}
+
+// MfaLastUsedStepNotNil applies the NotNil predicate on the "mfa_last_used_step" field.
+func MfaLastUsedStepNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaLastUsedStep))
+}
+
+// MfaRecoveryCodeHashesIsNil applies the IsNil predicate on the "mfa_recovery_code_hashes" field.
+func MfaRecoveryCodeHashesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaRecoveryCodeHashes))
+}
+
+// MfaRecoveryCodeHashesNotNil applies the NotNil predicate on the "mfa_recovery_code_hashes" field.
+func MfaRecoveryCodeHashesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaRecoveryCodeHashes))
+}
+
+// PendingEmailEQ applies the EQ predicate on the "pending_email" field.
+func PendingEmailEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailNEQ applies the NEQ predicate on the "pending_email" field.
+func PendingEmailNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailIn applies the In predicate on the "pending_email" field.
+func PendingEmailIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailNotIn applies the NotIn predicate on the "pending_email" field.
+func PendingEmailNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailGT applies the GT predicate on the "pending_email" field.
+func PendingEmailGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPendingEmail, v))
+}
+
+// PendingEmailGTE applies the GTE predicate on the "pending_email" field.
+func PendingEmailGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPendingEmail, v))
+}
+
+// PendingEmailLT applies the LT predicate on the "pending_email" field.
+func PendingEmailLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPendingEmail, v))
+}
+
+// PendingEmailLTE applies the LTE predicate on the "pending_email" field.
+func PendingEmailLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPendingEmail, v))
+}
+
+// PendingEmailContains applies the Contains predicate on the "pending_email" field.
+func PendingEmailContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPendingEmail, v))
+}
+
+// PendingEmailHasPrefix applies the HasPrefix predicate on the "pending_email" field.
+func PendingEmailHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPendingEmail, v))
+}
+
+// PendingEmailHasSuffix applies the HasSuffix predicate on the "pending_email" field.
+func PendingEmailHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPendingEmail, v))
+}
+
+// PendingEmailIsNil applies the IsNil predicate on the "pending_email" field.
+func PendingEmailIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPendingEmail))
+}
+
+// PendingEmailNotNil applies the NotNil predicate on the "pending_email" field.
+func PendingEmailNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPendingEmail))
+}
+
+// PendingEmailEqualFold applies the EqualFold predicate on the "pending_email" field.
+func PendingEmailEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPendingEmail, v))
+}
+
+// PendingEmailContainsFold applies the ContainsFold predicate on the "pending_email" field.
+func PendingEmailContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPendingEmail, v))
+}
+
+// EmailChangeTokenHashEQ applies the EQ predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashNEQ applies the NEQ predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashIn applies the In predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailChangeTokenHash, vs...))
+}
+
+// EmailChangeTokenHashNotIn applies the NotIn predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailChangeTokenHash, vs...))
+}
+
+// EmailChangeTokenHashGT applies the GT predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashGTE applies the GTE predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashLT applies the LT predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashLTE applies the LTE predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashContains applies the Contains predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashHasPrefix applies the HasPrefix predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashHasSuffix applies the HasSuffix predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashIsNil applies the IsNil predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailChangeTokenHash))
+}
+
+// EmailChangeTokenHashNotNil applies the NotNil predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailChangeTokenHash))
+}
+
+// EmailChangeTokenHashEqualFold applies the EqualFold predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeTokenHashContainsFold applies the ContainsFold predicate on the "email_change_token_hash" field.
+func EmailChangeTokenHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldEmailChangeTokenHash, v))
+}
+
+// EmailChangeExpiresAtEQ applies the EQ predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtNEQ applies the NEQ predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtIn applies the In predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldEmailChangeExpiresAt, vs...))
+}
+
+// EmailChangeExpiresAtNotIn applies the NotIn predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldEmailChangeExpiresAt, vs...))
+}
+
+// EmailChangeExpiresAtGT applies the GT predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtGTE applies the GTE predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtLT applies the LT predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtLTE applies the LTE predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldEmailChangeExpiresAt, v))
+}
+
+// EmailChangeExpiresAtIsNil applies the IsNil predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldEmailChangeExpiresAt))
+}
+
+// EmailChangeExpiresAtNotNil applies the NotNil predicate on the "email_change_expires_at" field.
+func EmailChangeExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldEmailChangeExpiresAt))
+}
+
+// DeletionCodeHashEQ applies the EQ predicate on the "deletion_code_hash" field.
+func DeletionCodeHashEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashNEQ applies the NEQ predicate on the "deletion_code_hash" field.
+func DeletionCodeHashNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashIn applies the In predicate on the "deletion_code_hash" field.
+func DeletionCodeHashIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletionCodeHash, vs...))
+}
+
+// DeletionCodeHashNotIn applies the NotIn predicate on the "deletion_code_hash" field.
+func DeletionCodeHashNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletionCodeHash, vs...))
+}
+
+// DeletionCodeHashGT applies the GT predicate on the "deletion_code_hash" field.
+func DeletionCodeHashGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashGTE applies the GTE predicate on the "deletion_code_hash" field.
+func DeletionCodeHashGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashLT applies the LT predicate on the "deletion_code_hash" field.
+func DeletionCodeHashLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashLTE applies the LTE predicate on the "deletion_code_hash" field.
+func DeletionCodeHashLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashContains applies the Contains predicate on the "deletion_code_hash" field.
+func DeletionCodeHashContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashHasPrefix applies the HasPrefix predicate on the "deletion_code_hash" field.
+func DeletionCodeHashHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashHasSuffix applies the HasSuffix predicate on the "deletion_code_hash" field.
+func DeletionCodeHashHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashIsNil applies the IsNil predicate on the "deletion_code_hash" field.
+func DeletionCodeHashIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletionCodeHash))
+}
+
+// DeletionCodeHashNotNil applies the NotNil predicate on the "deletion_code_hash" field.
+func DeletionCodeHashNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletionCodeHash))
+}
+
+// DeletionCodeHashEqualFold applies the EqualFold predicate on the "deletion_code_hash" field.
+func DeletionCodeHashEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeHashContainsFold applies the ContainsFold predicate on the "deletion_code_hash" field.
+func DeletionCodeHashContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldDeletionCodeHash, v))
+}
+
+// DeletionCodeExpiresAtEQ applies the EQ predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtNEQ applies the NEQ predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtNEQ(v time.Time) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtIn applies the In predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldIn(FieldDeletionCodeExpiresAt, vs...))
+}
+
+// DeletionCodeExpiresAtNotIn applies the NotIn predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtNotIn(vs ...time.Time) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldDeletionCodeExpiresAt, vs...))
+}
+
+// DeletionCodeExpiresAtGT applies the GT predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtGT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGT(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtGTE applies the GTE predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtGTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtLT applies the LT predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtLT(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLT(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtLTE applies the LTE predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtLTE(v time.Time) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldDeletionCodeExpiresAt, v))
+}
+
+// DeletionCodeExpiresAtIsNil applies the IsNil predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldDeletionCodeExpiresAt))
+}
+
+// DeletionCodeExpiresAtNotNil applies the NotNil predicate on the "deletion_code_expires_at" field.
+func DeletionCodeExpiresAtNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldDeletionCodeExpiresAt))
+}
+
+// PasswordHistoryHashesIsNil applies the IsNil predicate on the "password_history_hashes" field.
+func PasswordHistoryHashesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldPasswordHistoryHashes))
+}
+
+// PasswordHistoryHashesNotNil applies the NotNil predicate on the "password_history_hashes" field.
+func PasswordHistoryHashesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldPasswordHistoryHashes))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
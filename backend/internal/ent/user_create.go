@@ -110,6 +110,144 @@ func (_c *UserCreate) SetNillableVerificationTokenExpiresAt(v *time.Time) *UserC
 	return _c
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (_c *UserCreate) SetLockedUntil(v time.Time) *UserCreate {
+	_c.mutation.SetLockedUntil(v)
+	return _c
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLockedUntil(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLockedUntil(*v)
+	}
+	return _c
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_c *UserCreate) SetMfaEnabled(v bool) *UserCreate {
+	_c.mutation.SetMfaEnabled(v)
+	return _c
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMfaEnabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetMfaEnabled(*v)
+	}
+	return _c
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (_c *UserCreate) SetMfaSecret(v string) *UserCreate {
+	_c.mutation.SetMfaSecret(v)
+	return _c
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMfaSecret(v *string) *UserCreate {
+	if v != nil {
+		_c.SetMfaSecret(*v)
+	}
+	return _c
+}
+
+// SetMfaLastUsedStep sets the "mfa_last_used_step" field.
+func (_c *UserCreate) SetMfaLastUsedStep(v int64) *UserCreate {
+	_c.mutation.SetMfaLastUsedStep(v)
+	return _c
+}
+
+// SetNillableMfaLastUsedStep sets the "mfa_last_used_step" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMfaLastUsedStep(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetMfaLastUsedStep(*v)
+	}
+	return _c
+}
+
+// SetMfaRecoveryCodeHashes sets the "mfa_recovery_code_hashes" field.
+func (_c *UserCreate) SetMfaRecoveryCodeHashes(v []string) *UserCreate {
+	_c.mutation.SetMfaRecoveryCodeHashes(v)
+	return _c
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (_c *UserCreate) SetPendingEmail(v string) *UserCreate {
+	_c.mutation.SetPendingEmail(v)
+	return _c
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePendingEmail(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPendingEmail(*v)
+	}
+	return _c
+}
+
+// SetEmailChangeTokenHash sets the "email_change_token_hash" field.
+func (_c *UserCreate) SetEmailChangeTokenHash(v string) *UserCreate {
+	_c.mutation.SetEmailChangeTokenHash(v)
+	return _c
+}
+
+// SetNillableEmailChangeTokenHash sets the "email_change_token_hash" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailChangeTokenHash(v *string) *UserCreate {
+	if v != nil {
+		_c.SetEmailChangeTokenHash(*v)
+	}
+	return _c
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (_c *UserCreate) SetEmailChangeExpiresAt(v time.Time) *UserCreate {
+	_c.mutation.SetEmailChangeExpiresAt(v)
+	return _c
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableEmailChangeExpiresAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetEmailChangeExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetDeletionCodeHash sets the "deletion_code_hash" field.
+func (_c *UserCreate) SetDeletionCodeHash(v string) *UserCreate {
+	_c.mutation.SetDeletionCodeHash(v)
+	return _c
+}
+
+// SetNillableDeletionCodeHash sets the "deletion_code_hash" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeletionCodeHash(v *string) *UserCreate {
+	if v != nil {
+		_c.SetDeletionCodeHash(*v)
+	}
+	return _c
+}
+
+// SetDeletionCodeExpiresAt sets the "deletion_code_expires_at" field.
+func (_c *UserCreate) SetDeletionCodeExpiresAt(v time.Time) *UserCreate {
+	_c.mutation.SetDeletionCodeExpiresAt(v)
+	return _c
+}
+
+// SetNillableDeletionCodeExpiresAt sets the "deletion_code_expires_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableDeletionCodeExpiresAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetDeletionCodeExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetPasswordHistoryHashes sets the "password_history_hashes" field.
+func (_c *UserCreate) SetPasswordHistoryHashes(v []string) *UserCreate {
+	_c.mutation.SetPasswordHistoryHashes(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -171,7 +309,9 @@ func (_c *UserCreate) Mutation() *UserMutation {
 
 // Save creates the User in the database.
 func (_c *UserCreate) Save(ctx context.Context) (*User, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -198,7 +338,7 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *UserCreate) defaults() {
+func (_c *UserCreate) defaults() error {
 	if _, ok := _c.mutation.Name(); !ok {
 		v := user.DefaultName
 		_c.mutation.SetName(v)
@@ -211,14 +351,25 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultEmailVerified
 		_c.mutation.SetEmailVerified(v)
 	}
+	if _, ok := _c.mutation.MfaEnabled(); !ok {
+		v := user.DefaultMfaEnabled
+		_c.mutation.SetMfaEnabled(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if user.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if user.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -261,6 +412,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.EmailVerified(); !ok {
 		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "User.email_verified"`)}
 	}
+	if _, ok := _c.mutation.MfaEnabled(); !ok {
+		return &ValidationError{Name: "mfa_enabled", err: errors.New(`ent: missing required field "User.mfa_enabled"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -338,6 +492,50 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldVerificationTokenExpiresAt, field.TypeTime, value)
 		_node.VerificationTokenExpiresAt = &value
 	}
+	if value, ok := _c.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+		_node.LockedUntil = &value
+	}
+	if value, ok := _c.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+		_node.MfaEnabled = value
+	}
+	if value, ok := _c.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+		_node.MfaSecret = &value
+	}
+	if value, ok := _c.mutation.MfaLastUsedStep(); ok {
+		_spec.SetField(user.FieldMfaLastUsedStep, field.TypeInt64, value)
+		_node.MfaLastUsedStep = &value
+	}
+	if value, ok := _c.mutation.MfaRecoveryCodeHashes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodeHashes, field.TypeJSON, value)
+		_node.MfaRecoveryCodeHashes = value
+	}
+	if value, ok := _c.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+		_node.PendingEmail = &value
+	}
+	if value, ok := _c.mutation.EmailChangeTokenHash(); ok {
+		_spec.SetField(user.FieldEmailChangeTokenHash, field.TypeString, value)
+		_node.EmailChangeTokenHash = &value
+	}
+	if value, ok := _c.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+		_node.EmailChangeExpiresAt = &value
+	}
+	if value, ok := _c.mutation.DeletionCodeHash(); ok {
+		_spec.SetField(user.FieldDeletionCodeHash, field.TypeString, value)
+		_node.DeletionCodeHash = &value
+	}
+	if value, ok := _c.mutation.DeletionCodeExpiresAt(); ok {
+		_spec.SetField(user.FieldDeletionCodeExpiresAt, field.TypeTime, value)
+		_node.DeletionCodeExpiresAt = &value
+	}
+	if value, ok := _c.mutation.PasswordHistoryHashes(); ok {
+		_spec.SetField(user.FieldPasswordHistoryHashes, field.TypeJSON, value)
+		_node.PasswordHistoryHashes = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -13,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -139,6 +140,223 @@ func (_u *UserUpdate) ClearVerificationTokenExpiresAt() *UserUpdate {
 	return _u
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (_u *UserUpdate) SetLockedUntil(v time.Time) *UserUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableLockedUntil(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *UserUpdate) ClearLockedUntil() *UserUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_u *UserUpdate) SetMfaEnabled(v bool) *UserUpdate {
+	_u.mutation.SetMfaEnabled(v)
+	return _u
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMfaEnabled(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetMfaEnabled(*v)
+	}
+	return _u
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (_u *UserUpdate) SetMfaSecret(v string) *UserUpdate {
+	_u.mutation.SetMfaSecret(v)
+	return _u
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMfaSecret(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetMfaSecret(*v)
+	}
+	return _u
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (_u *UserUpdate) ClearMfaSecret() *UserUpdate {
+	_u.mutation.ClearMfaSecret()
+	return _u
+}
+
+// SetMfaLastUsedStep sets the "mfa_last_used_step" field.
+func (_u *UserUpdate) SetMfaLastUsedStep(v int64) *UserUpdate {
+	_u.mutation.ResetMfaLastUsedStep()
+	_u.mutation.SetMfaLastUsedStep(v)
+	return _u
+}
+
+// SetNillableMfaLastUsedStep sets the "mfa_last_used_step" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMfaLastUsedStep(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetMfaLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddMfaLastUsedStep adds value to the "mfa_last_used_step" field.
+func (_u *UserUpdate) AddMfaLastUsedStep(v int64) *UserUpdate {
+	_u.mutation.AddMfaLastUsedStep(v)
+	return _u
+}
+
+// ClearMfaLastUsedStep clears the value of the "mfa_last_used_step" field.
+func (_u *UserUpdate) ClearMfaLastUsedStep() *UserUpdate {
+	_u.mutation.ClearMfaLastUsedStep()
+	return _u
+}
+
+// SetMfaRecoveryCodeHashes sets the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdate) SetMfaRecoveryCodeHashes(v []string) *UserUpdate {
+	_u.mutation.SetMfaRecoveryCodeHashes(v)
+	return _u
+}
+
+// AppendMfaRecoveryCodeHashes appends value to the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdate) AppendMfaRecoveryCodeHashes(v []string) *UserUpdate {
+	_u.mutation.AppendMfaRecoveryCodeHashes(v)
+	return _u
+}
+
+// ClearMfaRecoveryCodeHashes clears the value of the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdate) ClearMfaRecoveryCodeHashes() *UserUpdate {
+	_u.mutation.ClearMfaRecoveryCodeHashes()
+	return _u
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (_u *UserUpdate) SetPendingEmail(v string) *UserUpdate {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePendingEmail(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (_u *UserUpdate) ClearPendingEmail() *UserUpdate {
+	_u.mutation.ClearPendingEmail()
+	return _u
+}
+
+// SetEmailChangeTokenHash sets the "email_change_token_hash" field.
+func (_u *UserUpdate) SetEmailChangeTokenHash(v string) *UserUpdate {
+	_u.mutation.SetEmailChangeTokenHash(v)
+	return _u
+}
+
+// SetNillableEmailChangeTokenHash sets the "email_change_token_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailChangeTokenHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetEmailChangeTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailChangeTokenHash clears the value of the "email_change_token_hash" field.
+func (_u *UserUpdate) ClearEmailChangeTokenHash() *UserUpdate {
+	_u.mutation.ClearEmailChangeTokenHash()
+	return _u
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (_u *UserUpdate) SetEmailChangeExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetEmailChangeExpiresAt(v)
+	return _u
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableEmailChangeExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetEmailChangeExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailChangeExpiresAt clears the value of the "email_change_expires_at" field.
+func (_u *UserUpdate) ClearEmailChangeExpiresAt() *UserUpdate {
+	_u.mutation.ClearEmailChangeExpiresAt()
+	return _u
+}
+
+// SetDeletionCodeHash sets the "deletion_code_hash" field.
+func (_u *UserUpdate) SetDeletionCodeHash(v string) *UserUpdate {
+	_u.mutation.SetDeletionCodeHash(v)
+	return _u
+}
+
+// SetNillableDeletionCodeHash sets the "deletion_code_hash" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDeletionCodeHash(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetDeletionCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearDeletionCodeHash clears the value of the "deletion_code_hash" field.
+func (_u *UserUpdate) ClearDeletionCodeHash() *UserUpdate {
+	_u.mutation.ClearDeletionCodeHash()
+	return _u
+}
+
+// SetDeletionCodeExpiresAt sets the "deletion_code_expires_at" field.
+func (_u *UserUpdate) SetDeletionCodeExpiresAt(v time.Time) *UserUpdate {
+	_u.mutation.SetDeletionCodeExpiresAt(v)
+	return _u
+}
+
+// SetNillableDeletionCodeExpiresAt sets the "deletion_code_expires_at" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableDeletionCodeExpiresAt(v *time.Time) *UserUpdate {
+	if v != nil {
+		_u.SetDeletionCodeExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionCodeExpiresAt clears the value of the "deletion_code_expires_at" field.
+func (_u *UserUpdate) ClearDeletionCodeExpiresAt() *UserUpdate {
+	_u.mutation.ClearDeletionCodeExpiresAt()
+	return _u
+}
+
+// SetPasswordHistoryHashes sets the "password_history_hashes" field.
+func (_u *UserUpdate) SetPasswordHistoryHashes(v []string) *UserUpdate {
+	_u.mutation.SetPasswordHistoryHashes(v)
+	return _u
+}
+
+// AppendPasswordHistoryHashes appends value to the "password_history_hashes" field.
+func (_u *UserUpdate) AppendPasswordHistoryHashes(v []string) *UserUpdate {
+	_u.mutation.AppendPasswordHistoryHashes(v)
+	return _u
+}
+
+// ClearPasswordHistoryHashes clears the value of the "password_history_hashes" field.
+func (_u *UserUpdate) ClearPasswordHistoryHashes() *UserUpdate {
+	_u.mutation.ClearPasswordHistoryHashes()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -188,7 +406,9 @@ func (_u *UserUpdate) RemoveTodos(v ...*Todo) *UserUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -215,11 +435,15 @@ func (_u *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *UserUpdate) defaults() {
+func (_u *UserUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -284,6 +508,82 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.VerificationTokenExpiresAtCleared() {
 		_spec.ClearField(user.FieldVerificationTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if _u.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaLastUsedStep(); ok {
+		_spec.SetField(user.FieldMfaLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMfaLastUsedStep(); ok {
+		_spec.AddField(user.FieldMfaLastUsedStep, field.TypeInt64, value)
+	}
+	if _u.mutation.MfaLastUsedStepCleared() {
+		_spec.ClearField(user.FieldMfaLastUsedStep, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MfaRecoveryCodeHashes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodeHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaRecoveryCodeHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodeHashes, value)
+		})
+	}
+	if _u.mutation.MfaRecoveryCodeHashesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodeHashes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if _u.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailChangeTokenHash(); ok {
+		_spec.SetField(user.FieldEmailChangeTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailChangeTokenHashCleared() {
+		_spec.ClearField(user.FieldEmailChangeTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailChangeExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionCodeHash(); ok {
+		_spec.SetField(user.FieldDeletionCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.DeletionCodeHashCleared() {
+		_spec.ClearField(user.FieldDeletionCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletionCodeExpiresAt(); ok {
+		_spec.SetField(user.FieldDeletionCodeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionCodeExpiresAtCleared() {
+		_spec.ClearField(user.FieldDeletionCodeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordHistoryHashes(); ok {
+		_spec.SetField(user.FieldPasswordHistoryHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPasswordHistoryHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldPasswordHistoryHashes, value)
+		})
+	}
+	if _u.mutation.PasswordHistoryHashesCleared() {
+		_spec.ClearField(user.FieldPasswordHistoryHashes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -462,6 +762,223 @@ func (_u *UserUpdateOne) ClearVerificationTokenExpiresAt() *UserUpdateOne {
 	return _u
 }
 
+// SetLockedUntil sets the "locked_until" field.
+func (_u *UserUpdateOne) SetLockedUntil(v time.Time) *UserUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableLockedUntil(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_u *UserUpdateOne) SetMfaEnabled(v bool) *UserUpdateOne {
+	_u.mutation.SetMfaEnabled(v)
+	return _u
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMfaEnabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetMfaEnabled(*v)
+	}
+	return _u
+}
+
+// SetMfaSecret sets the "mfa_secret" field.
+func (_u *UserUpdateOne) SetMfaSecret(v string) *UserUpdateOne {
+	_u.mutation.SetMfaSecret(v)
+	return _u
+}
+
+// SetNillableMfaSecret sets the "mfa_secret" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMfaSecret(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetMfaSecret(*v)
+	}
+	return _u
+}
+
+// ClearMfaSecret clears the value of the "mfa_secret" field.
+func (_u *UserUpdateOne) ClearMfaSecret() *UserUpdateOne {
+	_u.mutation.ClearMfaSecret()
+	return _u
+}
+
+// SetMfaLastUsedStep sets the "mfa_last_used_step" field.
+func (_u *UserUpdateOne) SetMfaLastUsedStep(v int64) *UserUpdateOne {
+	_u.mutation.ResetMfaLastUsedStep()
+	_u.mutation.SetMfaLastUsedStep(v)
+	return _u
+}
+
+// SetNillableMfaLastUsedStep sets the "mfa_last_used_step" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMfaLastUsedStep(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetMfaLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddMfaLastUsedStep adds value to the "mfa_last_used_step" field.
+func (_u *UserUpdateOne) AddMfaLastUsedStep(v int64) *UserUpdateOne {
+	_u.mutation.AddMfaLastUsedStep(v)
+	return _u
+}
+
+// ClearMfaLastUsedStep clears the value of the "mfa_last_used_step" field.
+func (_u *UserUpdateOne) ClearMfaLastUsedStep() *UserUpdateOne {
+	_u.mutation.ClearMfaLastUsedStep()
+	return _u
+}
+
+// SetMfaRecoveryCodeHashes sets the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdateOne) SetMfaRecoveryCodeHashes(v []string) *UserUpdateOne {
+	_u.mutation.SetMfaRecoveryCodeHashes(v)
+	return _u
+}
+
+// AppendMfaRecoveryCodeHashes appends value to the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdateOne) AppendMfaRecoveryCodeHashes(v []string) *UserUpdateOne {
+	_u.mutation.AppendMfaRecoveryCodeHashes(v)
+	return _u
+}
+
+// ClearMfaRecoveryCodeHashes clears the value of the "mfa_recovery_code_hashes" field.
+func (_u *UserUpdateOne) ClearMfaRecoveryCodeHashes() *UserUpdateOne {
+	_u.mutation.ClearMfaRecoveryCodeHashes()
+	return _u
+}
+
+// SetPendingEmail sets the "pending_email" field.
+func (_u *UserUpdateOne) SetPendingEmail(v string) *UserUpdateOne {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePendingEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
+// ClearPendingEmail clears the value of the "pending_email" field.
+func (_u *UserUpdateOne) ClearPendingEmail() *UserUpdateOne {
+	_u.mutation.ClearPendingEmail()
+	return _u
+}
+
+// SetEmailChangeTokenHash sets the "email_change_token_hash" field.
+func (_u *UserUpdateOne) SetEmailChangeTokenHash(v string) *UserUpdateOne {
+	_u.mutation.SetEmailChangeTokenHash(v)
+	return _u
+}
+
+// SetNillableEmailChangeTokenHash sets the "email_change_token_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailChangeTokenHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailChangeTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearEmailChangeTokenHash clears the value of the "email_change_token_hash" field.
+func (_u *UserUpdateOne) ClearEmailChangeTokenHash() *UserUpdateOne {
+	_u.mutation.ClearEmailChangeTokenHash()
+	return _u
+}
+
+// SetEmailChangeExpiresAt sets the "email_change_expires_at" field.
+func (_u *UserUpdateOne) SetEmailChangeExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetEmailChangeExpiresAt(v)
+	return _u
+}
+
+// SetNillableEmailChangeExpiresAt sets the "email_change_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmailChangeExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmailChangeExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailChangeExpiresAt clears the value of the "email_change_expires_at" field.
+func (_u *UserUpdateOne) ClearEmailChangeExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearEmailChangeExpiresAt()
+	return _u
+}
+
+// SetDeletionCodeHash sets the "deletion_code_hash" field.
+func (_u *UserUpdateOne) SetDeletionCodeHash(v string) *UserUpdateOne {
+	_u.mutation.SetDeletionCodeHash(v)
+	return _u
+}
+
+// SetNillableDeletionCodeHash sets the "deletion_code_hash" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeletionCodeHash(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeletionCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearDeletionCodeHash clears the value of the "deletion_code_hash" field.
+func (_u *UserUpdateOne) ClearDeletionCodeHash() *UserUpdateOne {
+	_u.mutation.ClearDeletionCodeHash()
+	return _u
+}
+
+// SetDeletionCodeExpiresAt sets the "deletion_code_expires_at" field.
+func (_u *UserUpdateOne) SetDeletionCodeExpiresAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetDeletionCodeExpiresAt(v)
+	return _u
+}
+
+// SetNillableDeletionCodeExpiresAt sets the "deletion_code_expires_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableDeletionCodeExpiresAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetDeletionCodeExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionCodeExpiresAt clears the value of the "deletion_code_expires_at" field.
+func (_u *UserUpdateOne) ClearDeletionCodeExpiresAt() *UserUpdateOne {
+	_u.mutation.ClearDeletionCodeExpiresAt()
+	return _u
+}
+
+// SetPasswordHistoryHashes sets the "password_history_hashes" field.
+func (_u *UserUpdateOne) SetPasswordHistoryHashes(v []string) *UserUpdateOne {
+	_u.mutation.SetPasswordHistoryHashes(v)
+	return _u
+}
+
+// AppendPasswordHistoryHashes appends value to the "password_history_hashes" field.
+func (_u *UserUpdateOne) AppendPasswordHistoryHashes(v []string) *UserUpdateOne {
+	_u.mutation.AppendPasswordHistoryHashes(v)
+	return _u
+}
+
+// ClearPasswordHistoryHashes clears the value of the "password_history_hashes" field.
+func (_u *UserUpdateOne) ClearPasswordHistoryHashes() *UserUpdateOne {
+	_u.mutation.ClearPasswordHistoryHashes()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -524,7 +1041,9 @@ func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
 
 // Save executes the query and returns the updated User entity.
 func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -551,11 +1070,15 @@ func (_u *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *UserUpdateOne) defaults() {
+func (_u *UserUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if user.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized user.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := user.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -637,6 +1160,82 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.VerificationTokenExpiresAtCleared() {
 		_spec.ClearField(user.FieldVerificationTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaSecret(); ok {
+		_spec.SetField(user.FieldMfaSecret, field.TypeString, value)
+	}
+	if _u.mutation.MfaSecretCleared() {
+		_spec.ClearField(user.FieldMfaSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.MfaLastUsedStep(); ok {
+		_spec.SetField(user.FieldMfaLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMfaLastUsedStep(); ok {
+		_spec.AddField(user.FieldMfaLastUsedStep, field.TypeInt64, value)
+	}
+	if _u.mutation.MfaLastUsedStepCleared() {
+		_spec.ClearField(user.FieldMfaLastUsedStep, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.MfaRecoveryCodeHashes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodeHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaRecoveryCodeHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodeHashes, value)
+		})
+	}
+	if _u.mutation.MfaRecoveryCodeHashesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodeHashes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(user.FieldPendingEmail, field.TypeString, value)
+	}
+	if _u.mutation.PendingEmailCleared() {
+		_spec.ClearField(user.FieldPendingEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailChangeTokenHash(); ok {
+		_spec.SetField(user.FieldEmailChangeTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.EmailChangeTokenHashCleared() {
+		_spec.ClearField(user.FieldEmailChangeTokenHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.EmailChangeExpiresAt(); ok {
+		_spec.SetField(user.FieldEmailChangeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailChangeExpiresAtCleared() {
+		_spec.ClearField(user.FieldEmailChangeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionCodeHash(); ok {
+		_spec.SetField(user.FieldDeletionCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.DeletionCodeHashCleared() {
+		_spec.ClearField(user.FieldDeletionCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.DeletionCodeExpiresAt(); ok {
+		_spec.SetField(user.FieldDeletionCodeExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionCodeExpiresAtCleared() {
+		_spec.ClearField(user.FieldDeletionCodeExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PasswordHistoryHashes(); ok {
+		_spec.SetField(user.FieldPasswordHistoryHashes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPasswordHistoryHashes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldPasswordHistoryHashes, value)
+		})
+	}
+	if _u.mutation.PasswordHistoryHashesCleared() {
+		_spec.ClearField(user.FieldPasswordHistoryHashes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
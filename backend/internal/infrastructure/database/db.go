@@ -2,46 +2,186 @@ package database
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
 
 	"good-todo-go/internal/ent"
+	_ "good-todo-go/internal/ent/runtime"
 	"good-todo-go/internal/infrastructure/environment"
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
-	_ "github.com/lib/pq"
+	"github.com/XSAM/otelsql"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // NewEntClient creates an ent.Client for database operations
 // Note: All database access should use TenantScopedTx for RLS enforcement
-func NewEntClient(cfg *environment.Config) (*ent.Client, error) {
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DBHost,
-		cfg.DBPort,
-		cfg.DBUser,
-		cfg.DBPassword,
-		cfg.DBName,
-	)
+func NewEntClient(cfg *environment.Config, logger *slog.Logger, tp trace.TracerProvider, reg prometheus.Registerer) (*ent.Client, error) {
+	return openEntClient(cfg, BuildDSN(cfg), cfg.DBName, logger, tp, reg)
+}
 
-	// Open sql.DB
-	db, err := sql.Open("postgres", dsn)
+// openEntClient opens a pool with the configured limits; poolName labels its metrics
+func openEntClient(cfg *environment.Config, dsn, poolName string, logger *slog.Logger, tp trace.TracerProvider, reg prometheus.Registerer) (*ent.Client, error) {
+	// Open sql.DB, recording a span for each query (including the tenant set_config of TenantScopedTx).
+	// Spans carry the statement but never its arguments
+	db, err := otelsql.Open("postgres", dsn,
+		otelsql.WithTracerProvider(tp),
+		otelsql.WithAttributes(semconv.DBSystemNamePostgreSQL, semconv.DBNamespace(cfg.DBName)),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			DisableErrSkip:       true,
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed opening connection to postgres: %w", err)
 	}
+	db.SetMaxOpenConns(cfg.DBMaxOpenConns)
+	db.SetMaxIdleConns(cfg.DBMaxIdleConns)
+	db.SetConnMaxLifetime(cfg.DBConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.DBConnMaxIdleTime)
+
+	// Fail at startup rather than on the first request when the database is unreachable
+	if err := pingWithRetry(context.Background(), db, cfg.DBConnectAttempts, cfg.DBConnectBackoff, logger); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed connecting to postgres: %w", err)
+	}
+
+	// Connection pool metrics (go_sql_*) from db.Stats()
+	if err := reg.Register(collectors.NewDBStatsCollector(db, poolName)); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to register connection pool metrics: %w", err)
+	}
 
 	// Create ent client from sql.DB
-	drv := entsql.OpenDB("postgres", db)
+	var drv dialect.Driver = entsql.OpenDB("postgres", db)
+	// LOG_LEVEL=debug でクエリをログに出す (引数にはパスワードハッシュやトークンが含まれるため出さない)
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		drv = dialect.DebugWithContext(drv, func(ctx context.Context, args ...any) {
+			logger.DebugContext(ctx, "query", "query", withoutArgs(fmt.Sprint(args...)))
+		})
+	}
 	client := ent.NewClient(ent.Driver(drv))
 
-	// デバッグモードを有効化（開発時のみ）
-	if cfg.AppEnv == "local" {
-		client = client.Debug()
+	return client, nil
+}
+
+// withoutArgs drops the " args=[...]" that ent's debug driver appends to each query
+func withoutArgs(entry string) string {
+	if i := strings.LastIndex(entry, " args="); i >= 0 {
+		return entry[:i]
 	}
+	return entry
+}
 
-	return client, nil
+// maxConnectBackoff caps the wait between startup pings
+const maxConnectBackoff = 30 * time.Second
+
+// pingTimeout bounds each startup ping, so an unresponsive host counts as a failed attempt
+const pingTimeout = 5 * time.Second
+
+type pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// pingWithRetry pings the database up to attempts times, doubling the wait after each failure
+func pingWithRetry(ctx context.Context, db pinger, attempts int, backoff time.Duration, logger *slog.Logger) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		err = db.PingContext(pingCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= attempts {
+			return fmt.Errorf("gave up after %d attempts: %w", attempt, err)
+		}
+		logger.WarnContext(ctx, "database not reachable, retrying", "attempt", attempt, "retry_in", backoff.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
+}
+
+// BuildDSN builds the PostgreSQL connection string from the config.
+// Settings lib/pq does not know (statement_timeout) are sent to the server as session parameters
+func BuildDSN(cfg *environment.Config) string {
+	params := [][2]string{
+		{"host", cfg.DBHost},
+		{"port", cfg.DBPort},
+		{"user", cfg.DBUser},
+		{"password", cfg.DBPassword},
+		{"dbname", cfg.DBName},
+		{"sslmode", cfg.DBSSLMode},
+	}
+	if cfg.DBSSLRootCert != "" {
+		params = append(params, [2]string{"sslrootcert", cfg.DBSSLRootCert})
+	}
+	return joinDSN(append(params, sessionParams(cfg)...))
+}
+
+// BuildReplicaDSN adds the primary's session parameters (application_name, statement_timeout)
+// to POSTGRES_DB_REPLICA_DSN, which may be a URL or a keyword/value string.
+// They override the same parameters in the DSN
+func BuildReplicaDSN(cfg *environment.Config) (string, error) {
+	dsn := cfg.DBReplicaDSN
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		parsed, err := pq.ParseURL(dsn)
+		if err != nil {
+			return "", fmt.Errorf("invalid POSTGRES_DB_REPLICA_DSN: %w", err)
+		}
+		dsn = parsed
+	}
+	session := sessionParams(cfg)
+	if len(session) == 0 {
+		return dsn, nil
+	}
+	// lib/pq keeps the last value of a repeated keyword
+	return dsn + " " + joinDSN(session), nil
+}
+
+// sessionParams are the settings every pool sends when connecting
+func sessionParams(cfg *environment.Config) [][2]string {
+	var params [][2]string
+	if cfg.DBApplicationName != "" {
+		params = append(params, [2]string{"application_name", cfg.DBApplicationName})
+	}
+	if cfg.DBStatementTimeout > 0 {
+		params = append(params, [2]string{"statement_timeout", strconv.FormatInt(cfg.DBStatementTimeout.Milliseconds(), 10)})
+	}
+	return params
+}
+
+// joinDSN builds a keyword/value connection string
+func joinDSN(params [][2]string) string {
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		parts = append(parts, p[0]+"="+quoteDSNValue(p[1]))
+	}
+	return strings.Join(parts, " ")
+}
+
+// quoteDSNValue quotes a keyword/value connection string value, so passwords may contain spaces and quotes
+func quoteDSNValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `'`, `\'`)
+	return "'" + v + "'"
 }
 
+// CloseEntClient closes the client and its connection pool
 func CloseEntClient(client *ent.Client) error {
 	if client != nil {
 		return client.Close()
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/infrastructure/environment"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// stickySweepInterval is how often expired write records are dropped
+const stickySweepInterval = time.Minute
+
+// ReadReplica routes tenant-scoped reads to a read replica, except for users who
+// changed something within the sticky window, whose reads stay on the primary.
+// Writes are recorded in memory, so each instance only knows about the writes it served.
+// A nil ReadReplica sends every read to the primary.
+type ReadReplica struct {
+	client *ent.Client
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	lastWrite map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewReadReplica connects to POSTGRES_DB_REPLICA_DSN. Without it, or when the replica
+// cannot be reached at startup, reads go to the primary
+func NewReadReplica(cfg *environment.Config, logger *slog.Logger, tp trace.TracerProvider, reg prometheus.Registerer) (*ReadReplica, error) {
+	if cfg.DBReplicaDSN == "" {
+		return nil, nil
+	}
+	dsn, err := BuildReplicaDSN(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := openEntClient(cfg, dsn, cfg.DBName+"_replica", logger, tp, reg)
+	if err != nil {
+		// The replica only offloads reads, so the API still starts without it
+		logger.Warn("read replica unavailable, reading from the primary until restart", "error", err)
+		return nil, nil
+	}
+	return NewReadReplicaWithClient(client, cfg.DBReplicaStickyWindow, time.Now), nil
+}
+
+// NewReadReplicaWithClient routes reads to client
+func NewReadReplicaWithClient(client *ent.Client, window time.Duration, now func() time.Time) *ReadReplica {
+	return &ReadReplica{
+		client:    client,
+		window:    window,
+		now:       now,
+		lastWrite: make(map[string]time.Time),
+	}
+}
+
+// Enabled reports whether reads can go to a replica
+func (r *ReadReplica) Enabled() bool {
+	return r != nil
+}
+
+// MarkWrite keeps the reads of key (a tenant and user) on the primary for the sticky window
+func (r *ReadReplica) MarkWrite(key string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.sweep(now)
+	r.lastWrite[key] = now
+}
+
+// RecentlyWrote reports whether key changed something within the sticky window
+func (r *ReadReplica) RecentlyWrote(key string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	at, ok := r.lastWrite[key]
+	return ok && r.now().Sub(at) < r.window
+}
+
+// sweep drops writes older than the window, which behave the same as missing ones
+func (r *ReadReplica) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < stickySweepInterval {
+		return
+	}
+	r.lastSweep = now
+	for key, at := range r.lastWrite {
+		if now.Sub(at) >= r.window {
+			delete(r.lastWrite, key)
+		}
+	}
+}
+
+// Close closes the replica's connection pool
+func (r *ReadReplica) Close() error {
+	if r == nil {
+		return nil
+	}
+	return CloseEntClient(r.client)
+}
+
+type primaryReadsContextKey struct{}
+
+// WithPrimaryReads sends the ReadScopedTx calls made with ctx to the primary
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsContextKey{}, true)
+}
+
+// useReplica reports whether a read made with ctx may go to the replica
+func (r *ReadReplica) useReplica(ctx context.Context) bool {
+	if r == nil {
+		return false
+	}
+	if _, ok := ctx.Value(sharedTxContextKey{}).(*sharedTx); ok {
+		// Reads inside RunInTenantTx must see its writes
+		return false
+	}
+	primary, _ := ctx.Value(primaryReadsContextKey{}).(bool)
+	return !primary
+}
+
+// ReadScopedTx is TenantScopedTx for reads that tolerate replication lag, such as listings and counts.
+// It starts a read-only transaction on the replica, with the tenant context set the same way,
+// unless ctx asks for primary reads or is inside RunInTenantTx.
+// If the replica cannot start a transaction, the primary is used.
+func ReadScopedTx(ctx context.Context, primary *ent.Client, replica *ReadReplica) (*ent.Tx, error) {
+	if !replica.useReplica(ctx) {
+		return TenantScopedTx(ctx, primary)
+	}
+
+	tenantID, ok := GetTenantID(ctx)
+	if !ok || tenantID == "" {
+		return nil, fmt.Errorf("tenant ID not found in context")
+	}
+	tx, err := beginTenantScope(ctx, replica.client, tenantID, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		slog.WarnContext(ctx, "read replica unavailable, reading from the primary", "error", err)
+		return WithTenantScope(ctx, primary, tenantID)
+	}
+	return tx, nil
+}
@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/infrastructure/environment"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestReadReplica_StickyWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	replica := NewReadReplicaWithClient(nil, 5*time.Second, func() time.Time { return now })
+
+	replica.MarkWrite("tenant-1:user-1")
+
+	assert.True(t, replica.RecentlyWrote("tenant-1:user-1"))
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-2"))
+
+	now = now.Add(5 * time.Second)
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-1"))
+
+	// Expired writes are swept on a later write
+	now = now.Add(stickySweepInterval)
+	replica.MarkWrite("tenant-1:user-2")
+	assert.NotContains(t, replica.lastWrite, "tenant-1:user-1")
+}
+
+func TestReadReplica_UseReplica(t *testing.T) {
+	t.Parallel()
+
+	replica := NewReadReplicaWithClient(nil, 5*time.Second, time.Now)
+	ctx := context.Background()
+
+	assert.True(t, replica.useReplica(ctx))
+	assert.False(t, replica.useReplica(WithPrimaryReads(ctx)), "primary reads requested")
+	assert.False(t, replica.useReplica(context.WithValue(ctx, sharedTxContextKey{}, &sharedTx{})), "inside RunInTenantTx")
+}
+
+func TestReadReplica_Nil(t *testing.T) {
+	t.Parallel()
+
+	var replica *ReadReplica
+
+	assert.False(t, replica.Enabled())
+	assert.False(t, replica.useReplica(context.Background()))
+	replica.MarkWrite("tenant-1:user-1")
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-1"))
+	assert.NoError(t, replica.Close())
+}
+
+func TestNewReadReplica_UnreachableFallsBackToPrimary(t *testing.T) {
+	t.Parallel()
+
+	cfg := &environment.Config{
+		DBName:            "goodtodo",
+		DBReplicaDSN:      "host=127.0.0.1 port=1 user=u password=p dbname=goodtodo sslmode=disable connect_timeout=1",
+		DBMaxOpenConns:    1,
+		DBConnectAttempts: 1,
+	}
+
+	replica, err := NewReadReplica(cfg, slog.New(slog.DiscardHandler), tracenoop.NewTracerProvider(), prometheus.NewRegistry())
+
+	require.NoError(t, err)
+	assert.False(t, replica.Enabled())
+}
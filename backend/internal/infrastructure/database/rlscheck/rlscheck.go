@@ -0,0 +1,319 @@
+// Package rlscheck verifies that PostgreSQL Row Level Security isolates every
+// tenant scoped table generated from the ent schema.
+package rlscheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/migrate"
+	"good-todo-go/internal/infrastructure/database"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// TenantColumn is the column that RLS policies filter on
+const TenantColumn = "tenant_id"
+
+// insufficientPrivilege is the SQLSTATE raised when a row violates an RLS policy
+// or the role lacks the table privilege
+const insufficientPrivilege = "42501"
+
+type Operation string
+
+const (
+	OperationSelect Operation = "select"
+	OperationInsert Operation = "insert"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// Result is the outcome of a single cross-tenant attempt
+type Result struct {
+	Table     string
+	Operation Operation
+	// Actor is the tenant whose scope was used, Target the tenant it tried to reach
+	Actor  string
+	Target string
+	Leaked bool
+	Detail string
+}
+
+// Report collects the results of a Checker run
+type Report struct {
+	Results []Result
+}
+
+// Leaks returns the results where data crossed the tenant boundary
+func (r *Report) Leaks() []Result {
+	var leaks []Result
+	for _, res := range r.Results {
+		if res.Leaked {
+			leaks = append(leaks, res)
+		}
+	}
+	return leaks
+}
+
+// OK reports whether no leak was found
+func (r *Report) OK() bool {
+	return len(r.Leaks()) == 0
+}
+
+// Checker attempts cross-tenant reads and writes on every tenant table.
+// The client must connect as a role without BYPASSRLS (e.g. goodtodo_app),
+// otherwise every check would trivially leak.
+type Checker struct {
+	client *ent.Client
+	tables []*schema.Table
+}
+
+func NewChecker(client *ent.Client) *Checker {
+	return &Checker{
+		client: client,
+		tables: TenantTables(),
+	}
+}
+
+// TenantTables returns the ent tables that carry a tenant_id column
+func TenantTables() []*schema.Table {
+	var tables []*schema.Table
+	for _, t := range migrate.Tables {
+		if t.HasColumn(TenantColumn) {
+			tables = append(tables, t)
+		}
+	}
+	return tables
+}
+
+// Run checks isolation in both directions between tenantA and tenantB.
+// Every attempt runs in its own tenant scoped transaction which is always rolled back.
+func (c *Checker) Run(ctx context.Context, tenantA, tenantB string) (*Report, error) {
+	if tenantA == "" || tenantB == "" || tenantA == tenantB {
+		return nil, errors.New("two distinct tenant IDs are required")
+	}
+
+	if err := c.ensureRLSEnforced(ctx); err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, t := range c.tables {
+		for _, pair := range [][2]string{{tenantA, tenantB}, {tenantB, tenantA}} {
+			actor, target := pair[0], pair[1]
+			checks := []func(context.Context, *schema.Table, string, string) (Result, error){
+				c.checkSelect,
+				c.checkInsert,
+				c.checkUpdate,
+				c.checkDelete,
+			}
+			for _, check := range checks {
+				res, err := check(ctx, t, actor, target)
+				if err != nil {
+					return nil, err
+				}
+				report.Results = append(report.Results, res)
+			}
+		}
+	}
+	return report, nil
+}
+
+// ensureRLSEnforced fails when the connected role would bypass RLS
+func (c *Checker) ensureRLSEnforced(ctx context.Context) error {
+	rows, err := c.client.QueryContext(ctx,
+		"SELECT current_user, rolsuper OR rolbypassrls FROM pg_roles WHERE rolname = current_user")
+	if err != nil {
+		return fmt.Errorf("failed to inspect current role: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return errors.New("current role not found in pg_roles")
+	}
+	var (
+		role     string
+		bypasses bool
+	)
+	if err := rows.Scan(&role, &bypasses); err != nil {
+		return fmt.Errorf("failed to inspect current role: %w", err)
+	}
+	if bypasses {
+		return fmt.Errorf("role %q bypasses row level security, connect as the application role instead", role)
+	}
+	return rows.Err()
+}
+
+// checkSelect counts rows outside the actor's tenant
+func (c *Checker) checkSelect(ctx context.Context, t *schema.Table, actor, target string) (Result, error) {
+	res := Result{Table: t.Name, Operation: OperationSelect, Actor: actor, Target: target}
+
+	err := c.withScope(ctx, actor, func(tx *ent.Tx) error {
+		query := fmt.Sprintf("SELECT count(*) FROM %s WHERE %s <> $1", quote(t.Name), quote(TenantColumn))
+		rows, err := tx.QueryContext(ctx, query, actor)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var count int
+		if rows.Next() {
+			if err := rows.Scan(&count); err != nil {
+				return err
+			}
+		}
+		if count > 0 {
+			res.Leaked = true
+			res.Detail = fmt.Sprintf("%d foreign rows visible", count)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return res, fmt.Errorf("select on %s: %w", t.Name, err)
+	}
+	return res, nil
+}
+
+// checkInsert writes a row tagged with the target tenant, which must be rejected by the policy
+func (c *Checker) checkInsert(ctx context.Context, t *schema.Table, actor, target string) (Result, error) {
+	res := Result{Table: t.Name, Operation: OperationInsert, Actor: actor, Target: target}
+
+	columns := []string{quote(TenantColumn)}
+	args := []any{target}
+	for _, col := range t.PrimaryKey {
+		if col.Name == TenantColumn || col.Type != field.TypeString {
+			continue
+		}
+		columns = append(columns, quote(col.Name))
+		args = append(args, uuid.New().String())
+	}
+	placeholders := make([]string, len(args))
+	for i := range args {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+
+	err := c.withScope(ctx, actor, func(tx *ent.Tx) error {
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", quote(t.Name), strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	switch {
+	case err == nil:
+		res.Leaked = true
+		res.Detail = "row for another tenant was inserted"
+	case !isPolicyViolation(err):
+		// Anything other than the RLS rejection means the policy did not stop the write
+		res.Leaked = true
+		res.Detail = fmt.Sprintf("insert was not rejected by RLS: %v", err)
+	}
+	return res, nil
+}
+
+// checkUpdate touches foreign rows and tries to move the actor's rows to the target tenant
+func (c *Checker) checkUpdate(ctx context.Context, t *schema.Table, actor, target string) (Result, error) {
+	res := Result{Table: t.Name, Operation: OperationUpdate, Actor: actor, Target: target}
+
+	err := c.withScope(ctx, actor, func(tx *ent.Tx) error {
+		query := fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s <> $1",
+			quote(t.Name), quote(TenantColumn), quote(TenantColumn), quote(TenantColumn))
+		result, err := tx.ExecContext(ctx, query, actor)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected > 0 {
+			res.Leaked = true
+			res.Detail = fmt.Sprintf("%d foreign rows updated", affected)
+		}
+		return nil
+	})
+	if isPolicyViolation(err) {
+		// Tables such as audit_events deny UPDATE to the application role entirely
+		res.Detail = "update denied"
+		return res, nil
+	}
+	if err != nil {
+		return res, fmt.Errorf("update on %s: %w", t.Name, err)
+	}
+	if res.Leaked {
+		return res, nil
+	}
+
+	err = c.withScope(ctx, actor, func(tx *ent.Tx) error {
+		query := fmt.Sprintf("UPDATE %s SET %s = $2 WHERE %s = $1",
+			quote(t.Name), quote(TenantColumn), quote(TenantColumn))
+		result, err := tx.ExecContext(ctx, query, actor, target)
+		if err != nil {
+			return err
+		}
+		if affected, _ := result.RowsAffected(); affected > 0 {
+			res.Leaked = true
+			res.Detail = fmt.Sprintf("%d rows moved to another tenant", affected)
+		}
+		return nil
+	})
+	if err != nil && !isPolicyViolation(err) {
+		res.Leaked = true
+		res.Detail = fmt.Sprintf("update was not rejected by RLS: %v", err)
+	}
+	return res, nil
+}
+
+// checkDelete deletes rows outside the actor's tenant
+func (c *Checker) checkDelete(ctx context.Context, t *schema.Table, actor, target string) (Result, error) {
+	res := Result{Table: t.Name, Operation: OperationDelete, Actor: actor, Target: target}
+
+	err := c.withScope(ctx, actor, func(tx *ent.Tx) error {
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s <> $1", quote(t.Name), quote(TenantColumn))
+		result, err := tx.ExecContext(ctx, query, actor)
+		if err != nil {
+			return err
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected > 0 {
+			res.Leaked = true
+			res.Detail = fmt.Sprintf("%d foreign rows deleted", affected)
+		}
+		return nil
+	})
+	if isPolicyViolation(err) {
+		res.Detail = "delete denied"
+		return res, nil
+	}
+	if err != nil {
+		return res, fmt.Errorf("delete on %s: %w", t.Name, err)
+	}
+	return res, nil
+}
+
+// withScope runs fn inside database.WithTenantScope and always rolls back
+func (c *Checker) withScope(ctx context.Context, tenantID string, fn func(tx *ent.Tx) error) error {
+	tx, err := database.WithTenantScope(ctx, c.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	return fn(tx)
+}
+
+func isPolicyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == insufficientPrivilege
+}
+
+func quote(ident string) string {
+	return pq.QuoteIdentifier(ident)
+}
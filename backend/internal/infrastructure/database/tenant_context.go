@@ -24,12 +24,24 @@ func GetTenantID(ctx context.Context) (string, bool) {
 	return tenantID, ok
 }
 
+// execer is satisfied by *sql.DB, *sql.Tx and *ent.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// SetLocalTenantID sets the tenant for RLS until the end of the current transaction.
+// set_config takes the tenant ID as a bind parameter, so it is never parsed as SQL
+func SetLocalTenantID(ctx context.Context, tx execer, tenantID string) error {
+	if _, err := tx.ExecContext(ctx, "SELECT set_config('app.current_tenant_id', $1, true)", tenantID); err != nil {
+		return fmt.Errorf("failed to set tenant context: %w", err)
+	}
+	return nil
+}
+
 // SetTenantContext sets the PostgreSQL session variable for RLS
 // This must be called at the beginning of each request/transaction
 func SetTenantContext(ctx context.Context, db *sql.DB, tenantID string) error {
-	query := fmt.Sprintf("SET app.current_tenant_id = '%s'", tenantID)
-	_, err := db.ExecContext(ctx, query)
-	if err != nil {
+	if _, err := db.ExecContext(ctx, "SELECT set_config('app.current_tenant_id', $1, false)", tenantID); err != nil {
 		return fmt.Errorf("failed to set tenant context: %w", err)
 	}
 	return nil
@@ -37,19 +49,17 @@ func SetTenantContext(ctx context.Context, db *sql.DB, tenantID string) error {
 
 // WithTenantTx executes a function within a transaction with tenant context set
 // This is the recommended way to use RLS with connection pooling
-// IMPORTANT: SET LOCAL is used to ensure the setting is scoped to the transaction only
+// IMPORTANT: the setting is transaction-local, so it never leaks to other users of the connection
 func WithTenantTx(ctx context.Context, db *sql.DB, tenantID string, fn func(tx *sql.Tx) error) error {
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
 
-	// Set tenant context within transaction using SET LOCAL
-	// SET LOCAL ensures the setting is only for this transaction (connection pool safe)
-	query := fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", tenantID)
-	if _, err := tx.ExecContext(ctx, query); err != nil {
+	// Set tenant context within transaction only (connection pool safe)
+	if err := SetLocalTenantID(ctx, tx, tenantID); err != nil {
 		_ = tx.Rollback()
-		return fmt.Errorf("failed to set tenant context: %w", err)
+		return err
 	}
 
 	// Execute the function
@@ -86,18 +96,20 @@ func NewEntClientWithDB(db *sql.DB) *ent.Client {
 //	todos, err := tx.TenantTodoView.Query().All(ctx)
 //	return tx.Commit()
 func WithTenantScope(ctx context.Context, client *ent.Client, tenantID string) (*ent.Tx, error) {
-	tx, err := client.Tx(ctx)
+	return beginTenantScope(ctx, client, tenantID, nil)
+}
+
+// beginTenantScope is WithTenantScope with transaction options, e.g. read-only for replicas
+func beginTenantScope(ctx context.Context, client *ent.Client, tenantID string, opts *sql.TxOptions) (*ent.Tx, error) {
+	tx, err := client.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 
-	// Set tenant context within transaction using SET LOCAL
-	// Note: SET command doesn't support placeholders, so we use fmt.Sprintf
-	// tenantID should be a valid UUID from JWT, validated at auth layer
-	query := fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", tenantID)
-	if _, err := tx.ExecContext(ctx, query); err != nil {
+	// Set tenant context within transaction only (connection pool safe)
+	if err := SetLocalTenantID(ctx, tx, tenantID); err != nil {
 		_ = tx.Rollback()
-		return nil, fmt.Errorf("failed to set tenant context: %w", err)
+		return nil, err
 	}
 
 	return tx, nil
@@ -111,10 +123,92 @@ func WithTenantScope(ctx context.Context, client *ent.Client, tenantID string) (
 //	defer tx.Rollback()
 //	todos, err := tx.TenantTodoView.Query().All(ctx)
 //	return tx.Commit()
+//
+// Inside RunInTenantTx the shared transaction is returned instead, and its
+// Commit and Rollback are left to RunInTenantTx.
 func TenantScopedTx(ctx context.Context, client *ent.Client) (*ent.Tx, error) {
+	if shared, ok := ctx.Value(sharedTxContextKey{}).(*sharedTx); ok {
+		return shared.tx, nil
+	}
+
 	tenantID, ok := GetTenantID(ctx)
 	if !ok || tenantID == "" {
 		return nil, fmt.Errorf("tenant ID not found in context")
 	}
 	return WithTenantScope(ctx, client, tenantID)
 }
+
+type sharedTxContextKey struct{}
+
+// sharedTx is a transaction joined by every TenantScopedTx call made with its context
+type sharedTx struct {
+	tx *ent.Tx
+	// done is set once RunInTenantTx itself ends the transaction
+	done bool
+}
+
+// RunInTenantTx runs fn in a single tenant-scoped transaction.
+// Repository calls made with the context passed to fn join it, so their
+// writes are committed together when fn returns nil and rolled back otherwise.
+func RunInTenantTx(ctx context.Context, client *ent.Client, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(sharedTxContextKey{}).(*sharedTx); ok {
+		// Already inside one, nesting just joins it
+		return fn(ctx)
+	}
+
+	tx, err := TenantScopedTx(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	shared := &sharedTx{tx: tx}
+	// Commit and Rollback from repositories are no-ops until done is set
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if !shared.done {
+				return nil
+			}
+			return next.Commit(ctx, tx)
+		})
+	})
+	tx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if !shared.done {
+				return nil
+			}
+			return next.Rollback(ctx, tx)
+		})
+	})
+
+	if err := fn(context.WithValue(ctx, sharedTxContextKey{}, shared)); err != nil {
+		shared.done = true
+		_ = tx.Rollback()
+		return err
+	}
+
+	shared.done = true
+	return tx.Commit()
+}
+
+// Savepoint runs fn inside a savepoint of the RunInTenantTx transaction, so a
+// failing fn only undoes its own writes and the transaction stays usable
+func Savepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	shared, ok := ctx.Value(sharedTxContextKey{}).(*sharedTx)
+	if !ok {
+		return fmt.Errorf("savepoint outside of RunInTenantTx")
+	}
+
+	if _, err := shared.tx.ExecContext(ctx, "SAVEPOINT item"); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if err := fn(ctx); err != nil {
+		if _, rbErr := shared.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT item"); rbErr != nil {
+			return fmt.Errorf("failed to roll back savepoint: %w", rbErr)
+		}
+		return err
+	}
+	if _, err := shared.tx.ExecContext(ctx, "RELEASE SAVEPOINT item"); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
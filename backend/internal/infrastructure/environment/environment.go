@@ -1,6 +1,9 @@
 package environment
 
 import (
+	"errors"
+	"time"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 )
@@ -10,6 +13,21 @@ type Config struct {
 	Port      string `env:"PORT" envDefault:"8000"`
 	AdminPort string `env:"ADMIN_PORT" envDefault:"8001"`
 	AppEnv    string `env:"APP_ENV" envDefault:"local"`
+	// TrustProxyHeaders takes the client IP from X-Forwarded-For set by a proxy on a private network
+	TrustProxyHeaders bool `env:"TRUST_PROXY_HEADERS" envDefault:"false"`
+	// ShutdownDrainDelay is how long /readyz fails before the server stops accepting connections
+	// on SIGTERM, for load balancers to take the instance out
+	ShutdownDrainDelay time.Duration `env:"SHUTDOWN_DRAIN_DELAY" envDefault:"5s"`
+	// LogLevel is debug, info, warn or error; debug also logs SQL queries, without their arguments
+	LogLevel string `env:"LOG_LEVEL" envDefault:"info"`
+
+	// Tracing (OpenTelemetry)
+	// TracingExporter is none, stdout (spans written as JSON, for local use) or otlp
+	TracingExporter string `env:"TRACING_EXPORTER" envDefault:"none"`
+	// TracingOTLPEndpoint is the OTLP/HTTP URL of the collector, e.g. http://localhost:4318
+	TracingOTLPEndpoint string  `env:"TRACING_OTLP_ENDPOINT" envDefault:""`
+	TracingSampleRatio  float64 `env:"TRACING_SAMPLE_RATIO" envDefault:"1"`
+	TracingServiceName  string  `env:"TRACING_SERVICE_NAME" envDefault:"good-todo-api"`
 
 	// Database
 	DBHost     string `env:"POSTGRES_DB_HOST" envDefault:"localhost"`
@@ -17,11 +35,35 @@ type Config struct {
 	DBUser     string `env:"POSTGRES_DB_USER" envDefault:"goodtodo"`
 	DBPassword string `env:"POSTGRES_DB_PASSWORD" envDefault:"secret"`
 	DBName     string `env:"POSTGRES_DB_NAME" envDefault:"goodtodo_dev"`
+	// DBSSLMode is disable, require, verify-ca or verify-full; DBSSLRootCert is the CA file for the verify modes
+	DBSSLMode         string `env:"POSTGRES_DB_SSL_MODE" envDefault:"disable"`
+	DBSSLRootCert     string `env:"POSTGRES_DB_SSL_ROOT_CERT" envDefault:""`
+	DBApplicationName string `env:"POSTGRES_DB_APPLICATION_NAME" envDefault:"good-todo-api"`
+	// DBStatementTimeout cancels queries running longer on the server; 0 disables it
+	DBStatementTimeout time.Duration `env:"POSTGRES_DB_STATEMENT_TIMEOUT" envDefault:"30s"`
+	DBMaxOpenConns     int           `env:"POSTGRES_DB_MAX_OPEN_CONNS" envDefault:"25"`
+	DBMaxIdleConns     int           `env:"POSTGRES_DB_MAX_IDLE_CONNS" envDefault:"10"`
+	DBConnMaxLifetime  time.Duration `env:"POSTGRES_DB_CONN_MAX_LIFETIME" envDefault:"30m"`
+	DBConnMaxIdleTime  time.Duration `env:"POSTGRES_DB_CONN_MAX_IDLE_TIME" envDefault:"5m"`
+	// DBConnectAttempts is how many times the startup ping is tried, waiting DBConnectBackoff
+	// after the first failure and twice as long after each further one
+	DBConnectAttempts int           `env:"POSTGRES_DB_CONNECT_ATTEMPTS" envDefault:"5"`
+	DBConnectBackoff  time.Duration `env:"POSTGRES_DB_CONNECT_BACKOFF" envDefault:"1s"`
+	// DBReplicaDSN is an optional read replica for todo listings and counts; it uses the pool settings above
+	DBReplicaDSN string `env:"POSTGRES_DB_REPLICA_DSN" envDefault:""`
+	// DBReplicaStickyWindow is how long a user's reads stay on the primary after they change something,
+	// so they see their own writes despite replication lag
+	DBReplicaStickyWindow time.Duration `env:"POSTGRES_DB_REPLICA_STICKY_WINDOW" envDefault:"5s"`
 
 	// JWT
 	JWTSecret           string `env:"JWT_SECRET" envDefault:"your-super-secret-key"`
 	JWTExpiresIn        int    `env:"JWT_EXPIRES_IN" envDefault:"3600"`
 	JWTRefreshExpiresIn int    `env:"JWT_REFRESH_EXPIRES_IN" envDefault:"604800"`
+	// JWTKeysDir holds <kid>.pem keys; when set tokens are signed with RS256/EdDSA instead of JWT_SECRET
+	JWTKeysDir      string `env:"JWT_KEYS_DIR" envDefault:""`
+	JWTSigningKeyID string `env:"JWT_SIGNING_KEY_ID" envDefault:""`
+	// JWTLegacyHS256Verify keeps accepting HS256 tokens signed with JWT_SECRET after moving to keys
+	JWTLegacyHS256Verify bool `env:"JWT_LEGACY_HS256_VERIFY" envDefault:"false"`
 
 	// SMTP
 	SMTPHost     string `env:"SMTP_HOST" envDefault:"localhost"`
@@ -29,6 +71,48 @@ type Config struct {
 	SMTPUser     string `env:"SMTP_USER" envDefault:""`
 	SMTPPassword string `env:"SMTP_PASSWORD" envDefault:""`
 	SMTPFrom     string `env:"SMTP_FROM" envDefault:"noreply@goodtodo.local"`
+
+	// Trash
+	TrashRetentionDays int           `env:"TRASH_RETENTION_DAYS" envDefault:"30"`
+	TrashPurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" envDefault:"1h"`
+
+	// Idempotency keys
+	IdempotencyKeyTTL           time.Duration `env:"IDEMPOTENCY_KEY_TTL" envDefault:"24h"`
+	IdempotencyKeyPurgeInterval time.Duration `env:"IDEMPOTENCY_KEY_PURGE_INTERVAL" envDefault:"1h"`
+
+	// Rate limiting
+	RateLimitEnabled       bool `env:"RATE_LIMIT_ENABLED" envDefault:"true"`
+	RateLimitAuthPerMinute int  `env:"RATE_LIMIT_AUTH_PER_MINUTE" envDefault:"10"`
+	RateLimitUserPerMinute int  `env:"RATE_LIMIT_USER_PER_MINUTE" envDefault:"300"`
+
+	// Login lockout
+	LoginMaxFailures          int           `env:"LOGIN_MAX_FAILURES" envDefault:"5"`
+	LoginMaxIPFailures        int           `env:"LOGIN_MAX_IP_FAILURES" envDefault:"20"`
+	LoginFailureWindow        time.Duration `env:"LOGIN_FAILURE_WINDOW" envDefault:"15m"`
+	LoginLockoutDuration      time.Duration `env:"LOGIN_LOCKOUT_DURATION" envDefault:"15m"`
+	LoginDelayBase            time.Duration `env:"LOGIN_DELAY_BASE" envDefault:"1s"`
+	LoginAttemptRetention     time.Duration `env:"LOGIN_ATTEMPT_RETENTION" envDefault:"168h"`
+	LoginAttemptPurgeInterval time.Duration `env:"LOGIN_ATTEMPT_PURGE_INTERVAL" envDefault:"1h"`
+
+	// Sessions
+	// SessionCheckAccessTokens rejects access tokens of revoked sessions at once, with a lookup per request.
+	// Otherwise revocation takes effect at the next refresh
+	SessionCheckAccessTokens bool          `env:"SESSION_CHECK_ACCESS_TOKENS" envDefault:"false"`
+	SessionPurgeInterval     time.Duration `env:"SESSION_PURGE_INTERVAL" envDefault:"1h"`
+
+	// Passwords
+	// PasswordHashAlgorithm is bcrypt or argon2id. Hashes made otherwise are upgraded at the next login
+	PasswordHashAlgorithm string `env:"PASSWORD_HASH_ALGORITHM" envDefault:"bcrypt"`
+	PasswordBcryptCost    int    `env:"PASSWORD_BCRYPT_COST" envDefault:"10"`
+	// PasswordMinLength applies to tenants without their own policy
+	PasswordMinLength int `env:"PASSWORD_MIN_LENGTH" envDefault:"8"`
+	// BreachedPasswordsDir holds <PREFIX>.txt SHA-1 range files (Pwned Passwords format); empty disables the check
+	BreachedPasswordsDir string `env:"BREACHED_PASSWORDS_DIR" envDefault:""`
+
+	// Single sign-on (OpenID Connect)
+	// OIDCRedirectBaseURL is the public URL of this API; callbacks are under /auth/oidc/{tenantSlug}/callback
+	OIDCRedirectBaseURL string        `env:"OIDC_REDIRECT_BASE_URL" envDefault:"http://localhost:8000"`
+	OIDCHTTPTimeout     time.Duration `env:"OIDC_HTTP_TIMEOUT" envDefault:"10s"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -38,5 +122,71 @@ func LoadConfig() (*Config, error) {
 	if err := env.Parse(cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
+
+// insecureJWTSecrets are the secrets shipped as defaults and examples
+var insecureJWTSecrets = map[string]bool{
+	"":                      true,
+	"your-super-secret-key": true,
+	"your-super-secret-key-change-in-production": true,
+}
+
+// dbSSLModes are the sslmode values lib/pq supports
+var dbSSLModes = map[string]bool{
+	"disable":     true,
+	"require":     true,
+	"verify-ca":   true,
+	"verify-full": true,
+}
+
+// Validate rejects settings that are only acceptable on a developer machine
+func (c *Config) Validate() error {
+	if c.JWTKeysDir != "" && c.JWTSigningKeyID == "" {
+		return errors.New("JWT_SIGNING_KEY_ID is required when JWT_KEYS_DIR is set")
+	}
+	if c.PasswordMinLength < 8 || c.PasswordMinLength > 72 {
+		return errors.New("PASSWORD_MIN_LENGTH must be between 8 and 72")
+	}
+	if c.TracingSampleRatio < 0 || c.TracingSampleRatio > 1 {
+		return errors.New("TRACING_SAMPLE_RATIO must be between 0 and 1")
+	}
+	if !dbSSLModes[c.DBSSLMode] {
+		return errors.New("POSTGRES_DB_SSL_MODE must be disable, require, verify-ca or verify-full")
+	}
+	if c.DBMaxOpenConns < 1 {
+		return errors.New("POSTGRES_DB_MAX_OPEN_CONNS must be at least 1")
+	}
+	if c.DBMaxIdleConns < 0 || c.DBMaxIdleConns > c.DBMaxOpenConns {
+		return errors.New("POSTGRES_DB_MAX_IDLE_CONNS must be between 0 and POSTGRES_DB_MAX_OPEN_CONNS")
+	}
+	if c.DBStatementTimeout < 0 {
+		return errors.New("POSTGRES_DB_STATEMENT_TIMEOUT must not be negative")
+	}
+	if c.DBConnectAttempts < 1 {
+		return errors.New("POSTGRES_DB_CONNECT_ATTEMPTS must be at least 1")
+	}
+	if c.DBReplicaStickyWindow < 0 {
+		return errors.New("POSTGRES_DB_REPLICA_STICKY_WINDOW must not be negative")
+	}
+	if c.TrashRetentionDays < 1 {
+		return errors.New("TRASH_RETENTION_DAYS must be at least 1")
+	}
+	if c.RateLimitAuthPerMinute < 1 {
+		return errors.New("RATE_LIMIT_AUTH_PER_MINUTE must be at least 1")
+	}
+	if c.RateLimitUserPerMinute < 1 {
+		return errors.New("RATE_LIMIT_USER_PER_MINUTE must be at least 1")
+	}
+	if c.AppEnv == "local" {
+		return nil
+	}
+	usesSecret := c.JWTKeysDir == "" || c.JWTLegacyHS256Verify
+	if usesSecret && insecureJWTSecrets[c.JWTSecret] {
+		return errors.New("JWT_SECRET must be changed from its default outside APP_ENV=local (or set JWT_KEYS_DIR)")
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package environment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "local may use the default secret",
+			cfg:  Config{AppEnv: "local", PasswordMinLength: 8, JWTSecret: "your-super-secret-key"},
+		},
+		{
+			name:    "default secret outside local",
+			cfg:     Config{AppEnv: "production", PasswordMinLength: 8, JWTSecret: "your-super-secret-key"},
+			wantErr: "JWT_SECRET",
+		},
+		{
+			name:    "example secret outside local",
+			cfg:     Config{AppEnv: "staging", PasswordMinLength: 8, JWTSecret: "your-super-secret-key-change-in-production"},
+			wantErr: "JWT_SECRET",
+		},
+		{
+			name: "changed secret outside local",
+			cfg:  Config{AppEnv: "production", PasswordMinLength: 8, JWTSecret: "3f1c0e8d6a..."},
+		},
+		{
+			name: "keys outside local do not use the secret",
+			cfg:  Config{AppEnv: "production", PasswordMinLength: 8, JWTSecret: "your-super-secret-key", JWTKeysDir: "/etc/jwt", JWTSigningKeyID: "2026-10"},
+		},
+		{
+			name:    "legacy verification with the default secret",
+			cfg:     Config{AppEnv: "production", PasswordMinLength: 8, JWTSecret: "your-super-secret-key", JWTKeysDir: "/etc/jwt", JWTSigningKeyID: "2026-10", JWTLegacyHS256Verify: true},
+			wantErr: "JWT_SECRET",
+		},
+		{
+			name:    "keys without a signing key",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, JWTKeysDir: "/etc/jwt"},
+			wantErr: "JWT_SIGNING_KEY_ID",
+		},
+		{
+			name:    "password minimum below 8",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 6},
+			wantErr: "PASSWORD_MIN_LENGTH",
+		},
+		{
+			name:    "trace sample ratio above 1",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, TracingSampleRatio: 1.5},
+			wantErr: "TRACING_SAMPLE_RATIO",
+		},
+		{
+			name:    "unsupported ssl mode",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, DBSSLMode: "prefer"},
+			wantErr: "POSTGRES_DB_SSL_MODE",
+		},
+		{
+			name:    "more idle than open connections",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, DBMaxOpenConns: 5, DBMaxIdleConns: 10},
+			wantErr: "POSTGRES_DB_MAX_IDLE_CONNS",
+		},
+		{
+			name:    "negative statement timeout",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, DBStatementTimeout: -time.Second},
+			wantErr: "POSTGRES_DB_STATEMENT_TIMEOUT",
+		},
+		{
+			name:    "no connect attempts",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, DBConnectAttempts: -1},
+			wantErr: "POSTGRES_DB_CONNECT_ATTEMPTS",
+		},
+		{
+			name:    "negative replica sticky window",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, DBReplicaStickyWindow: -time.Second},
+			wantErr: "POSTGRES_DB_REPLICA_STICKY_WINDOW",
+		},
+		{
+			name:    "trash purged immediately",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, TrashRetentionDays: -1},
+			wantErr: "TRASH_RETENTION_DAYS",
+		},
+		{
+			name:    "no auth requests allowed",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, RateLimitAuthPerMinute: -1},
+			wantErr: "RATE_LIMIT_AUTH_PER_MINUTE",
+		},
+		{
+			name:    "no user requests allowed",
+			cfg:     Config{AppEnv: "local", PasswordMinLength: 8, RateLimitUserPerMinute: -1},
+			wantErr: "RATE_LIMIT_USER_PER_MINUTE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := withDefaults(tt.cfg)
+			err := cfg.Validate()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+// withDefaults fills the database, retention and rate limit settings a case leaves unset with their env defaults
+func withDefaults(cfg Config) Config {
+	if cfg.DBSSLMode == "" {
+		cfg.DBSSLMode = "disable"
+	}
+	if cfg.DBMaxOpenConns == 0 {
+		cfg.DBMaxOpenConns = 25
+	}
+	if cfg.DBMaxIdleConns == 0 {
+		cfg.DBMaxIdleConns = 10
+	}
+	if cfg.DBConnectAttempts == 0 {
+		cfg.DBConnectAttempts = 5
+	}
+	if cfg.TrashRetentionDays == 0 {
+		cfg.TrashRetentionDays = 30
+	}
+	if cfg.RateLimitAuthPerMinute == 0 {
+		cfg.RateLimitAuthPerMinute = 10
+	}
+	if cfg.RateLimitUserPerMinute == 0 {
+		cfg.RateLimitUserPerMinute = 300
+	}
+	return cfg
+}
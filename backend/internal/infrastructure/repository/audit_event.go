@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/auditevent"
+	"good-todo-go/internal/ent/predicate"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type AuditEventRepository struct {
+	client *ent.Client
+}
+
+func NewAuditEventRepository(client *ent.Client) repository.IAuditEventRepository {
+	return &AuditEventRepository{client: client}
+}
+
+// FindByFilter reads audit events, newest first (RLS handles tenant isolation)
+func (r *AuditEventRepository) FindByFilter(ctx context.Context, filter *model.AuditEventFilter, limit, offset int) ([]*model.AuditEvent, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	events, err := tx.AuditEvent.Query().
+		Where(auditEventPredicates(filter)...).
+		Order(ent.Desc(auditevent.FieldCreatedAt), ent.Desc(auditevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.AuditEvent, len(events))
+	for i, e := range events {
+		result[i] = toAuditEventModel(e)
+	}
+	return result, nil
+}
+
+// CountByFilter counts audit events (RLS handles tenant isolation)
+func (r *AuditEventRepository) CountByFilter(ctx context.Context, filter *model.AuditEventFilter) (int, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count, err := tx.AuditEvent.Query().
+		Where(auditEventPredicates(filter)...).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func auditEventPredicates(filter *model.AuditEventFilter) []predicate.AuditEvent {
+	var ps []predicate.AuditEvent
+	if filter == nil {
+		return ps
+	}
+	if filter.EntityType != nil {
+		ps = append(ps, auditevent.EntityTypeEQ(*filter.EntityType))
+	}
+	if filter.EntityID != nil {
+		ps = append(ps, auditevent.EntityIDEQ(*filter.EntityID))
+	}
+	if filter.ActorID != nil {
+		ps = append(ps, auditevent.ActorIDEQ(*filter.ActorID))
+	}
+	if filter.Action != nil {
+		ps = append(ps, auditevent.ActionEQ(auditevent.Action(*filter.Action)))
+	}
+	if filter.From != nil {
+		ps = append(ps, auditevent.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		ps = append(ps, auditevent.CreatedAtLT(*filter.To))
+	}
+	return ps
+}
+
+// toAuditEventModel converts ent.AuditEvent to model.AuditEvent
+func toAuditEventModel(e *ent.AuditEvent) *model.AuditEvent {
+	return &model.AuditEvent{
+		ID:         e.ID,
+		TenantID:   e.TenantID,
+		ActorID:    e.ActorID,
+		Action:     string(e.Action),
+		EntityType: e.EntityType,
+		EntityID:   e.EntityID,
+		Before:     e.Before,
+		After:      e.After,
+		RequestID:  e.RequestID,
+		IP:         e.IP,
+		CreatedAt:  e.CreatedAt,
+	}
+}
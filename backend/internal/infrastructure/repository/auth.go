@@ -9,6 +9,7 @@ import (
 	"good-todo-go/internal/ent"
 	"good-todo-go/internal/ent/tenant"
 	"good-todo-go/internal/ent/user"
+	"good-todo-go/internal/infrastructure/database"
 )
 
 type AuthRepository struct {
@@ -29,8 +30,43 @@ func (r *AuthRepository) FindTenantBySlug(ctx context.Context, slug string) (*mo
 	return toTenantModel(t), nil
 }
 
+func (r *AuthRepository) FindTenantByID(ctx context.Context, tenantID string) (*model.Tenant, error) {
+	t, err := r.client.Tenant.Get(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return toTenantModel(t), nil
+}
+
+// FindAllTenants lists every tenant (the tenants table is not tenant-scoped)
+func (r *AuthRepository) FindAllTenants(ctx context.Context) ([]*model.Tenant, error) {
+	tenants, err := r.client.Tenant.Query().
+		Order(ent.Asc(tenant.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Tenant, len(tenants))
+	for i, t := range tenants {
+		result[i] = toTenantModel(t)
+	}
+	return result, nil
+}
+
 func (r *AuthRepository) CreateTenant(ctx context.Context, t *model.Tenant) (*model.Tenant, error) {
-	created, err := r.client.Tenant.Create().
+	// Start transaction with the new tenant's context so its audit event passes RLS
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := database.SetLocalTenantID(ctx, tx, t.ID); err != nil {
+		return nil, err
+	}
+
+	created, err := tx.Tenant.Create().
 		SetID(t.ID).
 		SetName(t.Name).
 		SetSlug(t.Slug).
@@ -38,9 +74,54 @@ func (r *AuthRepository) CreateTenant(ctx context.Context, t *model.Tenant) (*mo
 	if err != nil {
 		return nil, err
 	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return toTenantModel(created), nil
 }
 
+// UpdateTenant saves the tenant's settings
+func (r *AuthRepository) UpdateTenant(ctx context.Context, t *model.Tenant) (*model.Tenant, error) {
+	// Run in the tenant's context so its audit event passes RLS
+	tx, err := database.WithTenantScope(ctx, r.client, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	builder := tx.Tenant.UpdateOneID(t.ID).
+		SetName(t.Name).
+		SetRequireMfa(t.RequireMFA).
+		SetDeletedUserPublicTodos(tenant.DeletedUserPublicTodos(t.DeletedUserPublicTodos)).
+		SetNillablePasswordMinLength(t.PasswordMinLength).
+		SetPasswordMinCharacterClasses(t.PasswordMinCharacterClasses).
+		SetPasswordHistory(t.PasswordHistory).
+		SetNillableRateLimitPerMinute(t.RateLimitPerMinute).
+		SetNillableTrashRetentionDays(t.TrashRetentionDays)
+	if t.PasswordMinLength == nil {
+		builder.ClearPasswordMinLength()
+	}
+	if t.RateLimitPerMinute == nil {
+		builder.ClearRateLimitPerMinute()
+	}
+	if t.TrashRetentionDays == nil {
+		builder.ClearTrashRetentionDays()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return toTenantModel(updated), nil
+}
+
 func (r *AuthRepository) FindUserByEmail(ctx context.Context, tenantID, email string) (*model.User, error) {
 	// Start transaction with tenant context for RLS
 	tx, err := r.client.Tx(ctx)
@@ -50,8 +131,8 @@ func (r *AuthRepository) FindUserByEmail(ctx context.Context, tenantID, email st
 	defer tx.Rollback()
 
 	// Set tenant context for RLS
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", tenantID)); err != nil {
-		return nil, fmt.Errorf("failed to set tenant context: %w", err)
+	if err := database.SetLocalTenantID(ctx, tx, tenantID); err != nil {
+		return nil, err
 	}
 
 	u, err := tx.User.Query().
@@ -80,8 +161,8 @@ func (r *AuthRepository) FindUserByID(ctx context.Context, tenantID, userID stri
 	defer tx.Rollback()
 
 	// Set tenant context for RLS
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", tenantID)); err != nil {
-		return nil, fmt.Errorf("failed to set tenant context: %w", err)
+	if err := database.SetLocalTenantID(ctx, tx, tenantID); err != nil {
+		return nil, err
 	}
 
 	u, err := tx.User.Get(ctx, userID)
@@ -115,8 +196,8 @@ func (r *AuthRepository) CreateUser(ctx context.Context, u *model.User) (*model.
 	defer tx.Rollback()
 
 	// Set tenant context for RLS
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", u.TenantID)); err != nil {
-		return nil, fmt.Errorf("failed to set tenant context: %w", err)
+	if err := database.SetLocalTenantID(ctx, tx, u.TenantID); err != nil {
+		return nil, err
 	}
 
 	builder := tx.User.Create().
@@ -156,13 +237,35 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, u *model.User) (*model.
 	defer tx.Rollback()
 
 	// Set tenant context for RLS
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL app.current_tenant_id = '%s'", u.TenantID)); err != nil {
-		return nil, fmt.Errorf("failed to set tenant context: %w", err)
+	if err := database.SetLocalTenantID(ctx, tx, u.TenantID); err != nil {
+		return nil, err
 	}
 
 	builder := tx.User.UpdateOneID(u.ID).
+		SetEmail(u.Email).
+		SetPasswordHash(u.PasswordHash).
 		SetName(u.Name).
-		SetEmailVerified(u.EmailVerified)
+		SetEmailVerified(u.EmailVerified).
+		SetNillablePendingEmail(u.PendingEmail).
+		SetNillableEmailChangeTokenHash(u.EmailChangeTokenHash).
+		SetNillableEmailChangeExpiresAt(u.EmailChangeExpiresAt).
+		SetNillableDeletionCodeHash(u.DeletionCodeHash).
+		SetNillableDeletionCodeExpiresAt(u.DeletionCodeExpiresAt)
+	if u.PendingEmail == nil {
+		builder.ClearPendingEmail()
+	}
+	if u.EmailChangeTokenHash == nil {
+		builder.ClearEmailChangeTokenHash()
+	}
+	if u.EmailChangeExpiresAt == nil {
+		builder.ClearEmailChangeExpiresAt()
+	}
+	if u.DeletionCodeHash == nil {
+		builder.ClearDeletionCodeHash()
+	}
+	if u.DeletionCodeExpiresAt == nil {
+		builder.ClearDeletionCodeExpiresAt()
+	}
 
 	if u.VerificationToken != nil {
 		builder.SetVerificationToken(*u.VerificationToken)
@@ -176,7 +279,36 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, u *model.User) (*model.
 		builder.ClearVerificationTokenExpiresAt()
 	}
 
+	if u.LockedUntil != nil {
+		builder.SetLockedUntil(*u.LockedUntil)
+	} else {
+		builder.ClearLockedUntil()
+	}
+
+	builder.SetMfaEnabled(u.MFAEnabled).
+		SetNillableMfaSecret(u.MFASecret).
+		SetNillableMfaLastUsedStep(u.MFALastUsedStep).
+		SetMfaRecoveryCodeHashes(u.MFARecoveryCodeHashes)
+	if u.MFASecret == nil {
+		builder.ClearMfaSecret()
+	}
+	if u.MFALastUsedStep == nil {
+		builder.ClearMfaLastUsedStep()
+	}
+	if u.MFARecoveryCodeHashes == nil {
+		builder.ClearMfaRecoveryCodeHashes()
+	}
+
+	builder.SetPasswordHistoryHashes(u.PasswordHistoryHashes)
+	if u.PasswordHistoryHashes == nil {
+		builder.ClearPasswordHistoryHashes()
+	}
+
 	updated, err := builder.Save(ctx)
+	if ent.IsConstraintError(err) {
+		// The only unique column that can change is the email
+		return nil, repository.ErrEmailTaken
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -190,11 +322,18 @@ func (r *AuthRepository) UpdateUser(ctx context.Context, u *model.User) (*model.
 
 func toTenantModel(t *ent.Tenant) *model.Tenant {
 	return &model.Tenant{
-		ID:        t.ID,
-		Name:      t.Name,
-		Slug:      t.Slug,
-		CreatedAt: t.CreatedAt,
-		UpdatedAt: t.UpdatedAt,
+		ID:                          t.ID,
+		Name:                        t.Name,
+		Slug:                        t.Slug,
+		TrashRetentionDays:          t.TrashRetentionDays,
+		RateLimitPerMinute:          t.RateLimitPerMinute,
+		RequireMFA:                  t.RequireMfa,
+		DeletedUserPublicTodos:      string(t.DeletedUserPublicTodos),
+		PasswordMinLength:           t.PasswordMinLength,
+		PasswordMinCharacterClasses: t.PasswordMinCharacterClasses,
+		PasswordHistory:             t.PasswordHistory,
+		CreatedAt:                   t.CreatedAt,
+		UpdatedAt:                   t.UpdatedAt,
 	}
 }
 
@@ -209,6 +348,17 @@ func toUserModel(u *ent.User) *model.User {
 		EmailVerified:              u.EmailVerified,
 		VerificationToken:          u.VerificationToken,
 		VerificationTokenExpiresAt: u.VerificationTokenExpiresAt,
+		LockedUntil:                u.LockedUntil,
+		PendingEmail:               u.PendingEmail,
+		EmailChangeTokenHash:       u.EmailChangeTokenHash,
+		EmailChangeExpiresAt:       u.EmailChangeExpiresAt,
+		DeletionCodeHash:           u.DeletionCodeHash,
+		DeletionCodeExpiresAt:      u.DeletionCodeExpiresAt,
+		MFAEnabled:                 u.MfaEnabled,
+		MFASecret:                  u.MfaSecret,
+		MFALastUsedStep:            u.MfaLastUsedStep,
+		MFARecoveryCodeHashes:      u.MfaRecoveryCodeHashes,
+		PasswordHistoryHashes:      u.PasswordHistoryHashes,
 		CreatedAt:                  u.CreatedAt,
 		UpdatedAt:                  u.UpdatedAt,
 	}
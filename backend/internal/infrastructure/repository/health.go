@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/infrastructure/database/rlscheck"
+
+	"github.com/lib/pq"
+)
+
+type HealthRepository struct {
+	client *ent.Client
+}
+
+func NewHealthRepository(client *ent.Client) repository.IHealthRepository {
+	return &HealthRepository{client: client}
+}
+
+func (r *HealthRepository) Ping(ctx context.Context) error {
+	_, err := r.client.ExecContext(ctx, "SELECT 1")
+	return err
+}
+
+func (r *HealthRepository) MigrationVersion(ctx context.Context) (string, error) {
+	// A revision whose statements were not all applied is a failed migration
+	rows, err := r.client.QueryContext(ctx,
+		`SELECT version, applied = total FROM atlas_schema_revisions.atlas_schema_revisions ORDER BY version DESC LIMIT 1`)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", err
+		}
+		return "", sql.ErrNoRows
+	}
+	var (
+		version  string
+		complete bool
+	)
+	if err := rows.Scan(&version, &complete); err != nil {
+		return "", err
+	}
+	if !complete {
+		return "", fmt.Errorf("migration %s was partially applied", version)
+	}
+	return version, rows.Err()
+}
+
+func (r *HealthRepository) TablesWithoutRLS(ctx context.Context) ([]string, error) {
+	var tables []string
+	for _, t := range rlscheck.TenantTables() {
+		tables = append(tables, t.Name)
+	}
+
+	rows, err := r.client.QueryContext(ctx,
+		`SELECT relname FROM pg_catalog.pg_class
+		WHERE relnamespace = 'public'::regnamespace AND relname = ANY($1) AND relrowsecurity AND relforcerowsecurity`,
+		pq.Array(tables))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enforced := map[string]bool{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		enforced[name] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, name := range tables {
+		if !enforced[name] {
+			missing = append(missing, name)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthRepository(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	repo := NewHealthRepository(appClient)
+	ctx := context.Background()
+
+	require.NoError(t, repo.Ping(ctx))
+
+	missing, err := repo.TablesWithoutRLS(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	_, err = adminClient.ExecContext(ctx, `ALTER TABLE "todos" NO FORCE ROW LEVEL SECURITY`)
+	require.NoError(t, err)
+	missing, err = repo.TablesWithoutRLS(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todos"}, missing)
+
+	// The test database is migrated without Atlas, so there is no revision to read
+	_, err = repo.MigrationVersion(ctx)
+	assert.Error(t, err)
+}
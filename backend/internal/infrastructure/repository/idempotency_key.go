@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/idempotencykey"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type IdempotencyKeyRepository struct {
+	client *ent.Client
+}
+
+func NewIdempotencyKeyRepository(client *ent.Client) repository.IIdempotencyKeyRepository {
+	return &IdempotencyKeyRepository{client: client}
+}
+
+// Reserve returns the live key for the scope, or stores k when there is none
+func (r *IdempotencyKeyRepository) Reserve(ctx context.Context, k *model.IdempotencyKey, now time.Time) (*model.IdempotencyKey, error) {
+	existing, err := r.findLive(ctx, k.TenantID, k.UserID, k.Key, now)
+	if err == nil {
+		return existing, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	err = r.create(ctx, k, now)
+	if ent.IsConstraintError(err) {
+		// A concurrent request with the same key reserved it first
+		return r.findLive(ctx, k.TenantID, k.UserID, k.Key, now)
+	}
+	return nil, err
+}
+
+func (r *IdempotencyKeyRepository) findLive(ctx context.Context, tenantID, userID, key string, now time.Time) (*model.IdempotencyKey, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	k, err := tx.IdempotencyKey.Query().
+		Where(
+			idempotencykey.TenantIDEQ(tenantID),
+			idempotencykey.UserIDEQ(userID),
+			idempotencykey.KeyEQ(key),
+			idempotencykey.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toIdempotencyKeyModel(k), nil
+}
+
+// create replaces an expired key with the same scope, if any
+func (r *IdempotencyKeyRepository) create(ctx context.Context, k *model.IdempotencyKey, now time.Time) error {
+	tx, err := database.WithTenantScope(ctx, r.client, k.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.TenantIDEQ(k.TenantID),
+			idempotencykey.UserIDEQ(k.UserID),
+			idempotencykey.KeyEQ(k.Key),
+			idempotencykey.ExpiresAtLTE(now),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.IdempotencyKey.Create().
+		SetTenantID(k.TenantID).
+		SetUserID(k.UserID).
+		SetKey(k.Key).
+		SetRequestHash(k.RequestHash).
+		SetCreatedAt(now).
+		SetExpiresAt(k.ExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *IdempotencyKeyRepository) SaveResponse(ctx context.Context, k *model.IdempotencyKey) error {
+	tx, err := database.WithTenantScope(ctx, r.client, k.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.IdempotencyKey.Update().
+		Where(
+			idempotencykey.TenantIDEQ(k.TenantID),
+			idempotencykey.UserIDEQ(k.UserID),
+			idempotencykey.KeyEQ(k.Key),
+		).
+		SetNillableStatusCode(k.StatusCode).
+		SetResponseHeaders(k.ResponseHeaders).
+		SetResponseBody(k.ResponseBody).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *IdempotencyKeyRepository) Delete(ctx context.Context, tenantID, userID, key string) error {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.TenantIDEQ(tenantID),
+			idempotencykey.UserIDEQ(userID),
+			idempotencykey.KeyEQ(key),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *IdempotencyKeyRepository) DeleteExpired(ctx context.Context, tenantID string, now time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.IdempotencyKey.Delete().
+		Where(
+			idempotencykey.TenantIDEQ(tenantID),
+			idempotencykey.ExpiresAtLTE(now),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// toIdempotencyKeyModel converts ent.IdempotencyKey to model.IdempotencyKey
+func toIdempotencyKeyModel(k *ent.IdempotencyKey) *model.IdempotencyKey {
+	return &model.IdempotencyKey{
+		ID:              k.ID,
+		TenantID:        k.TenantID,
+		UserID:          k.UserID,
+		Key:             k.Key,
+		RequestHash:     k.RequestHash,
+		StatusCode:      k.StatusCode,
+		ResponseHeaders: k.ResponseHeaders,
+		ResponseBody:    k.ResponseBody,
+		CreatedAt:       k.CreatedAt,
+		ExpiresAt:       k.ExpiresAt,
+	}
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/loginattempt"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type LoginAttemptRepository struct {
+	client *ent.Client
+}
+
+func NewLoginAttemptRepository(client *ent.Client) repository.ILoginAttemptRepository {
+	return &LoginAttemptRepository{client: client}
+}
+
+func (r *LoginAttemptRepository) Create(ctx context.Context, a *model.LoginAttempt) error {
+	tx, err := database.WithTenantScope(ctx, r.client, a.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.LoginAttempt.Create().
+		SetTenantID(a.TenantID).
+		SetEmail(a.Email).
+		SetIPAddress(a.IPAddress).
+		SetSucceeded(a.Succeeded).
+		SetCreatedAt(a.CreatedAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *LoginAttemptRepository) FindFailuresByEmail(ctx context.Context, tenantID, email string, since time.Time) (*model.LoginFailures, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Failures before the last successful login no longer count
+	lastSuccess, err := tx.LoginAttempt.Query().
+		Where(
+			loginattempt.TenantIDEQ(tenantID),
+			loginattempt.EmailEQ(email),
+			loginattempt.Succeeded(true),
+			loginattempt.CreatedAtGT(since),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if lastSuccess != nil {
+		since = lastSuccess.CreatedAt
+	}
+
+	failures, err := tx.LoginAttempt.Query().
+		Where(
+			loginattempt.TenantIDEQ(tenantID),
+			loginattempt.EmailEQ(email),
+			loginattempt.Succeeded(false),
+			loginattempt.CreatedAtGT(since),
+		).
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := &model.LoginFailures{Count: len(failures)}
+	if len(failures) > 0 {
+		result.LastFailedAt = &failures[0].CreatedAt
+	}
+	return result, nil
+}
+
+func (r *LoginAttemptRepository) CountFailuresByIP(ctx context.Context, tenantID, ip string, since time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.LoginAttempt.Query().
+		Where(
+			loginattempt.TenantIDEQ(tenantID),
+			loginattempt.IPAddressEQ(ip),
+			loginattempt.Succeeded(false),
+			loginattempt.CreatedAtGT(since),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *LoginAttemptRepository) DeleteFailuresByEmail(ctx context.Context, tenantID, email string) error {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.LoginAttempt.Delete().
+		Where(
+			loginattempt.TenantIDEQ(tenantID),
+			loginattempt.EmailEQ(email),
+			loginattempt.Succeeded(false),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *LoginAttemptRepository) DeleteBefore(ctx context.Context, tenantID string, before time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.LoginAttempt.Delete().
+		Where(
+			loginattempt.TenantIDEQ(tenantID),
+			loginattempt.CreatedAtLT(before),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/oidcconfig"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type OIDCConfigRepository struct {
+	client *ent.Client
+}
+
+func NewOIDCConfigRepository(client *ent.Client) repository.IOIDCConfigRepository {
+	return &OIDCConfigRepository{client: client}
+}
+
+func (r *OIDCConfigRepository) FindByTenantID(ctx context.Context, tenantID string) (*model.OIDCConfig, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	c, err := tx.OIDCConfig.Query().
+		Where(oidcconfig.TenantIDEQ(tenantID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toOIDCConfigModel(c), nil
+}
+
+func (r *OIDCConfigRepository) Save(ctx context.Context, c *model.OIDCConfig) (*model.OIDCConfig, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, c.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	existing, err := tx.OIDCConfig.Query().
+		Where(oidcconfig.TenantIDEQ(c.TenantID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	var saved *ent.OIDCConfig
+	if existing == nil {
+		saved, err = tx.OIDCConfig.Create().
+			SetTenantID(c.TenantID).
+			SetIssuer(c.Issuer).
+			SetClientID(c.ClientID).
+			SetClientSecret(c.ClientSecret).
+			SetAllowedDomains(c.AllowedDomains).
+			SetDefaultRole(c.DefaultRole).
+			Save(ctx)
+	} else {
+		saved, err = tx.OIDCConfig.UpdateOne(existing).
+			SetIssuer(c.Issuer).
+			SetClientID(c.ClientID).
+			SetClientSecret(c.ClientSecret).
+			SetAllowedDomains(c.AllowedDomains).
+			SetDefaultRole(c.DefaultRole).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toOIDCConfigModel(saved), nil
+}
+
+func (r *OIDCConfigRepository) Delete(ctx context.Context, tenantID string) error {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.OIDCConfig.Delete().
+		Where(oidcconfig.TenantIDEQ(tenantID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toOIDCConfigModel(c *ent.OIDCConfig) *model.OIDCConfig {
+	return &model.OIDCConfig{
+		ID:             c.ID,
+		TenantID:       c.TenantID,
+		Issuer:         c.Issuer,
+		ClientID:       c.ClientID,
+		ClientSecret:   c.ClientSecret,
+		AllowedDomains: c.AllowedDomains,
+		DefaultRole:    c.DefaultRole,
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
+	}
+}
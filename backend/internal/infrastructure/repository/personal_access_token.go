@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/personalaccesstoken"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type PersonalAccessTokenRepository struct {
+	client *ent.Client
+}
+
+func NewPersonalAccessTokenRepository(client *ent.Client) repository.IPersonalAccessTokenRepository {
+	return &PersonalAccessTokenRepository{client: client}
+}
+
+func (r *PersonalAccessTokenRepository) Create(ctx context.Context, t *model.PersonalAccessToken) (*model.PersonalAccessToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, t.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := tx.PersonalAccessToken.Create().
+		SetID(t.ID).
+		SetTenantID(t.TenantID).
+		SetUserID(t.UserID).
+		SetName(t.Name).
+		SetTokenHash(t.TokenHash).
+		SetScopes(t.Scopes).
+		SetExpiresAt(t.ExpiresAt).
+		SetCreatedAt(t.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPersonalAccessTokenModel(created), nil
+}
+
+func (r *PersonalAccessTokenRepository) FindByHash(ctx context.Context, tenantID, tokenHash string) (*model.PersonalAccessToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.TenantIDEQ(tenantID),
+			personalaccesstoken.TokenHashEQ(tokenHash),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toPersonalAccessTokenModel(t), nil
+}
+
+func (r *PersonalAccessTokenRepository) FindByUserID(ctx context.Context, tenantID, userID string) ([]*model.PersonalAccessToken, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tokens, err := tx.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.TenantIDEQ(tenantID),
+			personalaccesstoken.UserIDEQ(userID),
+		).
+		Order(ent.Desc(personalaccesstoken.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.PersonalAccessToken, len(tokens))
+	for i, t := range tokens {
+		result[i] = toPersonalAccessTokenModel(t)
+	}
+	return result, nil
+}
+
+func (r *PersonalAccessTokenRepository) Delete(ctx context.Context, tenantID, userID, tokenID string) (bool, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.PersonalAccessToken.Delete().
+		Where(
+			personalaccesstoken.IDEQ(tokenID),
+			personalaccesstoken.TenantIDEQ(tenantID),
+			personalaccesstoken.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r *PersonalAccessTokenRepository) UpdateLastUsedAt(ctx context.Context, tenantID, tokenID string, usedAt time.Time) error {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.PersonalAccessToken.UpdateOneID(tokenID).
+		SetLastUsedAt(usedAt).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func toPersonalAccessTokenModel(t *ent.PersonalAccessToken) *model.PersonalAccessToken {
+	return &model.PersonalAccessToken{
+		ID:         t.ID,
+		TenantID:   t.TenantID,
+		UserID:     t.UserID,
+		Name:       t.Name,
+		TokenHash:  t.TokenHash,
+		Scopes:     t.Scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
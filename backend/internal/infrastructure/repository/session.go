@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/session"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type SessionRepository struct {
+	client *ent.Client
+}
+
+func NewSessionRepository(client *ent.Client) repository.ISessionRepository {
+	return &SessionRepository{client: client}
+}
+
+func (r *SessionRepository) Create(ctx context.Context, s *model.Session) error {
+	tx, err := database.WithTenantScope(ctx, r.client, s.TenantID)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.Session.Create().
+		SetID(s.ID).
+		SetTenantID(s.TenantID).
+		SetUserID(s.UserID).
+		SetRefreshTokenHash(s.RefreshTokenHash).
+		SetUserAgent(s.UserAgent).
+		SetIPAddress(s.IPAddress).
+		SetCreatedAt(s.CreatedAt).
+		SetLastUsedAt(s.LastUsedAt).
+		SetExpiresAt(s.ExpiresAt).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *SessionRepository) FindByID(ctx context.Context, tenantID, sessionID string) (*model.Session, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	s, err := tx.Session.Query().
+		Where(
+			session.IDEQ(sessionID),
+			session.TenantIDEQ(tenantID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toSessionModel(s), nil
+}
+
+func (r *SessionRepository) FindActiveByUserID(ctx context.Context, tenantID, userID string, now time.Time) ([]*model.Session, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	sessions, err := tx.Session.Query().
+		Where(
+			session.TenantIDEQ(tenantID),
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(now),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Session, len(sessions))
+	for i, s := range sessions {
+		result[i] = toSessionModel(s)
+	}
+	return result, nil
+}
+
+func (r *SessionRepository) Rotate(ctx context.Context, s *model.Session, oldHash string) (bool, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, s.TenantID)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// Compare and swap on the hash, so a refresh token can only be used once
+	n, err := tx.Session.Update().
+		Where(
+			session.IDEQ(s.ID),
+			session.TenantIDEQ(s.TenantID),
+			session.RefreshTokenHashEQ(oldHash),
+			session.RevokedAtIsNil(),
+		).
+		SetRefreshTokenHash(s.RefreshTokenHash).
+		SetUserAgent(s.UserAgent).
+		SetIPAddress(s.IPAddress).
+		SetLastUsedAt(s.LastUsedAt).
+		SetExpiresAt(s.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r *SessionRepository) Revoke(ctx context.Context, tenantID, userID, sessionID string, now time.Time) (bool, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Session.Update().
+		Where(
+			session.IDEQ(sessionID),
+			session.TenantIDEQ(tenantID),
+			session.UserIDEQ(userID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(now),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func (r *SessionRepository) RevokeAllByUserID(ctx context.Context, tenantID, userID, exceptID string, now time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Session.Update().
+		Where(
+			session.TenantIDEQ(tenantID),
+			session.UserIDEQ(userID),
+			session.IDNEQ(exceptID),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(now),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (r *SessionRepository) DeleteInactiveBefore(ctx context.Context, tenantID string, before time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Session.Delete().
+		Where(
+			session.TenantIDEQ(tenantID),
+			session.Or(
+				session.ExpiresAtLT(before),
+				session.RevokedAtLT(before),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func toSessionModel(s *ent.Session) *model.Session {
+	return &model.Session{
+		ID:               s.ID,
+		TenantID:         s.TenantID,
+		UserID:           s.UserID,
+		RefreshTokenHash: s.RefreshTokenHash,
+		UserAgent:        s.UserAgent,
+		IPAddress:        s.IPAddress,
+		CreatedAt:        s.CreatedAt,
+		LastUsedAt:       s.LastUsedAt,
+		ExpiresAt:        s.ExpiresAt,
+		RevokedAt:        s.RevokedAt,
+	}
+}
@@ -2,20 +2,24 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/schema/mixin"
 	"good-todo-go/internal/ent/todo"
 	"good-todo-go/internal/infrastructure/database"
 )
 
 type TodoRepository struct {
 	client *ent.Client
+	// replica serves listings and counts; nil reads everything from the primary
+	replica *database.ReadReplica
 }
 
-func NewTodoRepository(client *ent.Client) repository.ITodoRepository {
-	return &TodoRepository{client: client}
+func NewTodoRepository(client *ent.Client, replica *database.ReadReplica) repository.ITodoRepository {
+	return &TodoRepository{client: client, replica: replica}
 }
 
 // FindByID reads a single todo (RLS handles tenant isolation)
@@ -37,9 +41,9 @@ func (r *TodoRepository) FindByID(ctx context.Context, todoID string) (*model.To
 	return toTodoModel(t), nil
 }
 
-// FindByUserID reads todos (RLS handles tenant isolation)
+// FindByUserID reads todos, from the replica if any (RLS handles tenant isolation)
 func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error) {
-	tx, err := database.TenantScopedTx(ctx, r.client)
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
 	if err != nil {
 		return nil, err
 	}
@@ -66,9 +70,9 @@ func (r *TodoRepository) FindByUserID(ctx context.Context, userID string, limit,
 	return result, nil
 }
 
-// CountByUserID counts todos (RLS handles tenant isolation)
+// CountByUserID counts todos, on the replica if any (RLS handles tenant isolation)
 func (r *TodoRepository) CountByUserID(ctx context.Context, userID string) (int, error) {
-	tx, err := database.TenantScopedTx(ctx, r.client)
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
 	if err != nil {
 		return 0, err
 	}
@@ -87,14 +91,41 @@ func (r *TodoRepository) CountByUserID(ctx context.Context, userID string) (int,
 	return count, nil
 }
 
-// FindPublic reads public todos from the same tenant (RLS handles tenant isolation)
-func (r *TodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error) {
+// FindAllByUserID reads every todo of the user, trashed ones included, oldest first (RLS handles tenant isolation)
+func (r *TodoRepository) FindAllByUserID(ctx context.Context, userID string) ([]*model.Todo, error) {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
 
+	todos, err := tx.Todo.Query().
+		Where(todo.UserIDEQ(userID)).
+		Order(ent.Asc(todo.FieldCreatedAt)).
+		All(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Todo, len(todos))
+	for i, t := range todos {
+		result[i] = toTodoModel(t)
+	}
+	return result, nil
+}
+
+// FindPublic reads public todos from the same tenant, from the replica if any (RLS handles tenant isolation)
+func (r *TodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*model.Todo, error) {
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	todos, err := tx.Todo.Query().
 		Where(todo.IsPublicEQ(true)).
 		Order(ent.Desc(todo.FieldCreatedAt)).
@@ -116,9 +147,9 @@ func (r *TodoRepository) FindPublic(ctx context.Context, limit, offset int) ([]*
 	return result, nil
 }
 
-// CountPublic counts public todos in the same tenant (RLS handles tenant isolation)
+// CountPublic counts public todos in the same tenant, on the replica if any (RLS handles tenant isolation)
 func (r *TodoRepository) CountPublic(ctx context.Context) (int, error) {
-	tx, err := database.TenantScopedTx(ctx, r.client)
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
 	if err != nil {
 		return 0, err
 	}
@@ -179,6 +210,8 @@ func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo
 	defer tx.Rollback()
 
 	builder := tx.Todo.UpdateOneID(t.ID).
+		Where(todo.VersionEQ(t.Version)).
+		AddVersion(1).
 		SetTitle(t.Title).
 		SetDescription(t.Description).
 		SetCompleted(t.Completed).
@@ -191,6 +224,10 @@ func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo
 	}
 
 	updated, err := builder.Save(ctx)
+	if ent.IsNotFound(err) {
+		// The row exists (the caller just read it), so the version moved on
+		return nil, repository.ErrVersionConflict
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +239,7 @@ func (r *TodoRepository) Update(ctx context.Context, t *model.Todo) (*model.Todo
 	return toTodoModel(updated), nil
 }
 
-// Delete writes directly to todos table (RLS protected)
+// Delete moves the todo to the trash; the soft delete mixin turns it into an update of deleted_at (RLS protected)
 func (r *TodoRepository) Delete(ctx context.Context, todoID string) error {
 	tx, err := database.TenantScopedTx(ctx, r.client)
 	if err != nil {
@@ -217,6 +254,151 @@ func (r *TodoRepository) Delete(ctx context.Context, todoID string) error {
 	return tx.Commit()
 }
 
+// FindDeletedByID reads a single todo in the trash (RLS handles tenant isolation)
+func (r *TodoRepository) FindDeletedByID(ctx context.Context, todoID string) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	t, err := tx.Todo.Query().
+		Where(
+			todo.IDEQ(todoID),
+			todo.DeletedAtNotNil(),
+		).
+		Only(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return toTodoModel(t), nil
+}
+
+// FindDeletedByUserID reads todos in the trash, most recently deleted first, from the replica if any (RLS handles tenant isolation)
+func (r *TodoRepository) FindDeletedByUserID(ctx context.Context, userID string, limit, offset int) ([]*model.Todo, error) {
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	todos, err := tx.Todo.Query().
+		Where(
+			todo.UserIDEQ(userID),
+			todo.DeletedAtNotNil(),
+		).
+		Order(ent.Desc(todo.FieldDeletedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.Todo, len(todos))
+	for i, t := range todos {
+		result[i] = toTodoModel(t)
+	}
+	return result, nil
+}
+
+// CountDeletedByUserID counts todos in the trash, on the replica if any (RLS handles tenant isolation)
+func (r *TodoRepository) CountDeletedByUserID(ctx context.Context, userID string) (int, error) {
+	tx, err := database.ReadScopedTx(ctx, r.client, r.replica)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	count, err := tx.Todo.Query().
+		Where(
+			todo.UserIDEQ(userID),
+			todo.DeletedAtNotNil(),
+		).
+		Count(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Restore clears deleted_at so the todo leaves the trash, and bumps the version like an update (RLS protected)
+func (r *TodoRepository) Restore(ctx context.Context, todoID string) (*model.Todo, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	restored, err := tx.Todo.UpdateOneID(todoID).
+		Where(todo.DeletedAtNotNil()).
+		ClearDeletedAt().
+		AddVersion(1).
+		Save(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toTodoModel(restored), nil
+}
+
+// Purge permanently deletes a todo in the trash (RLS protected)
+func (r *TodoRepository) Purge(ctx context.Context, todoID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.Todo.DeleteOneID(todoID).
+		Where(todo.DeletedAtNotNil()).
+		Exec(mixin.SkipSoftDelete(ctx)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PurgeDeletedBefore permanently deletes todos that were moved to the trash before the given time
+func (r *TodoRepository) PurgeDeletedBefore(ctx context.Context, tenantID string, before time.Time) (int, error) {
+	tx, err := database.WithTenantScope(ctx, r.client, tenantID)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	n, err := tx.Todo.Delete().
+		Where(
+			todo.TenantIDEQ(tenantID),
+			todo.DeletedAtLT(before),
+		).
+		Exec(mixin.SkipSoftDelete(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
 // toTodoModel converts ent.Todo to model.Todo
 func toTodoModel(t *ent.Todo) *model.Todo {
 	return &model.Todo{
@@ -228,6 +410,8 @@ func toTodoModel(t *ent.Todo) *model.Todo {
 		Completed:   t.Completed,
 		IsPublic:    t.IsPublic,
 		DueDate:     t.DueDate,
+		DeletedAt:   t.DeletedAt,
+		Version:     t.Version,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
 	}
@@ -21,7 +21,7 @@ func TestTodoRepository_Create(t *testing.T) {
 	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
 	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -56,7 +56,7 @@ func TestTodoRepository_FindByID(t *testing.T) {
 	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
 	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "todo-find-1", tenant.ID, user.ID))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -81,7 +81,7 @@ func TestTodoRepository_FindByUserID(t *testing.T) {
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetTitle("Todo 2"))
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetTitle("Todo 3"))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -104,7 +104,7 @@ func TestTodoRepository_CountByUserID(t *testing.T) {
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID))
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -128,7 +128,7 @@ func TestTodoRepository_FindPublic(t *testing.T) {
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetIsPublic(true).SetTitle("Public 2"))
 	common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetIsPublic(false).SetTitle("Private"))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -152,7 +152,7 @@ func TestTodoRepository_Update(t *testing.T) {
 	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
 	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID).SetTitle("Original Title"))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -186,7 +186,7 @@ func TestTodoRepository_Delete(t *testing.T) {
 	user := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
 	todo := common.CreateTodo(t, client, common.DefaultTodoBuilder(client, "", tenant.ID, user.ID))
 
-	repo := NewTodoRepository(client)
+	repo := NewTodoRepository(client, nil)
 
 	// Set tenant context
 	ctx := database.WithTenantID(context.Background(), tenant.ID)
@@ -198,4 +198,12 @@ func TestTodoRepository_Delete(t *testing.T) {
 	// Verify deletion
 	_, err = repo.FindByID(ctx, todo.ID)
 	assert.Error(t, err) // Should not find deleted todo
+
+	deleted, err := repo.FindDeletedByID(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, todo.Version+1, deleted.Version)
+
+	restored, err := repo.Restore(ctx, todo.ID)
+	require.NoError(t, err)
+	assert.Equal(t, todo.Version+2, restored.Version)
 }
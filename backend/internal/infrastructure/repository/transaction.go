@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/infrastructure/database"
+)
+
+type TransactionManager struct {
+	client *ent.Client
+}
+
+func NewTransactionManager(client *ent.Client) repository.ITransactionManager {
+	return &TransactionManager{client: client}
+}
+
+func (m *TransactionManager) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return database.RunInTenantTx(ctx, m.client, fn)
+}
+
+func (m *TransactionManager) Savepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	return database.Savepoint(ctx, fn)
+}
@@ -6,6 +6,12 @@ import (
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/idempotencykey"
+	"good-todo-go/internal/ent/loginattempt"
+	"good-todo-go/internal/ent/personalaccesstoken"
+	"good-todo-go/internal/ent/schema/mixin"
+	"good-todo-go/internal/ent/session"
+	"good-todo-go/internal/ent/todo"
 	"good-todo-go/internal/ent/user"
 	"good-todo-go/internal/infrastructure/database"
 )
@@ -86,3 +92,88 @@ func (r *UserRepository) Update(ctx context.Context, u *model.User) (*model.User
 
 	return toUserModel(updated), nil
 }
+
+func (r *UserRepository) FindOldestAdmin(ctx context.Context, exceptUserID string) (*model.User, error) {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Query().
+		Where(
+			user.RoleEQ(user.RoleAdmin),
+			user.IDNEQ(exceptUserID),
+		).
+		Order(ent.Asc(user.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return toUserModel(u), nil
+}
+
+func (r *UserRepository) Delete(ctx context.Context, userID, publicTodosOwnerID string) error {
+	tx, err := database.TenantScopedTx(ctx, r.client)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	u, err := tx.User.Get(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if publicTodosOwnerID != "" {
+		if _, err := tx.Todo.Update().
+			Where(
+				todo.UserIDEQ(userID),
+				todo.IsPublicEQ(true),
+			).
+			SetUserID(publicTodosOwnerID).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	// The trash is purged too
+	if _, err := tx.Todo.Delete().
+		Where(todo.UserIDEQ(userID)).
+		Exec(mixin.SkipSoftDelete(ctx)); err != nil {
+		return err
+	}
+	if _, err := tx.Session.Delete().
+		Where(session.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.PersonalAccessToken.Delete().
+		Where(personalaccesstoken.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.IdempotencyKey.Delete().
+		Where(idempotencykey.UserIDEQ(userID)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := tx.LoginAttempt.Delete().
+		Where(loginattempt.EmailEQ(u.Email)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if err := tx.User.DeleteOneID(userID).Exec(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
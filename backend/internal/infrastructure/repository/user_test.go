@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/user"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/integration_test/common"
 
@@ -283,3 +285,66 @@ func TestUserRepository_RLS_UpdateIsolation(t *testing.T) {
 		assert.Equal(t, "Original Name", found.Name)
 	})
 }
+
+func TestUserRepository_FindOldestAdmin(t *testing.T) {
+	t.Parallel()
+
+	client := common.SetupTestClient(t)
+
+	tenant := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	admin := common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).SetRole(user.RoleAdmin))
+	common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID).SetRole(user.RoleAdmin))
+	common.CreateUser(t, client, common.DefaultUserBuilder(client, "", tenant.ID))
+
+	repo := NewUserRepository(client)
+	ctx := database.WithTenantID(context.Background(), tenant.ID)
+
+	found, err := repo.FindOldestAdmin(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, found.ID)
+
+	// An admin deleting their own account is skipped
+	found, err = repo.FindOldestAdmin(ctx, admin.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, admin.ID, found.ID)
+
+	other := common.CreateTenant(t, client, common.DefaultTenantBuilder(client, ""))
+	found, err = repo.FindOldestAdmin(database.WithTenantID(context.Background(), other.ID), "")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestUserRepository_RLS_DeleteIsolation(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+
+	tenant1 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant1-delete-rls"))
+	tenant2 := common.CreateTenant(t, adminClient, common.DefaultTenantBuilder(adminClient, "tenant2-delete-rls"))
+
+	user1 := common.CreateUser(t, adminClient, common.DefaultUserBuilder(adminClient, "", tenant1.ID))
+	todo1 := common.CreateTodo(t, adminClient, common.DefaultTodoBuilder(adminClient, "", tenant1.ID, user1.ID))
+
+	repo := NewUserRepository(appClient)
+
+	t.Run("fail - Tenant2 cannot delete Tenant1 user", func(t *testing.T) {
+		ctx := database.WithTenantID(context.Background(), tenant2.ID)
+
+		err := repo.Delete(ctx, user1.ID, "")
+		require.Error(t, err, "RLS should block cross-tenant delete")
+
+		_, err = adminClient.Todo.Get(context.Background(), todo1.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("success - the user and their todos are deleted", func(t *testing.T) {
+		ctx := database.WithTenantID(context.Background(), tenant1.ID)
+
+		require.NoError(t, repo.Delete(ctx, user1.ID, ""))
+
+		_, err := adminClient.User.Get(context.Background(), user1.ID)
+		assert.True(t, ent.IsNotFound(err))
+		_, err = adminClient.Todo.Get(context.Background(), todo1.ID)
+		assert.True(t, ent.IsNotFound(err))
+	})
+}
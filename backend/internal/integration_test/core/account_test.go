@@ -0,0 +1,106 @@
+package integration_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"good-todo-go/internal/ent"
+	"good-todo-go/internal/ent/user"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccount_ExportAndDelete(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+	ctx := context.Background()
+
+	// User2 is the admin who gets User1's public todos
+	require.NoError(t, adminClient.User.UpdateOneID(dataSet.User2.ID).SetRole(user.RoleAdmin).Exec(ctx))
+
+	call := func(method, path, userID, role string, payload any, handler echo.HandlerFunc) (*httptest.ResponseRecorder, error) {
+		var body []byte
+		if payload != nil {
+			body, _ = json.Marshal(payload)
+		}
+		req := httptest.NewRequest(method, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, userID, dataSet.Tenant1.ID)
+		SetRoleContext(c, role)
+		return rec, handler(c)
+	}
+
+	var he *echo.HTTPError
+	_, err := call(http.MethodPut, "/tenant/deletion-policy", dataSet.User1.ID, "member",
+		api.DeletionPolicyRequest{PublicTodos: "reassign"}, deps.AccountController.SetDeletionPolicy)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusForbidden, he.Code)
+	_, err = call(http.MethodPut, "/tenant/deletion-policy", dataSet.User2.ID, "admin",
+		api.DeletionPolicyRequest{PublicTodos: "reassign"}, deps.AccountController.SetDeletionPolicy)
+	require.NoError(t, err)
+
+	// The export has User1's todos only
+	rec, err := call(http.MethodGet, "/me/export", dataSet.User1.ID, "member", nil, deps.AccountController.Export)
+	require.NoError(t, err)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+	var export api.AccountExportResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &export))
+	assert.Equal(t, dataSet.User1.Email, *export.User.Email)
+	require.Len(t, export.Todos, 2)
+
+	// A wrong password or code does not delete anything
+	_, err = call(http.MethodDelete, "/me", dataSet.User1.ID, "member",
+		api.DeleteAccountRequest{Password: strPtr("wrong-password")}, deps.AccountController.Delete)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+
+	_, err = call(http.MethodPost, "/me/deletion-code", dataSet.User1.ID, "member", nil, deps.AccountController.SendDeletionCode)
+	require.NoError(t, err)
+	messages := deps.Mailer.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, dataSet.User1.Email, messages[0].To)
+	code := regexp.MustCompile(`\d{6}`).FindString(messages[0].Body)
+
+	rec, err = call(http.MethodDelete, "/me", dataSet.User1.ID, "member",
+		api.DeleteAccountRequest{Code: &code}, deps.AccountController.Delete)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	_, err = adminClient.User.Get(ctx, dataSet.User1.ID)
+	assert.True(t, ent.IsNotFound(err))
+	_, err = adminClient.Todo.Get(ctx, dataSet.Todo1.ID)
+	assert.True(t, ent.IsNotFound(err))
+	reassigned, err := adminClient.Todo.Get(ctx, dataSet.Todo2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, dataSet.User2.ID, reassigned.UserID)
+
+	// Other users keep their data
+	_, err = adminClient.Todo.Get(ctx, dataSet.Todo3.ID)
+	assert.NoError(t, err)
+
+	// The append-only audit log keeps the events but none of the personal data
+	events, err := adminClient.AuditEvent.Query().All(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	for _, event := range events {
+		snapshots, err := json.Marshal([]map[string]any{event.Before, event.After})
+		require.NoError(t, err)
+		assert.NotContains(t, string(snapshots), dataSet.User1.Email, "audit event %s", event.ID)
+		assert.NotContains(t, string(snapshots), dataSet.Todo1.Title, "audit event %s", event.ID)
+	}
+}
@@ -0,0 +1,108 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAudit_TodoMutations(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	// Update then delete Todo1 as its owner
+	e := SetupEcho()
+	body, err := json.Marshal(api.UpdateTodoRequest{Title: strPtr("Audited Title")})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPut, "/todos/"+dataSet.Todo1.ID, bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	c := e.NewContext(req, httptest.NewRecorder())
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TodoController.UpdateTodo(c, dataSet.Todo1.ID, api.UpdateTodoParams{}))
+
+	c = e.NewContext(httptest.NewRequest(http.MethodDelete, "/todos/"+dataSet.Todo1.ID, nil), httptest.NewRecorder())
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TodoController.DeleteTodo(c, dataSet.Todo1.ID))
+
+	tests := []struct {
+		name          string
+		userID        string
+		tenantID      string
+		role          string
+		params        api.GetAuditEventsParams
+		expectedTotal int
+		wantErr       bool
+	}{
+		{
+			name:     "success - admin sees update and soft delete of the todo",
+			userID:   dataSet.User2.ID,
+			tenantID: dataSet.Tenant1.ID,
+			role:     "admin",
+			params: api.GetAuditEventsParams{
+				EntityType: strPtr("Todo"),
+				EntityId:   strPtr(dataSet.Todo1.ID),
+				ActorId:    strPtr(dataSet.User1.ID),
+			},
+			expectedTotal: 2,
+		},
+		{
+			name:     "success - other tenant admin sees nothing",
+			userID:   dataSet.User3.ID,
+			tenantID: dataSet.Tenant2.ID,
+			role:     "admin",
+			params: api.GetAuditEventsParams{
+				EntityId: strPtr(dataSet.Todo1.ID),
+			},
+			expectedTotal: 0,
+		},
+		{
+			name:     "fail - member cannot view audit events",
+			userID:   dataSet.User1.ID,
+			tenantID: dataSet.Tenant1.ID,
+			role:     "member",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/audit-events", nil), rec)
+			SetAuthContext(c, tt.userID, tt.tenantID)
+			SetRoleContext(c, tt.role)
+
+			err := deps.AuditController.GetAuditEvents(c, tt.params)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var response api.AuditEventListResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Equal(t, tt.expectedTotal, *response.Total)
+
+			if tt.expectedTotal == 2 {
+				events := *response.Events
+				// Newest first
+				assert.Equal(t, api.AuditEventResponseAction("soft_delete"), *events[0].Action)
+				assert.Equal(t, api.AuditEventResponseAction("update"), *events[1].Action)
+				// The change is recorded, but not the personal content
+				assert.Equal(t, "[REDACTED]", (*events[1].Before)["title"])
+				assert.Equal(t, "[REDACTED]", (*events[1].After)["title"])
+			}
+		})
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg/totp"
 	"good-todo-go/internal/presentation/public/api"
 
 	"github.com/labstack/echo/v4"
@@ -280,6 +282,161 @@ func TestAuth_Login(t *testing.T) {
 	}
 }
 
+func TestAuth_Lockout(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	registerReq := api.RegisterRequest{
+		Email:      "lockout-test@example.com",
+		Password:   "password123",
+		Name:       strPtr("Lockout Test User"),
+		TenantSlug: "lockout-test-tenant",
+	}
+	body, _ := json.Marshal(registerReq)
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, deps.AuthController.Register(e.NewContext(req, rec)))
+
+	var registerResponse api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResponse))
+	userID := *registerResponse.User.Id
+	tenantID := *registerResponse.User.TenantId
+
+	login := func(password string) (*httptest.ResponseRecorder, error) {
+		body, _ := json.Marshal(api.LoginRequest{
+			Email:      "lockout-test@example.com",
+			Password:   password,
+			TenantSlug: "lockout-test-tenant",
+		})
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return rec, deps.AuthController.Login(e.NewContext(req, rec))
+	}
+	assertStatus := func(t *testing.T, err error, status int) {
+		t.Helper()
+		var he *echo.HTTPError
+		require.ErrorAs(t, err, &he)
+		assert.Equal(t, status, he.Code)
+	}
+
+	for range TestLoginLockoutPolicy.MaxFailures {
+		_, err := login("wrongpassword")
+		assertStatus(t, err, http.StatusUnauthorized)
+	}
+
+	// The right password is refused while the account is locked
+	rec, err := login("password123")
+	assertStatus(t, err, http.StatusLocked)
+	assert.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+
+	messages := deps.Mailer.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "lockout-test@example.com", messages[0].To)
+
+	// Members cannot unlock
+	c := e.NewContext(httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil), httptest.NewRecorder())
+	SetAuthContext(c, userID, tenantID)
+	SetRoleContext(c, "member")
+	assertStatus(t, deps.AuthController.UnlockUser(c, userID), http.StatusForbidden)
+
+	rec = httptest.NewRecorder()
+	c = e.NewContext(httptest.NewRequest(http.MethodPost, "/users/"+userID+"/unlock", nil), rec)
+	SetAuthContext(c, "admin-user", tenantID)
+	SetRoleContext(c, "admin")
+	require.NoError(t, deps.AuthController.UnlockUser(c, userID))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, err = login("password123")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuth_MFA(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	body, _ := json.Marshal(api.RegisterRequest{
+		Email:      "mfa-test@example.com",
+		Password:   "password123",
+		Name:       strPtr("MFA Test User"),
+		TenantSlug: "mfa-test-tenant",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, deps.AuthController.Register(e.NewContext(req, rec)))
+
+	var registerResponse api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResponse))
+	userID := *registerResponse.User.Id
+	tenantID := *registerResponse.User.TenantId
+
+	post := func(path string, payload any, handler func(echo.Context) error) (*httptest.ResponseRecorder, error) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, userID, tenantID)
+		return rec, handler(c)
+	}
+
+	// Enroll and confirm with the current code
+	rec, err := post("/me/mfa/enroll", nil, deps.MFAController.Enroll)
+	require.NoError(t, err)
+	var enrollment api.MFAEnrollmentResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &enrollment))
+	require.NotEmpty(t, enrollment.Secret)
+
+	code, err := totp.Code(enrollment.Secret, time.Now())
+	require.NoError(t, err)
+	rec, err = post("/me/mfa/confirm", api.MFACodeRequest{Code: code}, deps.MFAController.Confirm)
+	require.NoError(t, err)
+	var recovery api.MFARecoveryCodesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &recovery))
+	require.NotEmpty(t, recovery.RecoveryCodes)
+
+	// The password alone no longer issues tokens
+	rec, err = post("/auth/login", api.LoginRequest{
+		Email:      "mfa-test@example.com",
+		Password:   "password123",
+		TenantSlug: "mfa-test-tenant",
+	}, deps.AuthController.Login)
+	require.NoError(t, err)
+	var challenge api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &challenge))
+	require.NotNil(t, challenge.MfaRequired)
+	assert.True(t, *challenge.MfaRequired)
+	assert.Nil(t, challenge.AccessToken)
+
+	// The confirmation code cannot be replayed, but a recovery code works once
+	verify := func(code string) (*httptest.ResponseRecorder, error) {
+		return post("/auth/mfa/verify", api.MFAVerifyRequest{MfaToken: *challenge.MfaToken, Code: code}, deps.AuthController.VerifyMFA)
+	}
+	_, err = verify(code)
+	var he *echo.HTTPError
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+
+	rec, err = verify(recovery.RecoveryCodes[0])
+	require.NoError(t, err)
+	var authResponse api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &authResponse))
+	assert.NotEmpty(t, authResponse.AccessToken)
+
+	_, err = verify(recovery.RecoveryCodes[0])
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+}
+
 func TestAuth_RefreshToken(t *testing.T) {
 	t.Parallel()
 
@@ -1,11 +1,20 @@
 package integration_test
 
 import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
 	"good-todo-go/internal/ent"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/audit"
+	"good-todo-go/internal/pkg/breach"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
+	"good-todo-go/internal/pkg/oidc"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/presenter"
 	"good-todo-go/internal/presentation/public/router/context_keys"
@@ -16,45 +25,130 @@ import (
 
 // TestDependencies holds all the dependencies for integration tests
 type TestDependencies struct {
-	Client         *ent.Client
-	AuthController *controller.AuthController
-	TodoController *controller.TodoController
-	UserController *controller.UserController
-	JWTService     *pkg.JWTService
+	Client            *ent.Client
+	AuthController    *controller.AuthController
+	TodoController    *controller.TodoController
+	UserController    *controller.UserController
+	AuditController   *controller.AuditController
+	MFAController     *controller.MFAController
+	OIDCController    *controller.OIDCController
+	TokenController   *controller.PersonalAccessTokenController
+	SessionController *controller.SessionController
+	AccountController *controller.AccountController
+	JWTService        *pkg.JWTService
+	Tokens            usecase.IPersonalAccessTokenInteractor
+	Sessions          usecase.ISessionInteractor
+	Idempotency       usecase.IIdempotencyInteractor
+	Mailer            *RecordingMailer
+}
+
+// TestLoginLockoutPolicy locks after 3 failures and has no delay between attempts
+var TestLoginLockoutPolicy = usecase.LoginLockoutPolicy{
+	MaxFailures:      3,
+	Window:           15 * time.Minute,
+	LockoutDuration:  15 * time.Minute,
+	MaxIPFailures:    100,
+	AttemptRetention: 24 * time.Hour,
+}
+
+// TestOIDCRedirectBaseURL is where single sign-on callbacks are sent in tests
+const TestOIDCRedirectBaseURL = "http://api.test"
+
+// RecordingMailer keeps sent mail for assertions instead of sending it
+type RecordingMailer struct {
+	mu       sync.Mutex
+	messages []*mailer.Message
+}
+
+func (m *RecordingMailer) Send(_ context.Context, msg *mailer.Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func (m *RecordingMailer) Check(context.Context) error {
+	return nil
+}
+
+// Messages returns the mail sent so far
+func (m *RecordingMailer) Messages() []*mailer.Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*mailer.Message(nil), m.messages...)
 }
 
 // BuildTestDependencies creates all dependencies for integration tests
 func BuildTestDependencies(client *ent.Client) *TestDependencies {
 	// Repositories
 	authRepo := repository.NewAuthRepository(client)
-	todoRepo := repository.NewTodoRepository(client)
+	todoRepo := repository.NewTodoRepository(client, nil)
 	userRepo := repository.NewUserRepository(client)
+	auditEventRepo := repository.NewAuditEventRepository(client)
+	txManager := repository.NewTransactionManager(client)
+	idempotencyKeyRepo := repository.NewIdempotencyKeyRepository(client)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(client)
+	oidcConfigRepo := repository.NewOIDCConfigRepository(client)
+	tokenRepo := repository.NewPersonalAccessTokenRepository(client)
+	sessionRepo := repository.NewSessionRepository(client)
 
 	// Services
 	uuidGen := pkg.NewUUIDGenerator()
 	jwtService := pkg.NewJWTService("test-secret-key-for-integration-tests", 3600, 86400)
+	recordingMailer := &RecordingMailer{}
+	passwordHasher, _ := pkg.NewPasswordHasher(pkg.PasswordAlgorithmBcrypt, pkg.DefaultCost, pkg.DefaultArgon2Params)
+	passwordPolicy := usecase.NewPasswordPolicy(passwordHasher, breach.NewNoopChecker(), 8)
 
 	// Usecases
-	authInteractor := usecase.NewAuthInteractor(authRepo, jwtService, uuidGen)
-	todoInteractor := usecase.NewTodoInteractor(todoRepo, userRepo, uuidGen)
-	userInteractor := usecase.NewUserInteractor(userRepo)
+	authInteractor := usecase.NewAuthInteractor(authRepo, loginAttemptRepo, sessionRepo, jwtService, uuidGen, pkg.NewClock(), recordingMailer, passwordPolicy, TestLoginLockoutPolicy)
+	todoInteractor := usecase.NewTodoInteractor(todoRepo, userRepo, txManager, uuidGen)
+	userInteractor := usecase.NewUserInteractor(userRepo, authRepo, sessionRepo, pkg.NewClock(), recordingMailer, passwordPolicy)
+	auditInteractor := usecase.NewAuditInteractor(auditEventRepo)
+	mfaInteractor := usecase.NewMFAInteractor(authRepo, pkg.NewClock())
+	oidcInteractor := usecase.NewOIDCInteractor(authRepo, oidcConfigRepo, oidc.NewClient(http.DefaultClient), sessionRepo, jwtService, uuidGen, pkg.NewClock(), TestOIDCRedirectBaseURL)
+	tokenInteractor := usecase.NewPersonalAccessTokenInteractor(tokenRepo, authRepo, uuidGen, pkg.NewClock())
+	sessionInteractor := usecase.NewSessionInteractor(sessionRepo, authRepo, pkg.NewClock())
+	accountInteractor := usecase.NewAccountInteractor(userRepo, authRepo, todoRepo, sessionRepo, tokenRepo, pkg.NewClock(), recordingMailer)
+	idempotencyInteractor := usecase.NewIdempotencyInteractor(idempotencyKeyRepo, authRepo, pkg.NewClock(), 24*time.Hour)
 
 	// Presenters
 	authPresenter := presenter.NewAuthPresenter()
 	todoPresenter := presenter.NewTodoPresenter()
 	userPresenter := presenter.NewUserPresenter()
+	auditPresenter := presenter.NewAuditPresenter()
+	mfaPresenter := presenter.NewMFAPresenter()
+	oidcPresenter := presenter.NewOIDCPresenter()
+	tokenPresenter := presenter.NewPersonalAccessTokenPresenter()
+	sessionPresenter := presenter.NewSessionPresenter()
+	accountPresenter := presenter.NewAccountPresenter()
 
 	// Controllers
 	authController := controller.NewAuthController(authInteractor, authPresenter)
 	todoController := controller.NewTodoController(todoInteractor, todoPresenter)
 	userController := controller.NewUserController(userInteractor, userPresenter)
+	auditController := controller.NewAuditController(auditInteractor, auditPresenter)
+	mfaController := controller.NewMFAController(mfaInteractor, mfaPresenter)
+	oidcController := controller.NewOIDCController(oidcInteractor, oidcPresenter)
+	tokenController := controller.NewPersonalAccessTokenController(tokenInteractor, tokenPresenter)
+	sessionController := controller.NewSessionController(sessionInteractor, sessionPresenter)
+	accountController := controller.NewAccountController(accountInteractor, accountPresenter)
 
 	return &TestDependencies{
-		Client:         client,
-		AuthController: authController,
-		TodoController: todoController,
-		UserController: userController,
-		JWTService:     jwtService,
+		Client:            client,
+		AuthController:    authController,
+		TodoController:    todoController,
+		UserController:    userController,
+		AuditController:   auditController,
+		MFAController:     mfaController,
+		OIDCController:    oidcController,
+		TokenController:   tokenController,
+		SessionController: sessionController,
+		AccountController: accountController,
+		JWTService:        jwtService,
+		Tokens:            tokenInteractor,
+		Sessions:          sessionInteractor,
+		Idempotency:       idempotencyInteractor,
+		Mailer:            recordingMailer,
 	}
 }
 
@@ -72,5 +166,11 @@ func SetAuthContext(c echo.Context, userID, tenantID string) {
 
 	// Also set tenant ID in request context for repository layer
 	ctx := database.WithTenantID(c.Request().Context(), tenantID)
+	ctx = audit.WithActor(ctx, userID)
 	c.SetRequest(c.Request().WithContext(ctx))
 }
+
+// SetRoleContext sets the user's role for the Echo context
+func SetRoleContext(c echo.Context, role string) {
+	c.Set(context_keys.RoleContextKey, role)
+}
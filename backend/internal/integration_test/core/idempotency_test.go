@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/ent/todo"
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/router/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotency_CreateTodo(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	e := SetupEcho()
+	handler := middleware.IdempotencyMiddleware(deps.Idempotency)(deps.TodoController.CreateTodo)
+	create := func(userID, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/todos", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(middleware.HeaderIdempotencyKey, key)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/todos")
+		SetAuthContext(c, userID, dataSet.Tenant1.ID)
+		if err := handler(c); err != nil {
+			e.HTTPErrorHandler(err, c)
+		}
+		return rec
+	}
+
+	// The retry gets the same todo back and nothing new is created
+	first := create(dataSet.User1.ID, "retry-key", `{"title":"Buy milk"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	retry := create(dataSet.User1.ID, "retry-key", `{"title":"Buy milk"}`)
+	require.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get("Idempotent-Replayed"))
+
+	var firstTodo, retriedTodo api.TodoResponse
+	require.NoError(t, json.Unmarshal(first.Body.Bytes(), &firstTodo))
+	require.NoError(t, json.Unmarshal(retry.Body.Bytes(), &retriedTodo))
+	assert.Equal(t, *firstTodo.Id, *retriedTodo.Id)
+
+	count, err := adminClient.Todo.Query().Where(todo.TitleEQ("Buy milk")).Count(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Same key with another body is rejected
+	mismatch := create(dataSet.User1.ID, "retry-key", `{"title":"Buy bread"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, mismatch.Code)
+
+	// Keys are per user, so another user can use the same one
+	other := create(dataSet.User2.ID, "retry-key", `{"title":"Buy bread"}`)
+	assert.Equal(t, http.StatusCreated, other.Code)
+	assert.Empty(t, other.Header().Get("Idempotent-Replayed"))
+}
@@ -0,0 +1,123 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/pkg/oidc/oidctest"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOIDC_SignIn(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+	provider := oidctest.NewProvider(t)
+
+	// Register the tenant and its admin
+	body, _ := json.Marshal(api.RegisterRequest{
+		Email:      "admin@example.com",
+		Password:   "password123",
+		Name:       strPtr("Admin"),
+		TenantSlug: "oidc-test-tenant",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, deps.AuthController.Register(e.NewContext(req, rec)))
+	var registerResponse api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registerResponse))
+	adminID := *registerResponse.User.Id
+	tenantID := *registerResponse.User.TenantId
+
+	// Configure the provider
+	body, _ = json.Marshal(api.OIDCConfigRequest{
+		Issuer:         provider.Issuer,
+		ClientId:       oidctest.ClientID,
+		ClientSecret:   strPtr(oidctest.ClientSecret),
+		AllowedDomains: []string{"example.com"},
+	})
+	req = httptest.NewRequest(http.MethodPut, "/tenant/oidc", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	SetAuthContext(c, adminID, tenantID)
+	SetRoleContext(c, "admin")
+	require.NoError(t, deps.OIDCController.SaveConfig(c))
+	var config api.OIDCConfigResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &config))
+	assert.Equal(t, TestOIDCRedirectBaseURL+"/auth/oidc/oidc-test-tenant/callback", *config.RedirectUrl)
+	assert.NotContains(t, rec.Body.String(), oidctest.ClientSecret)
+
+	signIn := func(t *testing.T) (*httptest.ResponseRecorder, error) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/auth/oidc/oidc-test-tenant/start", nil), rec)
+		require.NoError(t, deps.OIDCController.Start(c, "oidc-test-tenant"))
+		require.Equal(t, http.StatusFound, rec.Code)
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, presenter.OIDCStateCookie, cookies[0].Name)
+		assert.True(t, cookies[0].HttpOnly)
+
+		callback, err := provider.Authorize(rec.Header().Get(echo.HeaderLocation))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(callback.String(), *config.RedirectUrl))
+
+		code := callback.Query().Get("code")
+		state := callback.Query().Get("state")
+		rec = httptest.NewRecorder()
+		c = e.NewContext(httptest.NewRequest(http.MethodGet, callback.RequestURI(), nil), rec)
+		return rec, deps.OIDCController.Callback(c, "oidc-test-tenant", api.OidcCallbackParams{
+			Code:      &code,
+			State:     &state,
+			OidcState: &cookies[0].Value,
+		})
+	}
+
+	// The first sign-in creates the user
+	provider.SignIn(oidctest.User{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+	rec, err := signIn(t)
+	require.NoError(t, err)
+	var first api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &first))
+	assert.NotEmpty(t, first.AccessToken)
+	assert.Equal(t, "alice@example.com", *first.User.Email)
+	assert.Equal(t, api.UserResponseRoleMember, *first.User.Role)
+	assert.Equal(t, tenantID, *first.User.TenantId)
+
+	// The next one signs in the same user
+	rec, err = signIn(t)
+	require.NoError(t, err)
+	var second api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &second))
+	assert.Equal(t, *first.User.Id, *second.User.Id)
+
+	// Existing password users are signed in too
+	provider.SignIn(oidctest.User{Subject: "admin", Email: "admin@example.com", EmailVerified: true})
+	rec, err = signIn(t)
+	require.NoError(t, err)
+	var admin api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &admin))
+	assert.Equal(t, adminID, *admin.User.Id)
+
+	// Other domains are refused
+	provider.SignIn(oidctest.User{Subject: "mallory", Email: "mallory@elsewhere.test", EmailVerified: true})
+	_, err = signIn(t)
+	var he *echo.HTTPError
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusForbidden, he.Code)
+}
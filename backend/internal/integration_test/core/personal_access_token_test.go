@@ -0,0 +1,112 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/presentation/public/router/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalAccessToken_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	// Create a read-only token as User1
+	req := httptest.NewRequest(http.MethodPost, "/me/tokens", strings.NewReader(`{"name":"ci","scopes":["read:todos"],"expires_in_days":7}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TokenController.Create(c))
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var created api.CreatedPersonalAccessTokenResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	assert.Equal(t, []api.TokenScope{api.ReadTodos}, created.Scopes)
+	require.True(t, strings.HasPrefix(created.Token, "gtd_pat_"))
+
+	// Only the hash is stored
+	stored, err := adminClient.PersonalAccessToken.Get(t.Context(), created.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, created.Token, stored.TokenHash)
+
+	// Requests through the auth middleware act as User1, within the token's scopes
+	call := func(method, path, token string) (*httptest.ResponseRecorder, echo.Context) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		handler := middleware.JWTAuthMiddleware(deps.JWTService, deps.Tokens, nil)(func(c echo.Context) error {
+			return c.NoContent(http.StatusNoContent)
+		})
+		if err := handler(c); err != nil {
+			e.HTTPErrorHandler(err, c)
+		}
+		return rec, c
+	}
+
+	rec, c = call(http.MethodGet, "/todos", created.Token)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, dataSet.User1.ID, c.Get(context_keys.UserIDContextKey))
+	assert.Equal(t, dataSet.Tenant1.ID, c.Get(context_keys.TenantIDContextKey))
+	assert.Equal(t, dataSet.User1.Email, c.Get(context_keys.EmailContextKey))
+
+	rec, _ = call(http.MethodPost, "/todos", created.Token)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	// Tokens cannot mint more tokens
+	rec, _ = call(http.MethodGet, "/me/tokens", created.Token)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	rec, _ = call(http.MethodGet, "/todos", created.Token+"x")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	stored, err = adminClient.PersonalAccessToken.Get(t.Context(), created.Id)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.LastUsedAt)
+
+	// Another user cannot revoke it
+	req = httptest.NewRequest(http.MethodDelete, "/me/tokens/"+created.Id, nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	SetAuthContext(c, dataSet.User2.ID, dataSet.Tenant1.ID)
+	err = deps.TokenController.Revoke(c, created.Id)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusNotFound, httpErr.Code)
+
+	// List shows it without the token itself
+	req = httptest.NewRequest(http.MethodGet, "/me/tokens", nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TokenController.List(c))
+	var list api.PersonalAccessTokenListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Tokens, 1)
+	assert.Equal(t, "ci", list.Tokens[0].Name)
+	assert.NotContains(t, rec.Body.String(), created.Token)
+
+	// After revoking, the token no longer works
+	req = httptest.NewRequest(http.MethodDelete, "/me/tokens/"+created.Id, nil)
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TokenController.Revoke(c, created.Id))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec, _ = call(http.MethodGet, "/todos", created.Token)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
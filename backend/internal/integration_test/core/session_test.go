@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/integration_test/common"
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/router/middleware"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSession_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	authPost := func(path, userAgent string, payload any, handler func(echo.Context) error) (api.AuthResponse, error) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("User-Agent", userAgent)
+		rec := httptest.NewRecorder()
+		var res api.AuthResponse
+		if err := handler(e.NewContext(req, rec)); err != nil {
+			return res, err
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res, nil
+	}
+
+	// Register on a laptop, then log in on a phone
+	laptop, err := authPost("/auth/register", "laptop", api.RegisterRequest{
+		Email:      "session-test@example.com",
+		Password:   "password123",
+		Name:       strPtr("Session Test User"),
+		TenantSlug: "session-test-tenant",
+	}, deps.AuthController.Register)
+	require.NoError(t, err)
+	phone, err := authPost("/auth/login", "phone", api.LoginRequest{
+		Email:      "session-test@example.com",
+		Password:   "password123",
+		TenantSlug: "session-test-tenant",
+	}, deps.AuthController.Login)
+	require.NoError(t, err)
+
+	// Requests through the auth middleware, checking sessions on every request
+	call := func(method, path, accessToken string, handler echo.HandlerFunc) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		err := middleware.JWTAuthMiddleware(deps.JWTService, deps.Tokens, deps.Sessions)(handler)(e.NewContext(req, rec))
+		return rec, err
+	}
+
+	rec, err := call(http.MethodGet, "/me/sessions", *laptop.AccessToken, deps.SessionController.List)
+	require.NoError(t, err)
+	var list api.SessionListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Sessions, 2)
+	byAgent := map[string]api.SessionResponse{}
+	for _, s := range list.Sessions {
+		byAgent[s.UserAgent] = s
+	}
+	assert.True(t, byAgent["laptop"].Current)
+	assert.False(t, byAgent["phone"].Current)
+
+	// Refreshing rotates the refresh token; the old one is then a reuse and ends the session
+	refreshed, err := authPost("/auth/refresh", "phone", api.RefreshTokenRequest{RefreshToken: *phone.RefreshToken}, deps.AuthController.RefreshToken)
+	require.NoError(t, err)
+	_, err = authPost("/auth/refresh", "phone", api.RefreshTokenRequest{RefreshToken: *phone.RefreshToken}, deps.AuthController.RefreshToken)
+	var he *echo.HTTPError
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+	_, err = authPost("/auth/refresh", "phone", api.RefreshTokenRequest{RefreshToken: *refreshed.RefreshToken}, deps.AuthController.RefreshToken)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+
+	// The phone's access token is rejected at once
+	_, err = call(http.MethodGet, "/me/sessions", *refreshed.AccessToken, deps.SessionController.List)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+
+	// Log in on the phone again, then log out everywhere else from the laptop
+	phone, err = authPost("/auth/login", "phone", api.LoginRequest{
+		Email:      "session-test@example.com",
+		Password:   "password123",
+		TenantSlug: "session-test-tenant",
+	}, deps.AuthController.Login)
+	require.NoError(t, err)
+
+	rec, err = call(http.MethodDelete, "/me/sessions?except_current=true", *laptop.AccessToken, func(c echo.Context) error {
+		exceptCurrent := true
+		return deps.SessionController.RevokeAll(c, api.RevokeAllSessionsParams{ExceptCurrent: &exceptCurrent})
+	})
+	require.NoError(t, err)
+	var revoked api.RevokedSessionsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &revoked))
+	assert.Equal(t, 1, revoked.Revoked)
+
+	_, err = authPost("/auth/refresh", "phone", api.RefreshTokenRequest{RefreshToken: *phone.RefreshToken}, deps.AuthController.RefreshToken)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+	_, err = authPost("/auth/refresh", "laptop", api.RefreshTokenRequest{RefreshToken: *laptop.RefreshToken}, deps.AuthController.RefreshToken)
+	require.NoError(t, err)
+}
+
+func TestSession_ForceLogout(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	logout := func(role, userID string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPost, "/users/"+userID+"/logout", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+		SetRoleContext(c, role)
+		return rec, deps.SessionController.ForceLogout(c, userID)
+	}
+
+	var he *echo.HTTPError
+	_, err := logout("member", dataSet.User2.ID)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusForbidden, he.Code)
+
+	// Users of other tenants are not found
+	_, err = logout("admin", dataSet.User3.ID)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusNotFound, he.Code)
+
+	rec, err := logout("admin", dataSet.User2.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
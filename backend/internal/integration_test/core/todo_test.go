@@ -2,9 +2,11 @@ package integration_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"good-todo-go/internal/integration_test/common"
@@ -288,7 +290,7 @@ func TestTodo_GetTodo(t *testing.T) {
 			c.SetParamValues(tt.todoID)
 			SetAuthContext(c, tt.userID, tt.tenantID)
 
-			err := deps.TodoController.GetTodo(c, tt.todoID)
+			err := deps.TodoController.GetTodo(c, tt.todoID, api.GetTodoParams{})
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -362,7 +364,7 @@ func TestTodo_Update(t *testing.T) {
 			c.SetParamValues(tt.todoID)
 			SetAuthContext(c, tt.userID, tt.tenantID)
 
-			err = deps.TodoController.UpdateTodo(c, tt.todoID)
+			err = deps.TodoController.UpdateTodo(c, tt.todoID, api.UpdateTodoParams{})
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -386,6 +388,152 @@ func TestTodo_Update(t *testing.T) {
 	}
 }
 
+func TestTodo_ETag(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	e := SetupEcho()
+	update := func(ifMatch string, title string) (*httptest.ResponseRecorder, error) {
+		body, err := json.Marshal(api.UpdateTodoRequest{Title: strPtr(title)})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPut, "/todos/"+dataSet.Todo1.ID, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+		return rec, deps.TodoController.UpdateTodo(c, dataSet.Todo1.ID, api.UpdateTodoParams{IfMatch: &ifMatch})
+	}
+
+	// GET returns the ETag, and If-None-Match with it returns 304
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/todos/"+dataSet.Todo1.ID, nil), rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TodoController.GetTodo(c, dataSet.Todo1.ID, api.GetTodoParams{}))
+	etag := rec.Header().Get("ETag")
+	assert.Equal(t, `"1"`, etag)
+
+	rec = httptest.NewRecorder()
+	c = e.NewContext(httptest.NewRequest(http.MethodGet, "/todos/"+dataSet.Todo1.ID, nil), rec)
+	SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+	require.NoError(t, deps.TodoController.GetTodo(c, dataSet.Todo1.ID, api.GetTodoParams{IfNoneMatch: &etag}))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	// First tab updates with the current ETag
+	rec, err := update(etag, "First tab")
+	require.NoError(t, err)
+	assert.Equal(t, `"2"`, rec.Header().Get("ETag"))
+
+	// Second tab still holds the old ETag and must not overwrite
+	_, err = update(etag, "Second tab")
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusPreconditionFailed, httpErr.Code)
+}
+
+func TestTodo_MergePatch(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	e := SetupEcho()
+	patch := func(contentType, body string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodPatch, "/todos/"+dataSet.Todo1.ID, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+		return rec, deps.TodoController.PatchTodo(c, dataSet.Todo1.ID, api.PatchTodoParams{})
+	}
+
+	// Setting a due date leaves the other fields alone
+	rec, err := patch("application/merge-patch+json", `{"due_date": "2030-01-02T03:04:05Z"}`)
+	require.NoError(t, err)
+	var resp api.TodoResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.DueDate)
+	assert.Equal(t, dataSet.Todo1.Title, *resp.Title)
+
+	// null clears it
+	rec, err = patch("application/merge-patch+json", `{"due_date": null}`)
+	require.NoError(t, err)
+	resp = api.TodoResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Nil(t, resp.DueDate)
+
+	var httpErr *echo.HTTPError
+
+	// Title cannot be cleared
+	_, err = patch("application/merge-patch+json", `{"title": null}`)
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+
+	// Only merge patch documents are accepted
+	_, err = patch(echo.MIMEApplicationJSON, `{"title": "x"}`)
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusUnsupportedMediaType, httpErr.Code)
+}
+
+func TestTodo_Batch(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	e := SetupEcho()
+	batch := func(mode api.TodoBatchRequestMode) (*httptest.ResponseRecorder, error) {
+		body, err := json.Marshal(api.TodoBatchRequest{
+			Mode: &mode,
+			Operations: []api.TodoBatchOperation{
+				{Op: api.TodoBatchOperationOpComplete, Id: &dataSet.Todo1.ID},
+				// Todo3 belongs to User2
+				{Op: api.TodoBatchOperationOpDelete, Id: &dataSet.Todo3.ID},
+			},
+		})
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/todos/batch", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+		return rec, deps.TodoController.BatchTodos(c)
+	}
+
+	// Atomic: the forbidden delete rolls back the completion
+	_, err := batch(api.Atomic)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusForbidden, httpErr.Code)
+
+	todo1, err := adminClient.Todo.Get(context.Background(), dataSet.Todo1.ID)
+	require.NoError(t, err)
+	assert.False(t, todo1.Completed)
+
+	// Independent: the completion is kept and the delete reported as failed
+	rec, err := batch(api.Independent)
+	require.NoError(t, err)
+	var resp api.TodoBatchResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Results)
+	require.Len(t, *resp.Results, 2)
+	assert.Equal(t, http.StatusOK, (*resp.Results)[0].Status)
+	assert.Equal(t, http.StatusForbidden, (*resp.Results)[1].Status)
+	require.NotNil(t, (*resp.Results)[1].Error)
+	assert.Equal(t, api.ErrorCode("FORBIDDEN"), (*resp.Results)[1].Error.Code)
+
+	todo1, err = adminClient.Todo.Get(context.Background(), dataSet.Todo1.ID)
+	require.NoError(t, err)
+	assert.True(t, todo1.Completed)
+
+	_, err = adminClient.Todo.Get(context.Background(), dataSet.Todo3.ID)
+	assert.NoError(t, err)
+}
+
 func TestTodo_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -448,6 +596,74 @@ func TestTodo_Delete(t *testing.T) {
 	}
 }
 
+func TestTodo_Trash(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	dataSet := common.CreateTestDataSet(t, adminClient)
+	deps := BuildTestDependencies(appClient)
+
+	newContext := func(method, path string) echo.Context {
+		e := SetupEcho()
+		req := httptest.NewRequest(method, path, nil)
+		c := e.NewContext(req, httptest.NewRecorder())
+		SetAuthContext(c, dataSet.User1.ID, dataSet.Tenant1.ID)
+		return c
+	}
+	listTotal := func(c echo.Context) int {
+		var response api.TodoListResponse
+		err := json.Unmarshal(c.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &response)
+		require.NoError(t, err)
+		return *response.Total
+	}
+
+	// Deleting moves the todo to the trash
+	c := newContext(http.MethodDelete, "/todos/"+dataSet.Todo1.ID)
+	require.NoError(t, deps.TodoController.DeleteTodo(c, dataSet.Todo1.ID))
+
+	c = newContext(http.MethodGet, "/todos/"+dataSet.Todo1.ID)
+	require.Error(t, deps.TodoController.GetTodo(c, dataSet.Todo1.ID, api.GetTodoParams{}), "deleted todo should be hidden")
+
+	c = newContext(http.MethodGet, "/todos")
+	require.NoError(t, deps.TodoController.GetTodos(c, api.GetTodosParams{}))
+	assert.Equal(t, 1, listTotal(c))
+
+	c = newContext(http.MethodGet, "/todos/trash")
+	require.NoError(t, deps.TodoController.GetTrash(c, api.GetTrashParams{}))
+	assert.Equal(t, 1, listTotal(c))
+
+	// Other users cannot restore it
+	e := SetupEcho()
+	other := e.NewContext(httptest.NewRequest(http.MethodPost, "/todos/trash/"+dataSet.Todo1.ID+"/restore", nil), httptest.NewRecorder())
+	SetAuthContext(other, dataSet.User2.ID, dataSet.Tenant1.ID)
+	require.Error(t, deps.TodoController.RestoreTodo(other, dataSet.Todo1.ID))
+
+	// Restoring brings it back, with a new version so ETags taken before the delete no longer match
+	c = newContext(http.MethodPost, "/todos/trash/"+dataSet.Todo1.ID+"/restore")
+	require.NoError(t, deps.TodoController.RestoreTodo(c, dataSet.Todo1.ID))
+	var restored api.TodoResponse
+	require.NoError(t, json.Unmarshal(c.Response().Writer.(*httptest.ResponseRecorder).Body.Bytes(), &restored))
+	assert.Equal(t, dataSet.Todo1.Version+2, *restored.Version, "delete and restore should each bump the version")
+
+	c = newContext(http.MethodGet, "/todos")
+	require.NoError(t, deps.TodoController.GetTodos(c, api.GetTodosParams{}))
+	assert.Equal(t, 2, listTotal(c))
+
+	// Purging only works on trashed todos
+	c = newContext(http.MethodDelete, "/todos/trash/"+dataSet.Todo1.ID)
+	require.Error(t, deps.TodoController.PurgeTodo(c, dataSet.Todo1.ID))
+
+	c = newContext(http.MethodDelete, "/todos/"+dataSet.Todo1.ID)
+	require.NoError(t, deps.TodoController.DeleteTodo(c, dataSet.Todo1.ID))
+
+	c = newContext(http.MethodDelete, "/todos/trash/"+dataSet.Todo1.ID)
+	require.NoError(t, deps.TodoController.PurgeTodo(c, dataSet.Todo1.ID))
+
+	c = newContext(http.MethodGet, "/todos/trash")
+	require.NoError(t, deps.TodoController.GetTrash(c, api.GetTrashParams{}))
+	assert.Equal(t, 0, listTotal(c))
+}
+
 // =============================================================================
 // RLS (Row Level Security) Tenant Isolation Tests
 // =============================================================================
@@ -548,7 +764,7 @@ func TestTodo_RLS_CrossTenantAccess(t *testing.T) {
 			c.SetParamValues(tt.todoID)
 			SetAuthContext(c, tt.userID, tt.tenantID)
 
-			err := deps.TodoController.GetTodo(c, tt.todoID)
+			err := deps.TodoController.GetTodo(c, tt.todoID, api.GetTodoParams{})
 			require.Error(t, err, tt.description)
 		})
 	}
@@ -608,7 +824,7 @@ func TestTodo_RLS_CrossTenantModification(t *testing.T) {
 
 			var err error
 			if tt.operation == "update" {
-				err = deps.TodoController.UpdateTodo(c, tt.todoID)
+				err = deps.TodoController.UpdateTodo(c, tt.todoID, api.UpdateTodoParams{})
 			} else {
 				err = deps.TodoController.DeleteTodo(c, tt.todoID)
 			}
@@ -5,12 +5,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"good-todo-go/internal/integration_test/common"
 	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/router/middleware"
 
 	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -285,3 +288,205 @@ func TestUser_RLS_UpdateIsolation(t *testing.T) {
 		})
 	}
 }
+
+func TestUser_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	authPost := func(path string, payload any, handler func(echo.Context) error) (api.AuthResponse, error) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		var res api.AuthResponse
+		if err := handler(e.NewContext(req, rec)); err != nil {
+			return res, err
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res, nil
+	}
+	login := func(password string) (api.AuthResponse, error) {
+		return authPost("/auth/login", api.LoginRequest{
+			Email:      "password-test@example.com",
+			Password:   password,
+			TenantSlug: "password-test-tenant",
+		}, deps.AuthController.Login)
+	}
+	changePassword := func(accessToken string, payload api.ChangePasswordRequest) (*httptest.ResponseRecorder, error) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, "/me/password", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+accessToken)
+		rec := httptest.NewRecorder()
+		err := middleware.JWTAuthMiddleware(deps.JWTService, deps.Tokens, deps.Sessions)(deps.UserController.ChangePassword)(e.NewContext(req, rec))
+		return rec, err
+	}
+
+	laptop, err := authPost("/auth/register", api.RegisterRequest{
+		Email:      "password-test@example.com",
+		Password:   "password123",
+		TenantSlug: "password-test-tenant",
+	}, deps.AuthController.Register)
+	require.NoError(t, err)
+	phone, err := login("password123")
+	require.NoError(t, err)
+
+	var he *echo.HTTPError
+	_, err = changePassword(*laptop.AccessToken, api.ChangePasswordRequest{CurrentPassword: "wrong-password", NewPassword: "new-password"})
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+
+	rec, err := changePassword(*laptop.AccessToken, api.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "new-password"})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	// The phone is logged out, the laptop stays logged in
+	_, err = authPost("/auth/refresh", api.RefreshTokenRequest{RefreshToken: *phone.RefreshToken}, deps.AuthController.RefreshToken)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+	_, err = authPost("/auth/refresh", api.RefreshTokenRequest{RefreshToken: *laptop.RefreshToken}, deps.AuthController.RefreshToken)
+	require.NoError(t, err)
+
+	_, err = login("password123")
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusUnauthorized, he.Code)
+	_, err = login("new-password")
+	require.NoError(t, err)
+}
+
+func TestUser_PasswordPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	body, _ := json.Marshal(api.RegisterRequest{
+		Email:      "policy-test@example.com",
+		Password:   "Password-123",
+		TenantSlug: "password-policy-tenant",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	require.NoError(t, deps.AuthController.Register(e.NewContext(req, rec)))
+	var registered api.AuthResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &registered))
+	userID := *registered.User.Id
+	tenantID := *registered.User.TenantId
+
+	call := func(path, role string, payload any, handler func(echo.Context) error) error {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPut, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+		SetAuthContext(c, userID, tenantID)
+		SetRoleContext(c, role)
+		return handler(c)
+	}
+	changePassword := func(current, next string) error {
+		return call("/me/password", "member", api.ChangePasswordRequest{CurrentPassword: current, NewPassword: next}, deps.UserController.ChangePassword)
+	}
+
+	var he *echo.HTTPError
+	three, two := 3, 2
+	err := call("/tenant/password-policy", "member", api.PasswordPolicyRequest{MinCharacterClasses: &three, History: &two}, deps.UserController.SetPasswordPolicy)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusForbidden, he.Code)
+	require.NoError(t, call("/tenant/password-policy", "admin", api.PasswordPolicyRequest{MinCharacterClasses: &three, History: &two}, deps.UserController.SetPasswordPolicy))
+
+	// Too few character classes
+	err = changePassword("Password-123", "weak-password")
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+
+	require.NoError(t, changePassword("Password-123", "Password-456"))
+
+	// The previous password is remembered
+	err = changePassword("Password-456", "Password-123")
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+	assert.Contains(t, he.Message, "last 2 passwords")
+
+	require.NoError(t, changePassword("Password-456", "Password-789"))
+}
+
+func TestUser_ChangeEmail(t *testing.T) {
+	t.Parallel()
+
+	_, appClient := common.SetupTestClientWithRLS(t)
+	deps := BuildTestDependencies(appClient)
+	e := SetupEcho()
+
+	register := func(email string) api.AuthResponse {
+		body, _ := json.Marshal(api.RegisterRequest{
+			Email:      openapi_types.Email(email),
+			Password:   "password123",
+			TenantSlug: "email-change-tenant",
+		})
+		req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		require.NoError(t, deps.AuthController.Register(e.NewContext(req, rec)))
+		var res api.AuthResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+	alice := register("alice@example.com")
+	register("bob@example.com")
+
+	post := func(path string, payload any, handler echo.HandlerFunc) (api.UserResponse, int, error) {
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		SetAuthContext(c, *alice.User.Id, *alice.User.TenantId)
+		var res api.UserResponse
+		if err := handler(c); err != nil {
+			return res, 0, err
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res, rec.Code, nil
+	}
+
+	// Another user of the tenant has the address
+	var he *echo.HTTPError
+	_, _, err := post("/me/email", api.ChangeEmailRequest{NewEmail: "bob@example.com", CurrentPassword: "password123"}, deps.UserController.RequestEmailChange)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusConflict, he.Code)
+
+	res, code, err := post("/me/email", api.ChangeEmailRequest{NewEmail: "alice.new@example.com", CurrentPassword: "password123"}, deps.UserController.RequestEmailChange)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, "alice@example.com", *res.Email)
+	assert.Equal(t, "alice.new@example.com", *res.PendingEmail)
+
+	messages := deps.Mailer.Messages()
+	require.Len(t, messages, 1)
+	assert.Equal(t, "alice.new@example.com", messages[0].To)
+	token := strings.TrimSpace(strings.Split(messages[0].Body, "\n\n")[1])
+
+	_, _, err = post("/me/email/confirm", api.ConfirmEmailChangeRequest{Token: "wrong-token"}, deps.UserController.ConfirmEmailChange)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+
+	res, code, err = post("/me/email/confirm", api.ConfirmEmailChangeRequest{Token: token}, deps.UserController.ConfirmEmailChange)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "alice.new@example.com", *res.Email)
+	assert.Nil(t, res.PendingEmail)
+
+	// The old address is told about the change
+	messages = deps.Mailer.Messages()
+	require.Len(t, messages, 2)
+	assert.Equal(t, "alice@example.com", messages[1].To)
+
+	// The token cannot be used twice
+	_, _, err = post("/me/email/confirm", api.ConfirmEmailChangeRequest{Token: token}, deps.UserController.ConfirmEmailChange)
+	require.ErrorAs(t, err, &he)
+	assert.Equal(t, http.StatusBadRequest, he.Code)
+}
@@ -0,0 +1,42 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/infrastructure/database/rlscheck"
+	"good-todo-go/internal/integration_test/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRLS_IsolationChecker runs the isolation checker over every tenant table
+func TestRLS_IsolationChecker(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	data := common.CreateTestDataSet(t, adminClient)
+
+	report, err := rlscheck.NewChecker(appClient).Run(context.Background(), data.Tenant1.ID, data.Tenant2.ID)
+	require.NoError(t, err)
+
+	// 4 operations x 2 directions per tenant table
+	assert.Len(t, report.Results, len(rlscheck.TenantTables())*8)
+
+	for _, leak := range report.Leaks() {
+		t.Errorf("leak on %s %s (%s -> %s): %s", leak.Table, leak.Operation, leak.Actor, leak.Target, leak.Detail)
+	}
+}
+
+// TestRLS_IsolationChecker_RejectsBypassRole verifies that a superuser connection is refused
+func TestRLS_IsolationChecker_RejectsBypassRole(t *testing.T) {
+	t.Parallel()
+
+	adminClient, _ := common.SetupTestClientWithRLS(t)
+	data := common.CreateTestDataSet(t, adminClient)
+
+	_, err := rlscheck.NewChecker(adminClient).Run(context.Background(), data.Tenant1.ID, data.Tenant2.ID)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bypasses row level security")
+}
@@ -3,6 +3,7 @@ package integration_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/infrastructure/database"
@@ -24,7 +25,7 @@ func TestRLS_TenantIsolation(t *testing.T) {
 	data := common.CreateTestDataSet(t, adminClient)
 
 	t.Run("Todo - tenant1 context can only see tenant1 todos", func(t *testing.T) {
-		repo := repository.NewTodoRepository(appClient)
+		repo := repository.NewTodoRepository(appClient, nil)
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
 		// Should find todos in tenant1
@@ -35,7 +36,7 @@ func TestRLS_TenantIsolation(t *testing.T) {
 	})
 
 	t.Run("Todo - tenant1 context cannot see tenant2 todos", func(t *testing.T) {
-		repo := repository.NewTodoRepository(appClient)
+		repo := repository.NewTodoRepository(appClient, nil)
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
 
 		// Should NOT find todos in tenant2
@@ -44,7 +45,7 @@ func TestRLS_TenantIsolation(t *testing.T) {
 	})
 
 	t.Run("Todo - tenant2 context can only see tenant2 todos", func(t *testing.T) {
-		repo := repository.NewTodoRepository(appClient)
+		repo := repository.NewTodoRepository(appClient, nil)
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
 		// Should find todos in tenant2
@@ -55,7 +56,7 @@ func TestRLS_TenantIsolation(t *testing.T) {
 	})
 
 	t.Run("Todo - tenant2 context cannot see tenant1 todos", func(t *testing.T) {
-		repo := repository.NewTodoRepository(appClient)
+		repo := repository.NewTodoRepository(appClient, nil)
 		ctx := database.WithTenantID(context.Background(), data.Tenant2.ID)
 
 		// Should NOT find todos in tenant1
@@ -91,7 +92,7 @@ func TestRLS_FindByUserID(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("tenant1 context - find todos for user1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
@@ -122,7 +123,7 @@ func TestRLS_FindPublic(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("tenant1 context - find public todos only in tenant1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
@@ -158,7 +159,7 @@ func TestRLS_CreateWithWrongTenant(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("cannot create todo in different tenant context", func(t *testing.T) {
 		// Context is tenant1, but trying to create todo for tenant2
@@ -189,7 +190,7 @@ func TestRLS_CountByUserID(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("tenant1 context - count todos for user1", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
@@ -215,7 +216,7 @@ func TestRLS_CountPublic(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("tenant1 context - count public todos", func(t *testing.T) {
 		ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
@@ -241,7 +242,7 @@ func TestRLS_UpdateCrossTenant(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("cannot update todo from different tenant", func(t *testing.T) {
 		// Context is tenant2, but trying to update tenant1's todo
@@ -260,7 +261,7 @@ func TestRLS_DeleteCrossTenant(t *testing.T) {
 	adminClient, appClient := common.SetupTestClientWithRLS(t)
 	data := common.CreateTestDataSet(t, adminClient)
 
-	repo := repository.NewTodoRepository(appClient)
+	repo := repository.NewTodoRepository(appClient, nil)
 
 	t.Run("cannot delete todo from different tenant", func(t *testing.T) {
 		// Context is tenant2, but trying to delete tenant1's todo
@@ -277,3 +278,33 @@ func TestRLS_DeleteCrossTenant(t *testing.T) {
 		assert.NotNil(t, todo, "Todo should still exist after failed cross-tenant delete")
 	})
 }
+
+// TestRLS_ReadReplica verifies that reads routed to a replica pool still set the tenant context
+func TestRLS_ReadReplica(t *testing.T) {
+	t.Parallel()
+
+	adminClient, appClient := common.SetupTestClientWithRLS(t)
+	data := common.CreateTestDataSet(t, adminClient)
+
+	// The primary bypasses RLS, so tenant2's public todo would show up if reads went there
+	replica := database.NewReadReplicaWithClient(appClient, 5*time.Second, time.Now)
+	repo := repository.NewTodoRepository(adminClient, replica)
+	ctx := database.WithTenantID(context.Background(), data.Tenant1.ID)
+
+	t.Run("listing and count come from the replica with tenant isolation", func(t *testing.T) {
+		todos, err := repo.FindPublic(ctx, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, todos, 1)
+		assert.Equal(t, data.Todo2.ID, todos[0].ID)
+
+		count, err := repo.CountPublic(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("primary reads are used when requested", func(t *testing.T) {
+		count, err := repo.CountPublic(database.WithPrimaryReads(ctx))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+}
@@ -0,0 +1,34 @@
+// Package audit carries request metadata that the ent audit hook attaches to AuditEvents.
+package audit
+
+import "context"
+
+// Info describes who made a change and from where
+type Info struct {
+	ActorID   string
+	RequestID string
+	IP        string
+}
+
+type infoKey struct{}
+
+// WithRequest stores the request ID and client IP in the context
+func WithRequest(ctx context.Context, requestID, ip string) context.Context {
+	info := FromContext(ctx)
+	info.RequestID = requestID
+	info.IP = ip
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// WithActor stores the authenticated user ID in the context
+func WithActor(ctx context.Context, actorID string) context.Context {
+	info := FromContext(ctx)
+	info.ActorID = actorID
+	return context.WithValue(ctx, infoKey{}, info)
+}
+
+// FromContext returns the audit info in the context, or the zero value
+func FromContext(ctx context.Context) Info {
+	info, _ := ctx.Value(infoKey{}).(Info)
+	return info
+}
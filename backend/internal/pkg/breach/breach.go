@@ -0,0 +1,79 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_breach
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// prefixLength is the number of SHA-1 hex characters that name a range file
+const prefixLength = 5
+
+type IChecker interface {
+	// IsBreached reports whether the password appears in a known data breach
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// DirChecker looks passwords up in a local copy of a k-anonymity range
+// dataset such as Pwned Passwords. The directory holds one file per SHA-1
+// prefix, <PREFIX>.txt, with lines of "<SUFFIX>:<COUNT>". Only the range of
+// the password's prefix is read, and nothing leaves the machine
+type DirChecker struct {
+	dir string
+}
+
+func NewDirChecker(dir string) IChecker {
+	return &DirChecker{dir: dir}
+}
+
+func (c *DirChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:prefixLength], digest[prefixLength:]
+
+	f, err := os.Open(filepath.Join(c.dir, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		// An absent range has no breached passwords
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("breach: open range %s: %w", prefix, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		candidate, count, _ := strings.Cut(line, ":")
+		// Padded datasets list fake suffixes with a count of 0
+		if strings.EqualFold(candidate, suffix) && count != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("breach: read range %s: %w", prefix, err)
+	}
+	return false, nil
+}
+
+// NoopChecker treats every password as unbreached, for when no dataset is configured
+type NoopChecker struct{}
+
+func NewNoopChecker() IChecker {
+	return &NoopChecker{}
+}
+
+func (c *NoopChecker) IsBreached(_ context.Context, _ string) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,41 @@
+package breach
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirChecker_IsBreached(t *testing.T) {
+	t.Parallel()
+
+	// SHA-1 of "password123" is CBFDAC6008F9CAB4083784CBD1874F76618D2A97
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "CBFDA.txt"), []byte(
+		"0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n"+
+			"C6008F9CAB4083784CBD1874F76618D2A97:2254650\r\n"+
+			"C6008F9CAB4083784CBD1874F76618D2A98:0\r\n"), 0o600))
+	checker := NewDirChecker(dir)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "listed in the range", password: "password123", want: true},
+		{name: "range file missing", password: "correct horse battery staple", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := checker.IsBreached(context.Background(), tt.password)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
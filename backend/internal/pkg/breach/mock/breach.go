@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: breach.go
+//
+// Generated by this command:
+//
+//	mockgen -source=breach.go -destination=mock/breach.go -package=mock_breach
+//
+
+// Package mock_breach is a generated GoMock package.
+package mock_breach
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIChecker is a mock of IChecker interface.
+type MockIChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockICheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockICheckerMockRecorder is the mock recorder for MockIChecker.
+type MockICheckerMockRecorder struct {
+	mock *MockIChecker
+}
+
+// NewMockIChecker creates a new mock instance.
+func NewMockIChecker(ctrl *gomock.Controller) *MockIChecker {
+	mock := &MockIChecker{ctrl: ctrl}
+	mock.recorder = &MockICheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIChecker) EXPECT() *MockICheckerMockRecorder {
+	return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockIChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBreached", ctx, password)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockICheckerMockRecorder) IsBreached(ctx, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockIChecker)(nil).IsBreached), ctx, password)
+}
@@ -1,10 +1,13 @@
 package cerror
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 
+	"good-todo-go/internal/pkg/tracing"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -37,6 +40,15 @@ const (
 	ErrCodeConflict            ErrorCode = "CONFLICT"
 	ErrCodeInternalServerError ErrorCode = "INTERNAL_SERVER_ERROR"
 	ErrCodeValidationError     ErrorCode = "VALIDATION_ERROR"
+	ErrCodePreconditionFailed  ErrorCode = "PRECONDITION_FAILED"
+	ErrCodeUnprocessable       ErrorCode = "UNPROCESSABLE_ENTITY"
+	ErrCodeTooManyRequests     ErrorCode = "TOO_MANY_REQUESTS"
+	ErrCodeAccountLocked       ErrorCode = "ACCOUNT_LOCKED"
+	ErrCodeBadGateway          ErrorCode = "BAD_GATEWAY"
+	// Codes for errors raised by Echo itself rather than the application
+	ErrCodeMethodNotAllowed     ErrorCode = "METHOD_NOT_ALLOWED"
+	ErrCodeUnsupportedMediaType ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	ErrCodeHTTPError            ErrorCode = "HTTP_ERROR"
 )
 
 func NewBadRequest(message string, err error) *AppError {
@@ -84,6 +96,55 @@ func NewConflict(message string, err error) *AppError {
 	}
 }
 
+// NewPreconditionFailed is returned when a conditional request (If-Match) no longer matches
+func NewPreconditionFailed(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodePreconditionFailed,
+		Message:    message,
+		HTTPStatus: http.StatusPreconditionFailed,
+		Err:        err,
+	}
+}
+
+// NewUnprocessable is returned for a well-formed request that cannot be applied, such as a reused Idempotency-Key
+func NewUnprocessable(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodeUnprocessable,
+		Message:    message,
+		HTTPStatus: http.StatusUnprocessableEntity,
+		Err:        err,
+	}
+}
+
+func NewTooManyRequests(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodeTooManyRequests,
+		Message:    message,
+		HTTPStatus: http.StatusTooManyRequests,
+		Err:        err,
+	}
+}
+
+// NewAccountLocked is returned for a login to an account locked after too many failed attempts
+func NewAccountLocked(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodeAccountLocked,
+		Message:    message,
+		HTTPStatus: http.StatusLocked,
+		Err:        err,
+	}
+}
+
+// NewBadGateway is returned when a service the request depends on, such as an identity provider, fails
+func NewBadGateway(message string, err error) *AppError {
+	return &AppError{
+		Code:       ErrCodeBadGateway,
+		Message:    message,
+		HTTPStatus: http.StatusBadGateway,
+		Err:        err,
+	}
+}
+
 func NewInternalServerError(message string, err error) *AppError {
 	return &AppError{
 		Code:       ErrCodeInternalServerError,
@@ -102,34 +163,31 @@ func NewValidationError(message string, details map[string]interface{}) *AppErro
 	}
 }
 
-// CustomHTTPErrorHandler is the custom error handler for Echo
+// CustomHTTPErrorHandler is the custom error handler for Echo. Every error, whether
+// an AppError, an echo.HTTPError from a middleware or the binder, or a panic caught
+// by Recover, is written as an RFC 7807 problem. Logging the error is left to the
+// request logger
 func CustomHTTPErrorHandler(err error, c echo.Context) {
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-
-	var appErr *AppError
-	if errors.As(err, &appErr) {
-		if !c.Response().Committed {
-			_ = c.JSON(appErr.HTTPStatus, appErr)
-		}
+	if c.Response().Committed {
 		return
 	}
 
-	// Handle Echo's HTTPError
-	if he, ok := err.(*echo.HTTPError); ok {
-		if !c.Response().Committed {
-			_ = c.JSON(he.Code, map[string]interface{}{
-				"code":    "HTTP_ERROR",
-				"message": he.Message,
-			})
-		}
+	problem := NewProblem(err)
+	problem.Instance = c.Request().URL.Path
+	problem.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+	if problem.RequestID == "" {
+		problem.RequestID = c.Request().Header.Get(echo.HeaderXRequestID)
+	}
+	problem.TraceID, _ = tracing.IDs(c.Request().Context())
+	if c.Request().Method == http.MethodHead {
+		_ = c.NoContent(problem.Status)
 		return
 	}
-
-	// Default to internal server error
-	if !c.Response().Committed {
-		_ = c.JSON(http.StatusInternalServerError, map[string]interface{}{
-			"code":    ErrCodeInternalServerError,
-			"message": err.Error(),
-		})
+	body, err := json.Marshal(problem)
+	if err != nil {
+		slog.ErrorContext(c.Request().Context(), "failed to encode problem", "error", err)
+		_ = c.NoContent(problem.Status)
+		return
 	}
+	_ = c.Blob(problem.Status, MIMEApplicationProblemJSON, body)
 }
@@ -0,0 +1,116 @@
+package cerror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const MIMEApplicationProblemJSON = "application/problem+json"
+
+// problemTypePrefix makes the problem type URI of an error code,
+// e.g. urn:good-todo:problem:validation-error
+const problemTypePrefix = "urn:good-todo:problem:"
+
+// Problem is an error response in the RFC 7807 problem details format
+type Problem struct {
+	Type     string    `json:"type"`
+	Title    string    `json:"title"`
+	Status   int       `json:"status"`
+	Detail   string    `json:"detail,omitempty"`
+	Instance string    `json:"instance,omitempty"`
+	Code     ErrorCode `json:"code"`
+	// RequestID correlates the response with the server logs
+	RequestID string `json:"request_id,omitempty"`
+	// TraceID finds the trace of the request in the tracing backend
+	TraceID string `json:"trace_id,omitempty"`
+	// Errors maps each field of a VALIDATION_ERROR to its problem
+	Errors map[string]interface{} `json:"errors,omitempty"`
+	// Extensions are further members, such as retry_after_seconds
+	Extensions map[string]interface{} `json:"-"`
+}
+
+// codesByStatus names the echo.HTTPErrors raised outside the application
+var codesByStatus = map[int]ErrorCode{
+	http.StatusBadRequest:           ErrCodeBadRequest,
+	http.StatusUnauthorized:         ErrCodeUnauthorized,
+	http.StatusForbidden:            ErrCodeForbidden,
+	http.StatusNotFound:             ErrCodeNotFound,
+	http.StatusMethodNotAllowed:     ErrCodeMethodNotAllowed,
+	http.StatusConflict:             ErrCodeConflict,
+	http.StatusPreconditionFailed:   ErrCodePreconditionFailed,
+	http.StatusUnsupportedMediaType: ErrCodeUnsupportedMediaType,
+	http.StatusUnprocessableEntity:  ErrCodeUnprocessable,
+	http.StatusTooManyRequests:      ErrCodeTooManyRequests,
+	http.StatusInternalServerError:  ErrCodeInternalServerError,
+	http.StatusBadGateway:           ErrCodeBadGateway,
+}
+
+// NewProblem describes an error as a problem. Errors other than AppError and
+// echo.HTTPError are unexpected, and their message is not exposed
+func NewProblem(err error) *Problem {
+	var appErr *AppError
+	if errors.As(err, &appErr) {
+		problem := newProblem(appErr.HTTPStatus, appErr.Code, appErr.Message)
+		if appErr.Code == ErrCodeValidationError {
+			problem.Errors = appErr.Details
+		} else {
+			problem.Extensions = appErr.Details
+		}
+		return problem
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		code, ok := codesByStatus[he.Code]
+		if !ok {
+			code = ErrCodeHTTPError
+		}
+		return newProblem(he.Code, code, fmt.Sprint(he.Message))
+	}
+
+	return newProblem(http.StatusInternalServerError, ErrCodeInternalServerError, "internal server error")
+}
+
+func newProblem(status int, code ErrorCode, detail string) *Problem {
+	return &Problem{
+		Type:   ProblemType(code),
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// ProblemType is the type URI of an error code. Plain HTTP errors have no type
+// beyond their status, which RFC 7807 writes as about:blank
+func ProblemType(code ErrorCode) string {
+	if code == ErrCodeHTTPError {
+		return "about:blank"
+	}
+	return problemTypePrefix + strings.ReplaceAll(strings.ToLower(string(code)), "_", "-")
+}
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	type problem Problem
+	body, err := json.Marshal((*problem)(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return body, err
+	}
+
+	members := map[string]interface{}{}
+	if err := json.Unmarshal(body, &members); err != nil {
+		return nil, err
+	}
+	// Extensions cannot replace the standard members
+	for name, value := range p.Extensions {
+		if _, ok := members[name]; !ok {
+			members[name] = value
+		}
+	}
+	return json.Marshal(members)
+}
@@ -0,0 +1,133 @@
+package cerror
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestCustomHTTPErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	lockedErr := NewAccountLocked("account is locked", nil)
+	lockedErr.Details = map[string]interface{}{"retry_after_seconds": 30}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  int
+		wantMembers map[string]interface{}
+	}{
+		{
+			name:       "validation error lists the fields",
+			err:        NewValidationError("invalid todo", map[string]interface{}{"title": "is required"}),
+			wantStatus: http.StatusBadRequest,
+			wantMembers: map[string]interface{}{
+				"type":   "urn:good-todo:problem:validation-error",
+				"title":  "Bad Request",
+				"status": float64(http.StatusBadRequest),
+				"detail": "invalid todo",
+				"code":   "VALIDATION_ERROR",
+				"errors": map[string]interface{}{"title": "is required"},
+			},
+		},
+		{
+			name:       "app error wrapped by a controller keeps its code",
+			err:        echo.NewHTTPError(http.StatusNotFound, "todo not found").WithInternal(NewNotFound("todo not found", nil)),
+			wantStatus: http.StatusNotFound,
+			wantMembers: map[string]interface{}{
+				"type": "urn:good-todo:problem:not-found",
+				"code": "NOT_FOUND",
+			},
+		},
+		{
+			name:       "other details become extension members",
+			err:        lockedErr,
+			wantStatus: http.StatusLocked,
+			wantMembers: map[string]interface{}{
+				"code":                "ACCOUNT_LOCKED",
+				"retry_after_seconds": float64(30),
+			},
+		},
+		{
+			name:       "echo error is named by its status",
+			err:        echo.ErrMethodNotAllowed,
+			wantStatus: http.StatusMethodNotAllowed,
+			wantMembers: map[string]interface{}{
+				"type":   "urn:good-todo:problem:method-not-allowed",
+				"code":   "METHOD_NOT_ALLOWED",
+				"detail": "Method Not Allowed",
+			},
+		},
+		{
+			name:       "echo error of another status has no type",
+			err:        echo.NewHTTPError(http.StatusRequestEntityTooLarge, "too large"),
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantMembers: map[string]interface{}{
+				"type": "about:blank",
+				"code": "HTTP_ERROR",
+			},
+		},
+		{
+			name:       "unexpected error does not leak its message",
+			err:        errors.New("[PANIC RECOVER] runtime error: invalid memory address"),
+			wantStatus: http.StatusInternalServerError,
+			wantMembers: map[string]interface{}{
+				"code":   "INTERNAL_SERVER_ERROR",
+				"detail": "internal server error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			span := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+				SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+			})
+			req := httptest.NewRequest(http.MethodPost, "/todos", nil)
+			req = req.WithContext(trace.ContextWithSpanContext(req.Context(), span))
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.Response().Header().Set(echo.HeaderXRequestID, "request-1")
+
+			CustomHTTPErrorHandler(tt.err, c)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.Equal(t, MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+			var members map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &members))
+			assert.Equal(t, "/todos", members["instance"])
+			assert.Equal(t, "request-1", members["request_id"])
+			assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", members["trace_id"])
+			for name, want := range tt.wantMembers {
+				assert.Equal(t, want, members[name], name)
+			}
+		})
+	}
+}
+
+func TestProblem_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	problem := NewProblem(NewTooManyRequests("slow down", nil))
+	problem.Extensions = map[string]interface{}{"retry_after_seconds": 5, "status": 200}
+
+	body, err := json.Marshal(problem)
+	require.NoError(t, err)
+
+	var members map[string]interface{}
+	require.NoError(t, json.Unmarshal(body, &members))
+	assert.Equal(t, float64(5), members["retry_after_seconds"])
+	// Extensions cannot replace the standard members
+	assert.Equal(t, float64(http.StatusTooManyRequests), members["status"])
+}
@@ -0,0 +1,18 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_pkg
+package pkg
+
+import "time"
+
+type IClock interface {
+	Now() time.Time
+}
+
+type Clock struct{}
+
+func NewClock() IClock {
+	return &Clock{}
+}
+
+func (c *Clock) Now() time.Time {
+	return time.Now()
+}
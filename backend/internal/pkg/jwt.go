@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type TokenType string
@@ -12,6 +13,17 @@ type TokenType string
 const (
 	AccessToken  TokenType = "access"
 	RefreshToken TokenType = "refresh"
+	// MFAChallengeToken proves the password step of a login that still needs a second factor
+	MFAChallengeToken TokenType = "mfa_challenge"
+	// OIDCStateToken carries an OpenID Connect sign-in from the redirect to the callback
+	OIDCStateToken TokenType = "oidc_state"
+)
+
+const (
+	// mfaChallengeExpiresIn is how long the user has to enter the second factor
+	mfaChallengeExpiresIn = 5 * time.Minute
+	// oidcStateExpiresIn is how long the user has to sign in at the provider
+	oidcStateExpiresIn = 10 * time.Minute
 )
 
 type Claims struct {
@@ -20,72 +32,102 @@ type Claims struct {
 	Email     string    `json:"email"`
 	Role      string    `json:"role"`
 	TokenType TokenType `json:"token_type"`
+	// SessionID is the login session the token belongs to; empty for MFA challenges
+	SessionID string `json:"sid,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// OIDCState is what the callback of an OpenID Connect sign-in needs from its start.
+// It is kept in a cookie so the code verifier never reaches the provider
+type OIDCState struct {
+	TenantID     string    `json:"tenant_id"`
+	State        string    `json:"state"`
+	Nonce        string    `json:"nonce"`
+	CodeVerifier string    `json:"code_verifier"`
+	TokenType    TokenType `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
 type JWTService struct {
-	secret           string
+	keys             *KeySet
 	expiresIn        time.Duration
 	refreshExpiresIn time.Duration
 }
 
+// NewJWTService signs and verifies HS256 tokens with a shared secret
 func NewJWTService(secret string, expiresIn, refreshExpiresIn int) *JWTService {
+	return NewJWTServiceWithKeys(NewHMACKeySet(secret), expiresIn, refreshExpiresIn)
+}
+
+// NewJWTServiceWithKeys signs tokens with the signing key of keys and verifies
+// them with any key in the set
+func NewJWTServiceWithKeys(keys *KeySet, expiresIn, refreshExpiresIn int) *JWTService {
 	return &JWTService{
-		secret:           secret,
+		keys:             keys,
 		expiresIn:        time.Duration(expiresIn) * time.Second,
 		refreshExpiresIn: time.Duration(refreshExpiresIn) * time.Second,
 	}
 }
 
+// JWKS returns the public keys other services verify our tokens with
+func (s *JWTService) JWKS() []JWK {
+	return s.keys.JWKS()
+}
+
 type TokenPair struct {
-	AccessToken  string
-	RefreshToken string
-	ExpiresIn    int
+	AccessToken      string
+	RefreshToken     string
+	ExpiresIn        int
+	RefreshExpiresAt time.Time
 }
 
-func (s *JWTService) GenerateTokenPair(userID, tenantID, email, role string) (*TokenPair, error) {
-	accessToken, err := s.generateToken(userID, tenantID, email, role, AccessToken, s.expiresIn)
+func (s *JWTService) GenerateTokenPair(userID, tenantID, email, role, sessionID string) (*TokenPair, error) {
+	accessToken, err := s.generateToken(userID, tenantID, email, role, sessionID, AccessToken, s.expiresIn)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.generateToken(userID, tenantID, email, role, RefreshToken, s.refreshExpiresIn)
+	refreshToken, err := s.generateToken(userID, tenantID, email, role, sessionID, RefreshToken, s.refreshExpiresIn)
 	if err != nil {
 		return nil, err
 	}
 
 	return &TokenPair{
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		ExpiresIn:    int(s.expiresIn.Seconds()),
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		ExpiresIn:        int(s.expiresIn.Seconds()),
+		RefreshExpiresAt: time.Now().Add(s.refreshExpiresIn),
 	}, nil
 }
 
-func (s *JWTService) generateToken(userID, tenantID, email, role string, tokenType TokenType, expiresIn time.Duration) (string, error) {
+// GenerateMFAChallengeToken returns a short-lived token that can only be exchanged for a
+// token pair together with a second factor
+func (s *JWTService) GenerateMFAChallengeToken(userID, tenantID, email, role string) (string, error) {
+	return s.generateToken(userID, tenantID, email, role, "", MFAChallengeToken, mfaChallengeExpiresIn)
+}
+
+func (s *JWTService) generateToken(userID, tenantID, email, role, sessionID string, tokenType TokenType, expiresIn time.Duration) (string, error) {
 	claims := &Claims{
 		UserID:    userID,
 		TenantID:  tenantID,
 		Email:     email,
 		Role:      role,
 		TokenType: tokenType,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// A unique ID keeps tokens issued within the same second distinct
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiresIn)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(s.secret))
+	return s.keys.sign(claims)
 }
 
 func (s *JWTService) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(s.secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.keys.keyFunc)
 
 	if err != nil {
 		return nil, err
@@ -110,3 +152,52 @@ func (s *JWTService) ValidateRefreshToken(tokenString string) (*Claims, error) {
 
 	return claims, nil
 }
+
+func (s *JWTService) ValidateMFAChallengeToken(tokenString string) (*Claims, error) {
+	claims, err := s.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != MFAChallengeToken {
+		return nil, errors.New("invalid token type")
+	}
+
+	return claims, nil
+}
+
+// GenerateOIDCStateToken signs the state of a sign-in that is waiting for the provider
+func (s *JWTService) GenerateOIDCStateToken(tenantID, state, nonce, codeVerifier string) (string, error) {
+	now := time.Now()
+	claims := &OIDCState{
+		TenantID:     tenantID,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		TokenType:    OIDCStateToken,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(oidcStateExpiresIn)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	return s.keys.sign(claims)
+}
+
+func (s *JWTService) ValidateOIDCStateToken(tokenString string) (*OIDCState, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &OIDCState{}, s.keys.keyFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(*OIDCState)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+	if claims.TokenType != OIDCStateToken {
+		return nil, errors.New("invalid token type")
+	}
+
+	return claims, nil
+}
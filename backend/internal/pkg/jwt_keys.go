@@ -0,0 +1,222 @@
+package pkg
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits is the smallest RSA key accepted for signing or verification
+const minRSAKeyBits = 2048
+
+// verificationKey checks tokens whose kid header names it
+type verificationKey struct {
+	method jwt.SigningMethod
+	public crypto.PublicKey
+}
+
+// KeySet holds the key tokens are signed with and every key they are verified
+// with. Keys are identified by the kid header, so a new signing key can be
+// introduced while tokens signed by the previous one stay valid.
+type KeySet struct {
+	signingKeyID string
+	signingKey   any
+	signMethod   jwt.SigningMethod
+	verifyKeys   map[string]*verificationKey
+	// hmacSecret verifies tokens without a kid (HS256 signed with JWT_SECRET)
+	hmacSecret []byte
+}
+
+// NewHMACKeySet signs and verifies HS256 tokens with a shared secret
+func NewHMACKeySet(secret string) *KeySet {
+	return &KeySet{
+		signingKey: []byte(secret),
+		signMethod: jwt.SigningMethodHS256,
+		verifyKeys: map[string]*verificationKey{},
+		hmacSecret: []byte(secret),
+	}
+}
+
+// LoadKeySet reads every <kid>.pem file in dir. Private keys (RSA or Ed25519,
+// PKCS#8 or PKCS#1) can sign and verify; public keys only verify, for keys
+// being retired. signingKeyID picks the private key new tokens are signed with.
+// A non-empty legacySecret keeps HS256 tokens without a kid valid while moving
+// off the shared secret.
+func LoadKeySet(dir, signingKeyID, legacySecret string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	ks := &KeySet{verifyKeys: map[string]*verificationKey{}}
+	if legacySecret != "" {
+		ks.hmacSecret = []byte(legacySecret)
+	}
+
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		private, public, err := parseKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", path, err)
+		}
+		method, err := signingMethodFor(public)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", path, err)
+		}
+
+		ks.verifyKeys[kid] = &verificationKey{method: method, public: public}
+		if kid == signingKeyID {
+			if private == nil {
+				return nil, fmt.Errorf("jwt key %s: signing key must be a private key", path)
+			}
+			ks.signingKeyID = kid
+			ks.signingKey = private
+			ks.signMethod = method
+		}
+	}
+
+	if len(ks.verifyKeys) == 0 {
+		return nil, fmt.Errorf("no jwt keys (*.pem) in %s", dir)
+	}
+	if ks.signingKey == nil {
+		return nil, fmt.Errorf("jwt signing key %q not found in %s", signingKeyID, dir)
+	}
+	return ks, nil
+}
+
+func parseKeyPEM(data []byte) (private any, public crypto.PublicKey, err error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("no PEM block")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported private key %T", key)
+		}
+		return key, signer.Public(), nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, key.Public(), nil
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, key, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func signingMethodFor(public crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("rsa key has %d bits, at least %d required", key.N.BitLen(), minRSAKeyBits)
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T (use RSA or Ed25519)", public)
+	}
+}
+
+// sign signs claims with the signing key, naming it in the kid header
+func (ks *KeySet) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signMethod, claims)
+	if ks.signingKeyID != "" {
+		token.Header["kid"] = ks.signingKeyID
+	}
+	return token.SignedString(ks.signingKey)
+}
+
+// keyFunc finds the key for a token. The algorithm must be the one of the key,
+// so a public key can never be used as an HMAC secret
+func (ks *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		if ks.hmacSecret == nil || token.Method != jwt.SigningMethodHS256 {
+			return nil, errors.New("unexpected signing method")
+		}
+		return ks.hmacSecret, nil
+	}
+
+	key, ok := ks.verifyKeys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.public, nil
+}
+
+// JWK is a public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// OKP (Ed25519)
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS returns the public verification keys, ordered by kid. HMAC secrets are
+// never published, so an HS256-only set is empty
+func (ks *KeySet) JWKS() []JWK {
+	kids := make([]string, 0, len(ks.verifyKeys))
+	for kid := range ks.verifyKeys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	keys := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		key := ks.verifyKeys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+		keys = append(keys, jwk)
+	}
+	return keys
+}
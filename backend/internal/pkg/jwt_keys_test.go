@@ -0,0 +1,242 @@
+package pkg
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePrivateKey(t *testing.T, dir, kid string, key any) {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "PRIVATE KEY", der)
+}
+
+func writePublicKey(t *testing.T, dir, kid string, key any) {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	writePEM(t, dir, kid, "PUBLIC KEY", der)
+}
+
+func writePEM(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+}
+
+func newEd25519Key(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newJWTService(t *testing.T, dir, signingKeyID, legacySecret string) *JWTService {
+	t.Helper()
+	keys, err := LoadKeySet(dir, signingKeyID, legacySecret)
+	require.NoError(t, err)
+	return NewJWTServiceWithKeys(keys, 3600, 604800)
+}
+
+func TestJWTService_Rotation(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	oldKey := newEd25519Key(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePrivateKey(t, dir, "2026-01", oldKey)
+	writePrivateKey(t, dir, "2026-10", rsaKey)
+
+	before := newJWTService(t, dir, "2026-01", "")
+	pair, err := before.GenerateTokenPair("user-1", "tenant-1", "alice@example.com", "member", "session-1")
+	require.NoError(t, err)
+
+	token, _, err := jwt.NewParser().ParseUnverified(pair.AccessToken, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01", token.Header["kid"])
+	assert.Equal(t, "EdDSA", token.Header["alg"])
+
+	// The new key signs; the old one still verifies what it signed
+	after := newJWTService(t, dir, "2026-10", "")
+	claims, err := after.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", claims.UserID)
+
+	pair, err = after.GenerateTokenPair("user-1", "tenant-1", "alice@example.com", "member", "session-1")
+	require.NoError(t, err)
+	token, _, err = jwt.NewParser().ParseUnverified(pair.AccessToken, &Claims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", token.Header["kid"])
+	assert.Equal(t, "RS256", token.Header["alg"])
+	_, err = after.ValidateRefreshToken(pair.RefreshToken)
+	assert.NoError(t, err)
+
+	// Once the old key is retired to its public half, it only verifies
+	writePublicKey(t, dir, "2026-01", oldKey.Public())
+	retired := newJWTService(t, dir, "2026-10", "")
+	_, err = retired.ValidateToken(accessToken(t, before))
+	assert.NoError(t, err)
+
+	// And once it is removed, its tokens are rejected
+	require.NoError(t, os.Remove(filepath.Join(dir, "2026-01.pem")))
+	removed := newJWTService(t, dir, "2026-10", "")
+	_, err = removed.ValidateToken(accessToken(t, before))
+	assert.Error(t, err)
+}
+
+func accessToken(t *testing.T, s *JWTService) string {
+	t.Helper()
+	pair, err := s.GenerateTokenPair("user-1", "tenant-1", "alice@example.com", "member", "session-1")
+	require.NoError(t, err)
+	return pair.AccessToken
+}
+
+func TestJWTService_RejectsForgedTokens(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := newEd25519Key(t)
+	writePrivateKey(t, dir, "current", key)
+	service := newJWTService(t, dir, "current", "")
+
+	claims := &Claims{UserID: "user-1", TokenType: AccessToken}
+	hs256 := func(kid string, secret []byte) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(secret)
+		require.NoError(t, err)
+		return signed
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{
+			// The public key is published, so it must never work as an HMAC secret
+			name:  "HS256 with the public key as secret",
+			token: hs256("current", key.Public().(ed25519.PublicKey)),
+		},
+		{
+			name:  "HS256 without kid",
+			token: hs256("", []byte("your-super-secret-key")),
+		},
+		{
+			name:  "unknown kid",
+			token: hs256("unknown", []byte("your-super-secret-key")),
+		},
+		{
+			name: "unsigned",
+			token: func() string {
+				signed, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+				require.NoError(t, err)
+				return signed
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ValidateToken(tt.token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJWTService_LegacyHS256(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writePrivateKey(t, dir, "current", newEd25519Key(t))
+	legacy := accessToken(t, NewJWTService("legacy-secret", 3600, 604800))
+
+	// Accepted only while the legacy secret is configured
+	_, err := newJWTService(t, dir, "current", "legacy-secret").ValidateToken(legacy)
+	assert.NoError(t, err)
+	_, err = newJWTService(t, dir, "current", "").ValidateToken(legacy)
+	assert.Error(t, err)
+}
+
+func TestLoadKeySet_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory", func(t *testing.T) {
+		_, err := LoadKeySet(t.TempDir(), "current", "")
+		assert.ErrorContains(t, err, "no jwt keys")
+	})
+
+	t.Run("signing key not found", func(t *testing.T) {
+		dir := t.TempDir()
+		writePrivateKey(t, dir, "current", newEd25519Key(t))
+		_, err := LoadKeySet(dir, "next", "")
+		assert.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("signing key is a public key", func(t *testing.T) {
+		dir := t.TempDir()
+		writePublicKey(t, dir, "current", newEd25519Key(t).Public())
+		_, err := LoadKeySet(dir, "current", "")
+		assert.ErrorContains(t, err, "private key")
+	})
+
+	t.Run("rsa key too small", func(t *testing.T) {
+		dir := t.TempDir()
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		writePrivateKey(t, dir, "current", key)
+		_, err = LoadKeySet(dir, "current", "")
+		assert.ErrorContains(t, err, "bits")
+	})
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	edKey := newEd25519Key(t)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	writePrivateKey(t, dir, "b-ed25519", edKey)
+	writePublicKey(t, dir, "a-rsa", &rsaKey.PublicKey)
+
+	keys, err := LoadKeySet(dir, "b-ed25519", "legacy-secret")
+	require.NoError(t, err)
+
+	jwks := keys.JWKS()
+	require.Len(t, jwks, 2)
+
+	assert.Equal(t, JWK{
+		Kty: "RSA",
+		Kid: "a-rsa",
+		Use: "sig",
+		Alg: "RS256",
+		N:   base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		E:   "AQAB",
+	}, jwks[0])
+	assert.Equal(t, JWK{
+		Kty: "OKP",
+		Kid: "b-ed25519",
+		Use: "sig",
+		Alg: "EdDSA",
+		Crv: "Ed25519",
+		X:   base64.RawURLEncoding.EncodeToString(edKey.Public().(ed25519.PublicKey)),
+	}, jwks[1])
+
+	// Shared secrets are never published
+	assert.Empty(t, NewHMACKeySet("secret").JWKS())
+}
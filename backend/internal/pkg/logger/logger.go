@@ -0,0 +1,156 @@
+// Package logger builds the application's slog logger: JSON lines carrying the
+// request, tenant, user and trace of the context, with secrets redacted.
+package logger
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+
+	"good-todo-go/internal/pkg/tracing"
+)
+
+// Redacted replaces the value of sensitive attributes
+const Redacted = "[REDACTED]"
+
+// sensitiveSuffixes match attribute and field names, lowercased without separators,
+// that hold secrets: password, password_hash, access_token, PasswordHash, totp_secret...
+var sensitiveSuffixes = []string{"password", "secret", "token", "hash", "authorization", "cookie"}
+
+// New returns a JSON logger writing to w at the named level (debug, info, warn or error)
+func New(w io.Writer, level string) (*slog.Logger, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+	handler := slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       l,
+		ReplaceAttr: redact,
+	})
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+type fieldsKey struct{}
+
+// fields identify the request a log line belongs to
+type fields struct {
+	requestID string
+	tenantID  string
+	userID    string
+}
+
+// WithRequestID stores the request ID for the log lines of the request
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	f := fromContext(ctx)
+	f.requestID = requestID
+	return context.WithValue(ctx, fieldsKey{}, f)
+}
+
+// WithUser stores the authenticated tenant and user for the log lines of the request
+func WithUser(ctx context.Context, tenantID, userID string) context.Context {
+	f := fromContext(ctx)
+	f.tenantID = tenantID
+	f.userID = userID
+	return context.WithValue(ctx, fieldsKey{}, f)
+}
+
+// RequestID returns the request ID stored in the context, if any
+func RequestID(ctx context.Context) string {
+	return fromContext(ctx).requestID
+}
+
+func fromContext(ctx context.Context) fields {
+	f, _ := ctx.Value(fieldsKey{}).(fields)
+	return f
+}
+
+// contextHandler adds the request, tenant, user and span of the context to each record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	f := fromContext(ctx)
+	if f.requestID != "" {
+		r.AddAttrs(slog.String("request_id", f.requestID))
+	}
+	if f.tenantID != "" {
+		r.AddAttrs(slog.String("tenant_id", f.tenantID))
+	}
+	if f.userID != "" {
+		r.AddAttrs(slog.String("user_id", f.userID))
+	}
+	if traceID, spanID := tracing.IDs(ctx); traceID != "" {
+		r.AddAttrs(slog.String("trace_id", traceID), slog.String("span_id", spanID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+func redact(_ []string, a slog.Attr) slog.Attr {
+	if sensitive(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	if a.Value.Kind() == slog.KindAny {
+		if _, ok := a.Value.Any().(error); ok {
+			return a
+		}
+		if v, ok := structValue(reflect.ValueOf(a.Value.Any())); ok {
+			return slog.Attr{Key: a.Key, Value: v}
+		}
+	}
+	return a
+}
+
+// structValue turns a struct, such as a domain model, into a group so that its
+// sensitive fields are redacted like attributes
+func structValue(v reflect.Value) (slog.Value, bool) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return slog.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return slog.Value{}, false
+	}
+	// Types that describe themselves, such as time.Time, are left to the handler
+	switch v.Interface().(type) {
+	case slog.LogValuer, json.Marshaler, encoding.TextMarshaler, fmt.Stringer:
+		return slog.Value{}, false
+	}
+
+	t := v.Type()
+	attrs := make([]slog.Attr, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		attr := redact(nil, slog.Any(field.Name, v.Field(i).Interface()))
+		attrs = append(attrs, attr)
+	}
+	return slog.GroupValue(attrs...), true
+}
+
+func sensitive(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		ID           string
+		PasswordHash string
+		CreatedAt    time.Time
+	}
+	createdAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	span := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	})
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		attrs []any
+		want  map[string]any
+	}{
+		{
+			name:  "request, tenant and user come from the context",
+			ctx:   WithUser(WithRequestID(context.Background(), "request-1"), "tenant-1", "user-1"),
+			attrs: []any{"status", 200},
+			want: map[string]any{
+				"request_id": "request-1",
+				"tenant_id":  "tenant-1",
+				"user_id":    "user-1",
+				"status":     float64(200),
+			},
+		},
+		{
+			name: "trace and span come from the context",
+			ctx:  trace.ContextWithSpanContext(context.Background(), span),
+			want: map[string]any{
+				"trace_id": "4bf92f3577b34da6a3ce929d0e0e4736",
+				"span_id":  "00f067aa0ba902b7",
+			},
+		},
+		{
+			name: "sensitive attributes are redacted",
+			ctx:  context.Background(),
+			attrs: []any{
+				"password", "hunter2",
+				"refresh_token", "eyJ...",
+				"Authorization", "Bearer eyJ...",
+				"token_id", "token-1",
+				"error", errors.New("boom"),
+			},
+			want: map[string]any{
+				"password":      Redacted,
+				"refresh_token": Redacted,
+				"Authorization": Redacted,
+				"token_id":      "token-1",
+				"error":         "boom",
+			},
+		},
+		{
+			name:  "sensitive fields of structs are redacted",
+			ctx:   context.Background(),
+			attrs: []any{"user", &user{ID: "user-1", PasswordHash: "$2a$10$...", CreatedAt: createdAt}},
+			want: map[string]any{
+				"user": map[string]any{
+					"ID":           "user-1",
+					"PasswordHash": Redacted,
+					"CreatedAt":    "2026-10-19T09:00:00Z",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+			logger, err := New(&buf, "info")
+			require.NoError(t, err)
+
+			logger.InfoContext(tt.ctx, "message", tt.attrs...)
+
+			var line map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &line))
+			assert.Equal(t, "message", line[slog.MessageKey])
+			for key, want := range tt.want {
+				assert.Equal(t, want, line[key], key)
+			}
+		})
+	}
+}
+
+func TestNew_Level(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	logger, err := New(&buf, "warn")
+	require.NoError(t, err)
+	logger.Info("hidden")
+	logger.Warn("shown")
+	assert.NotContains(t, buf.String(), "hidden")
+	assert.Contains(t, buf.String(), "shown")
+
+	_, err = New(&buf, "verbose")
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_mailer
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+type IMailer interface {
+	Send(ctx context.Context, msg *Message) error
+	// Check verifies the configuration (a valid sender and server address) without connecting,
+	// since mail is optional and a relay outage must not fail readiness
+	Check(ctx context.Context) error
+}
+
+// SMTPMailer sends mail through an SMTP server (MailHog in local development)
+type SMTPMailer struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+// NewSMTPMailer authenticates only when user is set
+func NewSMTPMailer(host, port, user, password, from string) IMailer {
+	var auth smtp.Auth
+	if user != "" {
+		auth = smtp.PlainAuth("", user, password, host)
+	}
+	return &SMTPMailer{
+		addr: net.JoinHostPort(host, port),
+		auth: auth,
+		from: from,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return fmt.Errorf("mailer: header contains a line break")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.Body)
+
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, []byte(b.String()))
+}
+
+func (m *SMTPMailer) Check(context.Context) error {
+	if _, err := mail.ParseAddress(m.from); err != nil {
+		return fmt.Errorf("mailer: invalid sender %q: %w", m.from, err)
+	}
+	host, port, err := net.SplitHostPort(m.addr)
+	if err != nil || host == "" || port == "" {
+		return errors.New("mailer: SMTP host and port are required")
+	}
+	return nil
+}
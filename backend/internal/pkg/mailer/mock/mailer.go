@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mailer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mailer.go -destination=mock/mailer.go -package=mock_mailer
+//
+
+// Package mock_mailer is a generated GoMock package.
+package mock_mailer
+
+import (
+	context "context"
+	mailer "good-todo-go/internal/pkg/mailer"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMailer is a mock of IMailer interface.
+type MockIMailer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMailerMockRecorder
+	isgomock struct{}
+}
+
+// MockIMailerMockRecorder is the mock recorder for MockIMailer.
+type MockIMailerMockRecorder struct {
+	mock *MockIMailer
+}
+
+// NewMockIMailer creates a new mock instance.
+func NewMockIMailer(ctrl *gomock.Controller) *MockIMailer {
+	mock := &MockIMailer{ctrl: ctrl}
+	mock.recorder = &MockIMailerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMailer) EXPECT() *MockIMailerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockIMailer) Check(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockIMailerMockRecorder) Check(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockIMailer)(nil).Check), ctx)
+}
+
+// Send mocks base method.
+func (m *MockIMailer) Send(ctx context.Context, msg *mailer.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockIMailerMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIMailer)(nil).Send), ctx, msg)
+}
@@ -0,0 +1,99 @@
+// Package metrics defines the Prometheus metrics of the API. They are served
+// on the admin port, away from the public API.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+// namespace prefixes the application's own metrics
+const namespace = "goodtodo"
+
+// Results of a login or token refresh
+const (
+	ResultSuccess     = "success"
+	ResultFailure     = "failure"
+	ResultMFARequired = "mfa_required"
+)
+
+// NewRegistry returns a registry with the Go runtime and process metrics
+func NewRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return registry
+}
+
+// Metrics are the collectors updated by the middlewares and usecases
+type Metrics struct {
+	HTTPRequests        *prometheus.CounterVec
+	HTTPRequestDuration *prometheus.HistogramVec
+	TenantRequests      *prometheus.CounterVec
+	Logins              *prometheus.CounterVec
+	TokenRefreshes      *prometheus.CounterVec
+	TodosCreated        prometheus.Counter
+	TodosCompleted      prometheus.Counter
+}
+
+// New creates the metrics and registers them with reg
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		HTTPRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		HTTPRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of HTTP requests by method, route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		TenantRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "tenant_requests_total",
+			Help:      "Authenticated HTTP requests by tenant.",
+		}, []string{"tenant_id"}),
+		Logins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "auth_logins_total",
+			Help:      "Password logins and two-factor verifications by result (success, failure or mfa_required).",
+		}, []string{"result"}),
+		TokenRefreshes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "auth_token_refreshes_total",
+			Help:      "Token refreshes by result (success or failure).",
+		}, []string{"result"}),
+		TodosCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "todos_created_total",
+			Help:      "Todos created, one by one or in batches.",
+		}),
+		TodosCompleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "todos_completed_total",
+			Help:      "Todos moved from open to completed.",
+		}),
+	}
+	reg.MustRegister(
+		m.HTTPRequests,
+		m.HTTPRequestDuration,
+		m.TenantRequests,
+		m.Logins,
+		m.TokenRefreshes,
+		m.TodosCreated,
+		m.TodosCompleted,
+	)
+	return m
+}
+
+// Result names the outcome of an operation for the result label
+func Result(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+	return ResultSuccess
+}
@@ -0,0 +1,154 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval stops tokens with unknown key IDs from making us fetch
+// the key set on every request
+const minRefreshInterval = time.Minute
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type cachedKeys struct {
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+// keySet caches the signing keys of each provider, refetching them when a
+// token is signed with a key it does not know (the provider rotated keys)
+type keySet struct {
+	httpClient *http.Client
+
+	mu   sync.Mutex
+	sets map[string]*cachedKeys
+}
+
+func newKeySet(httpClient *http.Client) *keySet {
+	return &keySet{httpClient: httpClient, sets: make(map[string]*cachedKeys)}
+}
+
+func (s *keySet) get(ctx context.Context, jwksURI, kid string) (any, error) {
+	s.mu.Lock()
+	cached, ok := s.sets[jwksURI]
+	s.mu.Unlock()
+
+	if ok {
+		if key, found := lookupKey(cached.keys, kid); found {
+			return key, nil
+		}
+		if time.Since(cached.fetchedAt) < minRefreshInterval {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+	}
+
+	keys, err := s.fetch(ctx, jwksURI)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.sets[jwksURI] = &cachedKeys{keys: keys, fetchedAt: time.Now()}
+	s.mu.Unlock()
+
+	if key, found := lookupKey(keys, kid); found {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey accepts a token without a key ID only when there is a single key
+func lookupKey(keys map[string]any, kid string) (any, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	key, ok := keys[kid]
+	return key, ok
+}
+
+func (s *keySet) fetch(ctx context.Context, jwksURI string) (map[string]any, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, s.httpClient, jwksURI, &doc); err != nil {
+		return nil, fmt.Errorf("oidc: fetch keys: %w", err)
+	}
+
+	keys := make(map[string]any)
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			// Keys of other types are skipped, not fatal
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k *jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, fmt.Errorf("rsa exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		if !key.Curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on the curve")
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc.go -destination=mock/oidc.go -package=mock_oidc
+//
+
+// Package mock_oidc is a generated GoMock package.
+package mock_oidc
+
+import (
+	context "context"
+	oidc "good-todo-go/internal/pkg/oidc"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIClient is a mock of IClient interface.
+type MockIClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockIClientMockRecorder
+	isgomock struct{}
+}
+
+// MockIClientMockRecorder is the mock recorder for MockIClient.
+type MockIClientMockRecorder struct {
+	mock *MockIClient
+}
+
+// NewMockIClient creates a new mock instance.
+func NewMockIClient(ctrl *gomock.Controller) *MockIClient {
+	mock := &MockIClient{ctrl: ctrl}
+	mock.recorder = &MockIClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIClient) EXPECT() *MockIClientMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockIClient) AuthCodeURL(ctx context.Context, cfg *oidc.Config, state, nonce, codeVerifier string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, cfg, state, nonce, codeVerifier)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockIClientMockRecorder) AuthCodeURL(ctx, cfg, state, nonce, codeVerifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockIClient)(nil).AuthCodeURL), ctx, cfg, state, nonce, codeVerifier)
+}
+
+// Discover mocks base method.
+func (m *MockIClient) Discover(ctx context.Context, issuer string) (*oidc.Provider, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Discover", ctx, issuer)
+	ret0, _ := ret[0].(*oidc.Provider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Discover indicates an expected call of Discover.
+func (mr *MockIClientMockRecorder) Discover(ctx, issuer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discover", reflect.TypeOf((*MockIClient)(nil).Discover), ctx, issuer)
+}
+
+// Exchange mocks base method.
+func (m *MockIClient) Exchange(ctx context.Context, cfg *oidc.Config, code, codeVerifier, nonce string) (*oidc.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, cfg, code, codeVerifier, nonce)
+	ret0, _ := ret[0].(*oidc.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockIClientMockRecorder) Exchange(ctx, cfg, code, codeVerifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockIClient)(nil).Exchange), ctx, cfg, code, codeVerifier, nonce)
+}
@@ -0,0 +1,262 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_oidc
+
+// Package oidc is a relying party for the OpenID Connect authorization code
+// flow with PKCE. Providers are found through discovery and ID tokens are
+// verified against the provider's published keys.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// discoveryTTL is how long provider metadata is cached
+const discoveryTTL = time.Hour
+
+// maxResponseSize bounds what is read from a provider
+const maxResponseSize = 1 << 20
+
+// Config is the registration of this application with one provider
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+// Provider is the part of the discovery document the code flow needs
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Identity is the verified content of an ID token
+type Identity struct {
+	Subject string
+	Email   string
+	// EmailVerified is nil when the provider does not send the claim
+	EmailVerified *bool
+	Name          string
+}
+
+type IClient interface {
+	// Discover fetches the provider metadata of an issuer
+	Discover(ctx context.Context, issuer string) (*Provider, error)
+	// AuthCodeURL returns where to send the user to sign in
+	AuthCodeURL(ctx context.Context, cfg *Config, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems an authorization code and returns the identity in the ID token
+	Exchange(ctx context.Context, cfg *Config, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+type cachedProvider struct {
+	provider  *Provider
+	fetchedAt time.Time
+}
+
+type Client struct {
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	providers map[string]*cachedProvider
+	keys      *keySet
+}
+
+// NewClient uses httpClient for every request to a provider
+func NewClient(httpClient *http.Client) IClient {
+	return &Client{
+		httpClient: httpClient,
+		providers:  make(map[string]*cachedProvider),
+		keys:       newKeySet(httpClient),
+	}
+}
+
+// GenerateCodeVerifier returns a random PKCE code verifier (RFC 7636)
+func GenerateCodeVerifier() (string, error) {
+	return randomString(32)
+}
+
+// GenerateState returns a random value for the state or nonce parameter
+func GenerateState() (string, error) {
+	return randomString(16)
+}
+
+// CodeChallenge is the S256 challenge of a code verifier
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (c *Client) Discover(ctx context.Context, issuer string) (*Provider, error) {
+	c.mu.Lock()
+	cached, ok := c.providers[issuer]
+	c.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < discoveryTTL {
+		return cached.provider, nil
+	}
+
+	var p Provider
+	if err := getJSON(ctx, c.httpClient, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &p); err != nil {
+		return nil, fmt.Errorf("oidc: discovery: %w", err)
+	}
+	// The issuer in the document must be the one configured, or tokens from
+	// another issuer could be accepted
+	if p.Issuer != issuer {
+		return nil, fmt.Errorf("oidc: discovery returned issuer %q, expected %q", p.Issuer, issuer)
+	}
+	if p.AuthorizationEndpoint == "" || p.TokenEndpoint == "" || p.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is missing endpoints")
+	}
+
+	c.mu.Lock()
+	c.providers[issuer] = &cachedProvider{provider: &p, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return &p, nil
+}
+
+func (c *Client) AuthCodeURL(ctx context.Context, cfg *Config, state, nonce, codeVerifier string) (string, error) {
+	p, err := c.Discover(ctx, cfg.Issuer)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(p.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("oidc: authorization endpoint: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", cfg.ClientID)
+	q.Set("redirect_uri", cfg.RedirectURL)
+	q.Set("scope", "openid email profile")
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", CodeChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func (c *Client) Exchange(ctx context.Context, cfg *Config, code, codeVerifier, nonce string) (*Identity, error) {
+	p, err := c.Discover(ctx, cfg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(cfg.ClientID), url.QueryEscape(cfg.ClientSecret))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil {
+		return nil, fmt.Errorf("oidc: token response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: token request failed: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+
+	return c.verifyIDToken(ctx, p, cfg.ClientID, token.IDToken, nonce)
+}
+
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified *bool  `json:"email_verified"`
+	Name          string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+func (c *Client) verifyIDToken(ctx context.Context, p *Provider, clientID, raw, nonce string) (*Identity, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+			return c.keys.get(ctx, p.JWKSURI, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		jwt.WithIssuer(p.Issuer),
+		jwt.WithAudience(clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: invalid id_token: %w", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("oidc: id_token nonce does not match")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("oidc: id_token has no subject")
+	}
+
+	return &Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+func getJSON(ctx context.Context, httpClient *http.Client, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", u, resp.StatusCode)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
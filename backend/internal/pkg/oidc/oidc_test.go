@@ -0,0 +1,206 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/pkg/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(p *oidctest.Provider) *Config {
+	return &Config{
+		Issuer:       p.Issuer,
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  "http://app.example.com/auth/oidc/acme/callback",
+	}
+}
+
+func TestClient_CodeFlow(t *testing.T) {
+	t.Parallel()
+
+	provider := oidctest.NewProvider(t)
+	provider.SignIn(oidctest.User{Subject: "alice", Email: "alice@example.com", EmailVerified: true, Name: "Alice"})
+	client := NewClient(http.DefaultClient)
+	cfg := testConfig(provider)
+
+	authorize := func(t *testing.T, nonce, verifier string) string {
+		t.Helper()
+		authURL, err := client.AuthCodeURL(context.Background(), cfg, "state-1", nonce, verifier)
+		require.NoError(t, err)
+		callback, err := provider.Authorize(authURL)
+		require.NoError(t, err)
+		assert.Equal(t, "state-1", callback.Query().Get("state"))
+		return callback.Query().Get("code")
+	}
+
+	t.Run("success", func(t *testing.T) {
+		verifier, err := GenerateCodeVerifier()
+		require.NoError(t, err)
+		code := authorize(t, "nonce-1", verifier)
+
+		identity, err := client.Exchange(context.Background(), cfg, code, verifier, "nonce-1")
+		require.NoError(t, err)
+		assert.Equal(t, "alice", identity.Subject)
+		assert.Equal(t, "alice@example.com", identity.Email)
+		require.NotNil(t, identity.EmailVerified)
+		assert.True(t, *identity.EmailVerified)
+		assert.Equal(t, "Alice", identity.Name)
+
+		// Codes are single use
+		_, err = client.Exchange(context.Background(), cfg, code, verifier, "nonce-1")
+		assert.Error(t, err)
+	})
+
+	t.Run("fail - wrong code verifier", func(t *testing.T) {
+		code := authorize(t, "nonce-1", "the-right-verifier-the-right-verifier-0123")
+
+		_, err := client.Exchange(context.Background(), cfg, code, "another-verifier-another-verifier-01234567", "nonce-1")
+		assert.ErrorContains(t, err, "PKCE")
+	})
+
+	t.Run("fail - nonce does not match", func(t *testing.T) {
+		verifier, err := GenerateCodeVerifier()
+		require.NoError(t, err)
+		code := authorize(t, "nonce-1", verifier)
+
+		_, err = client.Exchange(context.Background(), cfg, code, verifier, "nonce-2")
+		assert.ErrorContains(t, err, "nonce")
+	})
+
+	t.Run("fail - wrong client secret", func(t *testing.T) {
+		verifier, err := GenerateCodeVerifier()
+		require.NoError(t, err)
+		code := authorize(t, "nonce-1", verifier)
+
+		wrong := *cfg
+		wrong.ClientSecret = "wrong"
+		_, err = client.Exchange(context.Background(), &wrong, code, verifier, "nonce-1")
+		assert.ErrorContains(t, err, "invalid_client")
+	})
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	provider := oidctest.NewProvider(t)
+	client := NewClient(http.DefaultClient)
+
+	authURL, err := client.AuthCodeURL(context.Background(), testConfig(provider), "state-1", "nonce-1", "verifier")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, provider.Issuer+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, oidctest.ClientID, q.Get("client_id"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, CodeChallenge("verifier"), q.Get("code_challenge"))
+	// The verifier itself never leaves the relying party
+	assert.NotContains(t, authURL, "verifier=")
+}
+
+func TestClient_Discover(t *testing.T) {
+	t.Parallel()
+
+	provider := oidctest.NewProvider(t)
+	client := NewClient(http.DefaultClient)
+
+	p, err := client.Discover(context.Background(), provider.Issuer)
+	require.NoError(t, err)
+	assert.Equal(t, provider.Issuer+"/token", p.TokenEndpoint)
+
+	// The configured issuer must match the document exactly
+	_, err = client.Discover(context.Background(), provider.Issuer+"/")
+	assert.ErrorContains(t, err, "expected")
+}
+
+func TestClient_VerifyIDToken(t *testing.T) {
+	t.Parallel()
+
+	provider := oidctest.NewProvider(t)
+	client := NewClient(http.DefaultClient).(*Client)
+	p, err := client.Discover(context.Background(), provider.Issuer)
+	require.NoError(t, err)
+
+	now := time.Now()
+	claims := func(override jwt.MapClaims) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss":   provider.Issuer,
+			"sub":   "alice",
+			"aud":   oidctest.ClientID,
+			"exp":   now.Add(time.Minute).Unix(),
+			"nonce": "nonce-1",
+			"email": "alice@example.com",
+		}
+		for k, v := range override {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{
+			name:  "success",
+			token: provider.IDToken(claims(nil)),
+		},
+		{
+			name:    "fail - another audience",
+			token:   provider.IDToken(claims(jwt.MapClaims{"aud": "another-client"})),
+			wantErr: true,
+		},
+		{
+			name:    "fail - another issuer",
+			token:   provider.IDToken(claims(jwt.MapClaims{"iss": "https://evil.example.com"})),
+			wantErr: true,
+		},
+		{
+			name:    "fail - expired",
+			token:   provider.IDToken(claims(jwt.MapClaims{"exp": now.Add(-time.Hour).Unix()})),
+			wantErr: true,
+		},
+		{
+			name:    "fail - no subject",
+			token:   provider.IDToken(claims(jwt.MapClaims{"sub": ""})),
+			wantErr: true,
+		},
+		{
+			name:    "fail - signed with a shared secret",
+			token:   hs256Token(t, claims(nil)),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity, err := client.verifyIDToken(context.Background(), p, oidctest.ClientID, tt.token, "nonce-1")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "alice", identity.Subject)
+			assert.Nil(t, identity.EmailVerified)
+		})
+	}
+}
+
+func hs256Token(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	return signed
+}
@@ -0,0 +1,68 @@
+package pkg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testArgon2Params keep the tests fast
+var testArgon2Params = Argon2Params{Memory: 1024, Time: 1, Threads: 1}
+
+func TestPasswordHasher(t *testing.T) {
+	t.Parallel()
+
+	bcryptHasher, err := NewPasswordHasher(PasswordAlgorithmBcrypt, 5, testArgon2Params)
+	require.NoError(t, err)
+	argonHasher, err := NewPasswordHasher(PasswordAlgorithmArgon2id, 5, testArgon2Params)
+	require.NoError(t, err)
+
+	bcryptHash, err := bcryptHasher.Hash("password123")
+	require.NoError(t, err)
+	argonHash, err := argonHasher.Hash("password123")
+	require.NoError(t, err)
+
+	t.Run("both algorithms verify", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, strings.HasPrefix(argonHash, "$argon2id$v=19$m=1024,t=1,p=1$"))
+		assert.True(t, CheckPasswordHash("password123", bcryptHash))
+		assert.True(t, CheckPasswordHash("password123", argonHash))
+		assert.False(t, CheckPasswordHash("password124", bcryptHash))
+		assert.False(t, CheckPasswordHash("password124", argonHash))
+	})
+
+	t.Run("hashes from other settings need a rehash", func(t *testing.T) {
+		t.Parallel()
+		assert.False(t, bcryptHasher.NeedsRehash(bcryptHash))
+		assert.True(t, bcryptHasher.NeedsRehash(argonHash))
+		assert.False(t, argonHasher.NeedsRehash(argonHash))
+		assert.True(t, argonHasher.NeedsRehash(bcryptHash))
+
+		higherCost, err := NewPasswordHasher(PasswordAlgorithmBcrypt, 6, testArgon2Params)
+		require.NoError(t, err)
+		assert.True(t, higherCost.NeedsRehash(bcryptHash))
+
+		moreMemory, err := NewPasswordHasher(PasswordAlgorithmArgon2id, 5, Argon2Params{Memory: 2048, Time: 1, Threads: 1})
+		require.NoError(t, err)
+		assert.True(t, moreMemory.NeedsRehash(argonHash))
+	})
+
+	t.Run("malformed argon2id hashes do not verify", func(t *testing.T) {
+		t.Parallel()
+		assert.False(t, CheckPasswordHash("password123", "$argon2id$v=19$m=1024,t=1,p=1$salt"))
+		assert.False(t, CheckPasswordHash("password123", "$argon2id$v=18$m=1024,t=1,p=1$c2FsdA$a2V5"))
+	})
+}
+
+func TestNewPasswordHasher_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewPasswordHasher("md5", DefaultCost, DefaultArgon2Params)
+	assert.Error(t, err)
+	_, err = NewPasswordHasher(PasswordAlgorithmBcrypt, 40, DefaultArgon2Params)
+	assert.Error(t, err)
+	_, err = NewPasswordHasher(PasswordAlgorithmArgon2id, DefaultCost, Argon2Params{})
+	assert.Error(t, err)
+}
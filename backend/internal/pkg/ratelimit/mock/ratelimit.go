@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ratelimit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ratelimit.go -destination=mock/ratelimit.go -package=mock_ratelimit
+//
+
+// Package mock_ratelimit is a generated GoMock package.
+package mock_ratelimit
+
+import (
+	context "context"
+	ratelimit "good-todo-go/internal/pkg/ratelimit"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIStore is a mock of IStore interface.
+type MockIStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockIStoreMockRecorder is the mock recorder for MockIStore.
+type MockIStoreMockRecorder struct {
+	mock *MockIStore
+}
+
+// NewMockIStore creates a new mock instance.
+func NewMockIStore(ctrl *gomock.Controller) *MockIStore {
+	mock := &MockIStore{ctrl: ctrl}
+	mock.recorder = &MockIStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIStore) EXPECT() *MockIStoreMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockIStore) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (ratelimit.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, limit, now)
+	ret0, _ := ret[0].(ratelimit.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockIStoreMockRecorder) Take(ctx, key, limit, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockIStore)(nil).Take), ctx, key, limit, now)
+}
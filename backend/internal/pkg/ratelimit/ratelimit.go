@@ -0,0 +1,36 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_ratelimit
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit is a token bucket holding Requests tokens that refills completely every Period
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// PerMinute returns a limit of n requests per minute
+func PerMinute(n int) Limit {
+	return Limit{Requests: n, Period: time.Minute}
+}
+
+// Result is the state of a bucket after a Take
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetAfter is how long until the bucket is full again
+	ResetAfter time.Duration
+	// RetryAfter is how long until a request would be allowed. Zero when Allowed
+	RetryAfter time.Duration
+}
+
+// IStore keeps the buckets. The in-memory store limits each process separately;
+// a shared implementation makes several instances enforce one limit.
+type IStore interface {
+	// Take removes one token from the bucket for key, if there is one
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
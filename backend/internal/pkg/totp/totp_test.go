@@ -0,0 +1,95 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA-1 key from the RFC 6238 test vectors
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode_RFC6238Vectors(t *testing.T) {
+	t.Parallel()
+
+	// The RFC lists 8-digit codes; these are their last 6 digits
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got, "at %d", tt.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1111111109, 0)
+	code, err := Code(rfcSecret, now)
+	require.NoError(t, err)
+
+	step, ok := Validate(rfcSecret, code, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	// One period of clock drift either way is accepted
+	_, ok = Validate(rfcSecret, code, now.Add(Period), 0)
+	assert.True(t, ok)
+	_, ok = Validate(rfcSecret, code, now.Add(-Period), 0)
+	assert.True(t, ok)
+	_, ok = Validate(rfcSecret, code, now.Add(2*Period), 0)
+	assert.False(t, ok)
+
+	// A used step cannot be replayed
+	_, ok = Validate(rfcSecret, code, now, step)
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "000000", now, 0)
+	assert.False(t, ok)
+	_, ok = Validate(rfcSecret, "12345", now, 0)
+	assert.False(t, ok)
+	_, ok = Validate("not base32!", code, now, 0)
+	assert.False(t, ok)
+}
+
+func TestGenerateSecret(t *testing.T) {
+	t.Parallel()
+
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+}
+
+func TestURI(t *testing.T) {
+	t.Parallel()
+
+	u, err := url.Parse(URI("Good Todo", "user@example.com", "SECRET"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/Good Todo:user@example.com", u.Path)
+	assert.Equal(t, "SECRET", u.Query().Get("secret"))
+	assert.Equal(t, "Good Todo", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
@@ -0,0 +1,97 @@
+// Package tracing sets up OpenTelemetry tracing: spans are sent to an OTLP
+// collector, written to stdout for local use, or not exported at all.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Config selects where spans go
+type Config struct {
+	ServiceName string
+	// Exporter is none, stdout or otlp
+	Exporter string
+	// OTLPEndpoint is the OTLP/HTTP URL of the collector, e.g. http://localhost:4318.
+	// Empty uses the OTEL_EXPORTER_OTLP_* variables of the SDK
+	OTLPEndpoint string
+	// SampleRatio is the share of new traces recorded; traces started upstream follow the caller's decision
+	SampleRatio float64
+	// Stdout receives the spans of the stdout exporter
+	Stdout io.Writer
+}
+
+// NewTracerProvider returns a tracer provider exporting as configured. Spans are
+// created even with the none exporter, so that logs and error responses carry a trace ID
+func NewTracerProvider(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the service: %w", err)
+	}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	}
+
+	switch cfg.Exporter {
+	case ExporterNone, "":
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(cfg.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the stdout exporter: %w", err)
+		}
+		// Synchronous so that spans show up next to the log lines of the request
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	case ExporterOTLP:
+		var otlpOpts []otlptracehttp.Option
+		if cfg.OTLPEndpoint != "" {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithEndpointURL(cfg.OTLPEndpoint))
+		}
+		exporter, err := otlptracehttp.New(ctx, otlpOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the OTLP exporter: %w", err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q (want none, stdout or otlp)", cfg.Exporter)
+	}
+
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+// Propagator reads and writes the W3C traceparent and baggage headers
+func Propagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
+
+// SetGlobal makes tp and Propagator the defaults of instrumentation that is not handed them
+func SetGlobal(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(Propagator())
+}
+
+// IDs returns the trace and span IDs of the span in the context, or empty strings
+func IDs(ctx context.Context) (traceID, spanID string) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return "", ""
+	}
+	return sc.TraceID().String(), sc.SpanID().String()
+}
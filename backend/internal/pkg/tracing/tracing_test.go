@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		exporter   string
+		wantErr    bool
+		wantOutput bool
+	}{
+		{name: "none still creates spans", exporter: ExporterNone},
+		{name: "stdout writes the spans", exporter: ExporterStdout, wantOutput: true},
+		{name: "unknown exporter", exporter: "jaeger", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var buf bytes.Buffer
+
+			tp, err := NewTracerProvider(context.Background(), Config{
+				ServiceName: "good-todo-api",
+				Exporter:    tt.exporter,
+				SampleRatio: 1,
+				Stdout:      &buf,
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx, span := tp.Tracer("test").Start(context.Background(), "GET /todos")
+			traceID, spanID := IDs(ctx)
+			span.End()
+			require.NoError(t, tp.Shutdown(context.Background()))
+
+			assert.Len(t, traceID, 32)
+			assert.Len(t, spanID, 16)
+			if tt.wantOutput {
+				assert.Contains(t, buf.String(), traceID)
+				assert.Contains(t, buf.String(), "good-todo-api")
+			} else {
+				assert.Empty(t, buf.String())
+			}
+		})
+	}
+}
+
+func TestIDs_NoSpan(t *testing.T) {
+	t.Parallel()
+
+	traceID, spanID := IDs(context.Background())
+	assert.Empty(t, traceID)
+	assert.Empty(t, spanID)
+}
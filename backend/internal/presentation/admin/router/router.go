@@ -0,0 +1,25 @@
+package router
+
+import (
+	"good-todo-go/internal/pkg/cerror"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NewRouter serves the admin port, which is not exposed publicly.
+// It only serves the Prometheus metrics until the admin API is implemented
+func NewRouter(registry *prometheus.Registry) *echo.Echo {
+	e := echo.New()
+	// The public server prints the banner
+	e.HideBanner = true
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
+
+	e.GET("/metrics", echo.WrapHandler(promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		// promhttp_metric_handler_errors_total counts failed scrapes
+		Registry: registry,
+	})))
+
+	return e
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/pkg/metrics"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRouter_Metrics(t *testing.T) {
+	t.Parallel()
+
+	registry := metrics.NewRegistry()
+	m := metrics.New(registry)
+	m.TodosCreated.Inc()
+	e := NewRouter(registry)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goodtodo_todos_created_total 1")
+	assert.Contains(t, rec.Body.String(), "go_goroutines")
+}
@@ -27,21 +27,209 @@ const (
 	BearerScopes = "Bearer.Scopes"
 )
 
+// Defines values for AuditEventResponseAction.
+const (
+	AuditEventResponseActionCreate     AuditEventResponseAction = "create"
+	AuditEventResponseActionDelete     AuditEventResponseAction = "delete"
+	AuditEventResponseActionRestore    AuditEventResponseAction = "restore"
+	AuditEventResponseActionSoftDelete AuditEventResponseAction = "soft_delete"
+	AuditEventResponseActionUpdate     AuditEventResponseAction = "update"
+)
+
+// Defines values for DeletionPolicyRequestPublicTodos.
+const (
+	DeletionPolicyRequestPublicTodosDelete   DeletionPolicyRequestPublicTodos = "delete"
+	DeletionPolicyRequestPublicTodosReassign DeletionPolicyRequestPublicTodos = "reassign"
+)
+
+// Defines values for ErrorCode.
+const (
+	ACCOUNTLOCKED        ErrorCode = "ACCOUNT_LOCKED"
+	BADGATEWAY           ErrorCode = "BAD_GATEWAY"
+	BADREQUEST           ErrorCode = "BAD_REQUEST"
+	CONFLICT             ErrorCode = "CONFLICT"
+	FORBIDDEN            ErrorCode = "FORBIDDEN"
+	HTTPERROR            ErrorCode = "HTTP_ERROR"
+	INTERNALSERVERERROR  ErrorCode = "INTERNAL_SERVER_ERROR"
+	METHODNOTALLOWED     ErrorCode = "METHOD_NOT_ALLOWED"
+	NOTFOUND             ErrorCode = "NOT_FOUND"
+	PRECONDITIONFAILED   ErrorCode = "PRECONDITION_FAILED"
+	TOOMANYREQUESTS      ErrorCode = "TOO_MANY_REQUESTS"
+	UNAUTHORIZED         ErrorCode = "UNAUTHORIZED"
+	UNPROCESSABLEENTITY  ErrorCode = "UNPROCESSABLE_ENTITY"
+	UNSUPPORTEDMEDIATYPE ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	VALIDATIONERROR      ErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusFail HealthCheckStatus = "fail"
+	HealthCheckStatusOk   HealthCheckStatus = "ok"
+)
+
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for OIDCConfigRequestDefaultRole.
+const (
+	OIDCConfigRequestDefaultRoleAdmin  OIDCConfigRequestDefaultRole = "admin"
+	OIDCConfigRequestDefaultRoleMember OIDCConfigRequestDefaultRole = "member"
+)
+
+// Defines values for ReadinessStatus.
+const (
+	ReadinessStatusDraining ReadinessStatus = "draining"
+	ReadinessStatusFail     ReadinessStatus = "fail"
+	ReadinessStatusOk       ReadinessStatus = "ok"
+)
+
+// Defines values for TodoBatchOperationOp.
+const (
+	TodoBatchOperationOpComplete TodoBatchOperationOp = "complete"
+	TodoBatchOperationOpCreate   TodoBatchOperationOp = "create"
+	TodoBatchOperationOpDelete   TodoBatchOperationOp = "delete"
+	TodoBatchOperationOpMove     TodoBatchOperationOp = "move"
+	TodoBatchOperationOpUpdate   TodoBatchOperationOp = "update"
+)
+
+// Defines values for TodoBatchOperationTo.
+const (
+	Todos TodoBatchOperationTo = "todos"
+	Trash TodoBatchOperationTo = "trash"
+)
+
+// Defines values for TodoBatchRequestMode.
+const (
+	Atomic      TodoBatchRequestMode = "atomic"
+	Independent TodoBatchRequestMode = "independent"
+)
+
+// Defines values for TokenScope.
+const (
+	ReadTenant  TokenScope = "read:tenant"
+	ReadTodos   TokenScope = "read:todos"
+	ReadUser    TokenScope = "read:user"
+	WriteTenant TokenScope = "write:tenant"
+	WriteTodos  TokenScope = "write:todos"
+	WriteUser   TokenScope = "write:user"
+)
+
 // Defines values for UserResponseRole.
 const (
-	Admin  UserResponseRole = "admin"
-	Member UserResponseRole = "member"
+	UserResponseRoleAdmin  UserResponseRole = "admin"
+	UserResponseRoleMember UserResponseRole = "member"
 )
 
+// Defines values for GetAuditEventsParamsAction.
+const (
+	GetAuditEventsParamsActionCreate     GetAuditEventsParamsAction = "create"
+	GetAuditEventsParamsActionDelete     GetAuditEventsParamsAction = "delete"
+	GetAuditEventsParamsActionRestore    GetAuditEventsParamsAction = "restore"
+	GetAuditEventsParamsActionSoftDelete GetAuditEventsParamsAction = "soft_delete"
+	GetAuditEventsParamsActionUpdate     GetAuditEventsParamsAction = "update"
+)
+
+// AccountExportResponse defines model for AccountExportResponse.
+type AccountExportResponse struct {
+	ExportedAt           time.Time                     `json:"exported_at"`
+	PersonalAccessTokens []PersonalAccessTokenResponse `json:"personal_access_tokens"`
+	Sessions             []SessionResponse             `json:"sessions"`
+
+	// Todos Every todo of the user, including the trash
+	Todos []TodoResponse `json:"todos"`
+	User  UserResponse   `json:"user"`
+}
+
+// AuditEventListResponse defines model for AuditEventListResponse.
+type AuditEventListResponse struct {
+	Events *[]AuditEventResponse `json:"events,omitempty"`
+	Total  *int                  `json:"total,omitempty"`
+}
+
+// AuditEventResponse defines model for AuditEventResponse.
+type AuditEventResponse struct {
+	Action *AuditEventResponseAction `json:"action,omitempty"`
+
+	// ActorId The user who made the change (null for unauthenticated requests)
+	ActorId *string `json:"actor_id"`
+
+	// After Changed fields after the mutation
+	After *map[string]interface{} `json:"after"`
+
+	// Before Changed fields before the mutation. Secrets and personal data (email,
+	// name, todo title and description) are recorded as "[REDACTED]"
+	Before    *map[string]interface{} `json:"before"`
+	CreatedAt *time.Time              `json:"created_at,omitempty"`
+	EntityId  *string                 `json:"entity_id,omitempty"`
+
+	// EntityType The changed entity (Todo, User or Tenant)
+	EntityType *string `json:"entity_type,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Ip         *string `json:"ip"`
+	RequestId  *string `json:"request_id"`
+}
+
+// AuditEventResponseAction defines model for AuditEventResponse.Action.
+type AuditEventResponseAction string
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	AccessToken *string `json:"access_token,omitempty"`
 
 	// ExpiresIn Access token expiration time in seconds
-	ExpiresIn    *int          `json:"expires_in,omitempty"`
-	RefreshToken *string       `json:"refresh_token,omitempty"`
-	TokenType    *string       `json:"token_type,omitempty"`
-	User         *UserResponse `json:"user,omitempty"`
+	ExpiresIn *int `json:"expires_in,omitempty"`
+
+	// MfaEnrollmentRequired The tenant requires two-factor authentication and the user has not
+	// enrolled. Call /auth/mfa/enroll first, then verify with the first code
+	MfaEnrollmentRequired *bool `json:"mfa_enrollment_required,omitempty"`
+
+	// MfaRequired True when the password was right but a second factor is needed. There
+	// are no tokens yet: send `mfa_token` with a code to /auth/mfa/verify
+	MfaRequired *bool `json:"mfa_required,omitempty"`
+
+	// MfaToken Challenge token, valid for 5 minutes
+	MfaToken *string `json:"mfa_token,omitempty"`
+
+	// RecoveryCodes Returned once, when the login completed enrollment
+	RecoveryCodes *[]string     `json:"recovery_codes,omitempty"`
+	RefreshToken  *string       `json:"refresh_token,omitempty"`
+	TokenType     *string       `json:"token_type,omitempty"`
+	User          *UserResponse `json:"user,omitempty"`
+}
+
+// ChangeEmailRequest defines model for ChangeEmailRequest.
+type ChangeEmailRequest struct {
+	CurrentPassword string              `json:"current_password"`
+	NewEmail        openapi_types.Email `json:"new_email"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+
+	// NewPassword Checked against the tenant's password policy and known breached passwords
+	NewPassword string `json:"new_password"`
+}
+
+// ConfirmEmailChangeRequest defines model for ConfirmEmailChangeRequest.
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
+
+// CreatePersonalAccessTokenRequest defines model for CreatePersonalAccessTokenRequest.
+type CreatePersonalAccessTokenRequest struct {
+	ExpiresInDays *int         `json:"expires_in_days,omitempty"`
+	Name          string       `json:"name"`
+	Scopes        []TokenScope `json:"scopes"`
 }
 
 // CreateTodoRequest defines model for CreateTodoRequest.
@@ -54,11 +242,90 @@ type CreateTodoRequest struct {
 	Title    string `json:"title"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Code    *string                 `json:"code,omitempty"`
-	Details *map[string]interface{} `json:"details,omitempty"`
-	Message *string                 `json:"message,omitempty"`
+// CreatedPersonalAccessTokenResponse defines model for CreatedPersonalAccessTokenResponse.
+type CreatedPersonalAccessTokenResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Id        string    `json:"id"`
+
+	// LastUsedAt Updated at most once a minute
+	LastUsedAt *time.Time   `json:"last_used_at"`
+	Name       string       `json:"name"`
+	Scopes     []TokenScope `json:"scopes"`
+
+	// Token Send as `Authorization: Bearer <token>`. Shown only once
+	Token string `json:"token"`
+}
+
+// DeleteAccountRequest Either the password or a code from POST /me/deletion-code
+type DeleteAccountRequest struct {
+	Code     *string `json:"code,omitempty"`
+	Password *string `json:"password,omitempty"`
+}
+
+// DeletionPolicyRequest defines model for DeletionPolicyRequest.
+type DeletionPolicyRequest struct {
+	// PublicTodos What happens to the public todos of a deleted account. reassign gives them to the oldest admin
+	PublicTodos DeletionPolicyRequestPublicTodos `json:"public_todos"`
+}
+
+// DeletionPolicyRequestPublicTodos What happens to the public todos of a deleted account. reassign gives them to the oldest admin
+type DeletionPolicyRequestPublicTodos string
+
+// ErrorCode Machine-readable error code
+type ErrorCode string
+
+// FieldErrors Problems with individual fields of a VALIDATION_ERROR, keyed by the field
+// (dotted path in the body, or parameter name; "body" for the body as a whole)
+type FieldErrors map[string]string
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	// Error Why the check failed; details are in the server logs
+	Error     *string           `json:"error,omitempty"`
+	LatencyMs float64           `json:"latency_ms"`
+	Status    HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// JWK Public key in JSON Web Key format (RFC 7517)
+type JWK struct {
+	Alg JWKAlg `json:"alg"`
+
+	// Crv Curve of an OKP key
+	Crv *string `json:"crv,omitempty"`
+
+	// E RSA exponent (base64url)
+	E *string `json:"e,omitempty"`
+
+	// Kid Matches the `kid` header of the tokens signed with this key
+	Kid string `json:"kid"`
+	Kty JWKKty `json:"kty"`
+
+	// N RSA modulus (base64url)
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+
+	// X Ed25519 public key (base64url)
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Liveness defines model for Liveness.
+type Liveness struct {
+	Status string `json:"status"`
 }
 
 // LoginRequest defines model for LoginRequest.
@@ -68,6 +335,156 @@ type LoginRequest struct {
 	TenantSlug string              `json:"tenant_slug"`
 }
 
+// MFACodeRequest defines model for MFACodeRequest.
+type MFACodeRequest struct {
+	// Code 6-digit TOTP code (or, to disable, an unused recovery code)
+	Code string `json:"code"`
+}
+
+// MFAEnrollRequest defines model for MFAEnrollRequest.
+type MFAEnrollRequest struct {
+	MfaToken string `json:"mfa_token"`
+}
+
+// MFAEnrollmentResponse defines model for MFAEnrollmentResponse.
+type MFAEnrollmentResponse struct {
+	// OtpauthUri otpauth:// URI to show as a QR code
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32 TOTP secret, for manual entry
+	Secret string `json:"secret"`
+}
+
+// MFAPolicyRequest defines model for MFAPolicyRequest.
+type MFAPolicyRequest struct {
+	// Required Require every member of the tenant to use two-factor authentication
+	Required bool `json:"required"`
+}
+
+// MFARecoveryCodesResponse defines model for MFARecoveryCodesResponse.
+type MFARecoveryCodesResponse struct {
+	// RecoveryCodes Single-use codes for when the authenticator is unavailable. Shown only once
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// MFAVerifyRequest defines model for MFAVerifyRequest.
+type MFAVerifyRequest struct {
+	// Code 6-digit TOTP code or an unused recovery code
+	Code     string `json:"code"`
+	MfaToken string `json:"mfa_token"`
+}
+
+// OIDCConfigRequest defines model for OIDCConfigRequest.
+type OIDCConfigRequest struct {
+	// AllowedDomains Email domains that may sign in, such as `example.com`
+	AllowedDomains []string `json:"allowed_domains"`
+	ClientId       string   `json:"client_id"`
+
+	// ClientSecret Required when creating the config. Leave out to keep the current secret
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// DefaultRole Role given to users created on their first sign-in
+	DefaultRole *OIDCConfigRequestDefaultRole `json:"default_role,omitempty"`
+
+	// Issuer Issuer URL of the provider. `/.well-known/openid-configuration` must be served under it
+	Issuer string `json:"issuer"`
+}
+
+// OIDCConfigRequestDefaultRole Role given to users created on their first sign-in
+type OIDCConfigRequestDefaultRole string
+
+// OIDCConfigResponse The client secret is never returned
+type OIDCConfigResponse struct {
+	AllowedDomains *[]string `json:"allowed_domains,omitempty"`
+	ClientId       *string   `json:"client_id,omitempty"`
+	DefaultRole    *string   `json:"default_role,omitempty"`
+	Issuer         *string   `json:"issuer,omitempty"`
+
+	// RedirectUrl Callback URL to register with the provider
+	RedirectUrl *string    `json:"redirect_url,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// PasswordPolicyRequest defines model for PasswordPolicyRequest.
+type PasswordPolicyRequest struct {
+	// History Number of previous passwords, including the current one, that cannot be reused
+	History *int `json:"history,omitempty"`
+
+	// MinCharacterClasses How many of lowercase letters, uppercase letters, digits and symbols a password must contain
+	MinCharacterClasses *int `json:"min_character_classes,omitempty"`
+
+	// MinLength Minimum length in characters. Omit or null for the server default (PASSWORD_MIN_LENGTH)
+	MinLength *int `json:"min_length"`
+}
+
+// PersonalAccessTokenListResponse defines model for PersonalAccessTokenListResponse.
+type PersonalAccessTokenListResponse struct {
+	Tokens []PersonalAccessTokenResponse `json:"tokens"`
+}
+
+// PersonalAccessTokenResponse defines model for PersonalAccessTokenResponse.
+type PersonalAccessTokenResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Id        string    `json:"id"`
+
+	// LastUsedAt Updated at most once a minute
+	LastUsedAt *time.Time   `json:"last_used_at"`
+	Name       string       `json:"name"`
+	Scopes     []TokenScope `json:"scopes"`
+}
+
+// Problem Error response in the RFC 7807 problem details format (application/problem+json).
+// Other members, such as retry_after_seconds, may be present depending on the type.
+type Problem struct {
+	// Code Machine-readable error code
+	Code ErrorCode `json:"code"`
+
+	// Detail Explanation specific to this occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Problems with individual fields of a VALIDATION_ERROR, keyed by the field
+	// (dotted path in the body, or parameter name; "body" for the body as a whole)
+	Errors *FieldErrors `json:"errors,omitempty"`
+
+	// Instance Path of the request that failed
+	Instance *string `json:"instance,omitempty"`
+
+	// RequestId ID of the request (the X-Request-Id header), for correlating with server logs
+	RequestId *string `json:"request_id,omitempty"`
+
+	// Status HTTP status code
+	Status int `json:"status"`
+
+	// Title Short summary of the kind of problem
+	Title string `json:"title"`
+
+	// TraceId W3C trace ID of the request, for finding its trace in the tracing backend
+	TraceId *string `json:"trace_id,omitempty"`
+
+	// Type URI identifying the kind of problem, one per code (about:blank for plain HTTP errors)
+	Type                 string                 `json:"type"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RateLimitPolicyRequest defines model for RateLimitPolicyRequest.
+type RateLimitPolicyRequest struct {
+	// RequestsPerMinute Requests per minute allowed for each member. Omit or null for the server default (RATE_LIMIT_USER_PER_MINUTE)
+	RequestsPerMinute *int `json:"requests_per_minute"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Checks Result of each check (database, migrations, rls, smtp); empty while draining
+	Checks map[string]HealthCheck `json:"checks"`
+
+	// Status ok when every check passes, draining while the server shuts down
+	Status ReadinessStatus `json:"status"`
+}
+
+// ReadinessStatus ok when every check passes, draining while the server shuts down
+type ReadinessStatus string
+
 // RefreshTokenRequest defines model for RefreshTokenRequest.
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
@@ -75,14 +492,99 @@ type RefreshTokenRequest struct {
 
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Name     *string             `json:"name,omitempty"`
-	Password string              `json:"password"`
+	Email openapi_types.Email `json:"email"`
+	Name  *string             `json:"name,omitempty"`
+
+	// Password Checked against the tenant's password policy and known breached passwords
+	Password string `json:"password"`
 
 	// TenantSlug Tenant identifier (slug)
 	TenantSlug string `json:"tenant_slug"`
 }
 
+// RevokedSessionsResponse defines model for RevokedSessionsResponse.
+type RevokedSessionsResponse struct {
+	// Revoked Number of sessions ended
+	Revoked int `json:"revoked"`
+}
+
+// SessionListResponse defines model for SessionListResponse.
+type SessionListResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+// SessionResponse defines model for SessionResponse.
+type SessionResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Current The session of the calling access token
+	Current   bool      `json:"current"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Id        string    `json:"id"`
+
+	// IpAddress Client IP of the last login or refresh
+	IpAddress string `json:"ip_address"`
+
+	// LastUsedAt Last login or refresh
+	LastUsedAt time.Time `json:"last_used_at"`
+
+	// UserAgent User-Agent of the last login or refresh
+	UserAgent string `json:"user_agent"`
+}
+
+// TodoBatchOperation defines model for TodoBatchOperation.
+type TodoBatchOperation struct {
+	Completed   *bool      `json:"completed,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date"`
+
+	// Id Target todo, required by every op except create
+	Id       *string              `json:"id,omitempty"`
+	IsPublic *bool                `json:"is_public,omitempty"`
+	Op       TodoBatchOperationOp `json:"op"`
+	Title    *string              `json:"title,omitempty"`
+
+	// To Destination of a move, the trash or the active todo list
+	To *TodoBatchOperationTo `json:"to,omitempty"`
+
+	// Version For update and complete, fail with 412 unless the todo is at this version
+	Version *int `json:"version,omitempty"`
+}
+
+// TodoBatchOperationOp defines model for TodoBatchOperation.Op.
+type TodoBatchOperationOp string
+
+// TodoBatchOperationTo Destination of a move, the trash or the active todo list
+type TodoBatchOperationTo string
+
+// TodoBatchRequest defines model for TodoBatchRequest.
+type TodoBatchRequest struct {
+	// Mode atomic rolls back all operations if one fails, independent reports each result
+	Mode       *TodoBatchRequestMode `json:"mode,omitempty"`
+	Operations []TodoBatchOperation  `json:"operations"`
+}
+
+// TodoBatchRequestMode atomic rolls back all operations if one fails, independent reports each result
+type TodoBatchRequestMode string
+
+// TodoBatchResponse defines model for TodoBatchResponse.
+type TodoBatchResponse struct {
+	Results *[]TodoBatchResult `json:"results,omitempty"`
+}
+
+// TodoBatchResult defines model for TodoBatchResult.
+type TodoBatchResult struct {
+	// Error Error response in the RFC 7807 problem details format (application/problem+json).
+	// Other members, such as retry_after_seconds, may be present depending on the type.
+	Error *Problem `json:"error,omitempty"`
+	Index int      `json:"index"`
+	Op    string   `json:"op"`
+
+	// Status HTTP status the single-todo endpoint would have returned
+	Status int           `json:"status"`
+	Todo   *TodoResponse `json:"todo,omitempty"`
+}
+
 // TodoCreator defines model for TodoCreator.
 type TodoCreator struct {
 	Id   string `json:"id"`
@@ -95,15 +597,33 @@ type TodoListResponse struct {
 	Total *int            `json:"total,omitempty"`
 }
 
+// TodoMergePatch defines model for TodoMergePatch.
+type TodoMergePatch struct {
+	Completed *bool `json:"completed,omitempty"`
+
+	// Description null clears the description
+	Description *string `json:"description"`
+
+	// DueDate null clears the due date
+	DueDate *time.Time `json:"due_date"`
+
+	// IsPublic If true, visible to all users in the same tenant
+	IsPublic *bool   `json:"is_public,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
 // TodoResponse defines model for TodoResponse.
 type TodoResponse struct {
 	Completed   *bool        `json:"completed,omitempty"`
 	CompletedAt *time.Time   `json:"completed_at"`
 	CreatedAt   *time.Time   `json:"created_at,omitempty"`
 	CreatedBy   *TodoCreator `json:"created_by,omitempty"`
-	Description *string      `json:"description,omitempty"`
-	DueDate     *time.Time   `json:"due_date"`
-	Id          *string      `json:"id,omitempty"`
+
+	// DeletedAt Set when the todo is in the trash
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description,omitempty"`
+	DueDate     *time.Time `json:"due_date"`
+	Id          *string    `json:"id,omitempty"`
 
 	// IsPublic If true, visible to all users in the same tenant
 	IsPublic  *bool      `json:"is_public,omitempty"`
@@ -112,6 +632,21 @@ type TodoResponse struct {
 
 	// UserId The ID of the user who created this todo
 	UserId *string `json:"user_id,omitempty"`
+
+	// Version Incremented on every update. Also sent as the ETag header
+	Version *int `json:"version,omitempty"`
+}
+
+// TokenScope `read:` scopes allow GET requests and `write:` scopes the others.
+// `todos` covers /todos, `user` covers /me, and `tenant` covers /tenant,
+// /users and /audit-events (which also need the admin role).
+// Tokens cannot manage tokens or two-factor authentication.
+type TokenScope string
+
+// TrashRetentionPolicyRequest defines model for TrashRetentionPolicyRequest.
+type TrashRetentionPolicyRequest struct {
+	// Days Days before deleted todos are purged from the trash. Omit or null for the server default (TRASH_RETENTION_DAYS)
+	Days *int `json:"days"`
 }
 
 // UpdateTodoRequest defines model for UpdateTodoRequest.
@@ -130,16 +665,25 @@ type UpdateUserRequest struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// UserMergePatch defines model for UserMergePatch.
+type UserMergePatch struct {
+	// Name null clears the name
+	Name *string `json:"name"`
+}
+
 // UserResponse defines model for UserResponse.
 type UserResponse struct {
-	CreatedAt     *time.Time        `json:"created_at,omitempty"`
-	Email         *string           `json:"email,omitempty"`
-	EmailVerified *bool             `json:"email_verified,omitempty"`
-	Id            *string           `json:"id,omitempty"`
-	Name          *string           `json:"name,omitempty"`
-	Role          *UserResponseRole `json:"role,omitempty"`
-	TenantId      *string           `json:"tenant_id,omitempty"`
-	UpdatedAt     *time.Time        `json:"updated_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	Email         *string    `json:"email,omitempty"`
+	EmailVerified *bool      `json:"email_verified,omitempty"`
+	Id            *string    `json:"id,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+
+	// PendingEmail New address waiting for confirmation. Email changes only once it is confirmed
+	PendingEmail *string           `json:"pending_email,omitempty"`
+	Role         *UserResponseRole `json:"role,omitempty"`
+	TenantId     *string           `json:"tenant_id,omitempty"`
+	UpdatedAt    *time.Time        `json:"updated_at,omitempty"`
 }
 
 // UserResponseRole defines model for UserResponse.Role.
@@ -150,6 +694,42 @@ type VerifyEmailRequest struct {
 	Token string `json:"token"`
 }
 
+// GetAuditEventsParams defines parameters for GetAuditEvents.
+type GetAuditEventsParams struct {
+	// EntityType Filter by entity type (Todo, User, Tenant, OIDCConfig or PersonalAccessToken)
+	EntityType *string                     `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *string                     `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+	ActorId    *string                     `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Action     *GetAuditEventsParamsAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// From Only events at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events before this time
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAuditEventsParamsAction defines parameters for GetAuditEvents.
+type GetAuditEventsParamsAction string
+
+// OidcCallbackParams defines parameters for OidcCallback.
+type OidcCallbackParams struct {
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error Set by the provider when the sign-in was refused
+	Error     *string `form:"error,omitempty" json:"error,omitempty"`
+	OidcState *string `form:"oidc_state,omitempty" json:"oidc_state,omitempty"`
+}
+
+// RevokeAllSessionsParams defines parameters for RevokeAllSessions.
+type RevokeAllSessionsParams struct {
+	// ExceptCurrent Keep the session of the calling access token signed in
+	ExceptCurrent *bool `form:"except_current,omitempty" json:"except_current,omitempty"`
+}
+
 // GetTodosParams defines parameters for GetTodos.
 type GetTodosParams struct {
 	// Completed Filter by completion status
@@ -164,9 +744,39 @@ type GetPublicTodosParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetTrashParams defines parameters for GetTrash.
+type GetTrashParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTodoParams defines parameters for GetTodo.
+type GetTodoParams struct {
+	// IfNoneMatch ETag from a previous response. Returns 304 if the todo is unchanged
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// PatchTodoParams defines parameters for PatchTodo.
+type PatchTodoParams struct {
+	// IfMatch ETag from a previous response. The update is rejected with 412 if the todo changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// IfMatch ETag from a previous response. The update is rejected with 412 if the todo changed since
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// EnrollMfaChallengeJSONRequestBody defines body for EnrollMfaChallenge for application/json ContentType.
+type EnrollMfaChallengeJSONRequestBody = MFAEnrollRequest
+
+// VerifyMfaJSONRequestBody defines body for VerifyMfa for application/json ContentType.
+type VerifyMfaJSONRequestBody = MFAVerifyRequest
+
 // RefreshTokenJSONRequestBody defines body for RefreshToken for application/json ContentType.
 type RefreshTokenJSONRequestBody = RefreshTokenRequest
 
@@ -176,41 +786,269 @@ type RegisterJSONRequestBody = RegisterRequest
 // VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
 type VerifyEmailJSONRequestBody = VerifyEmailRequest
 
+// DeleteMeJSONRequestBody defines body for DeleteMe for application/json ContentType.
+type DeleteMeJSONRequestBody = DeleteAccountRequest
+
+// PatchMeApplicationMergePatchPlusJSONRequestBody defines body for PatchMe for application/merge-patch+json ContentType.
+type PatchMeApplicationMergePatchPlusJSONRequestBody = UserMergePatch
+
 // UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
 type UpdateMeJSONRequestBody = UpdateUserRequest
 
+// RequestEmailChangeJSONRequestBody defines body for RequestEmailChange for application/json ContentType.
+type RequestEmailChangeJSONRequestBody = ChangeEmailRequest
+
+// ConfirmEmailChangeJSONRequestBody defines body for ConfirmEmailChange for application/json ContentType.
+type ConfirmEmailChangeJSONRequestBody = ConfirmEmailChangeRequest
+
+// ConfirmMfaJSONRequestBody defines body for ConfirmMfa for application/json ContentType.
+type ConfirmMfaJSONRequestBody = MFACodeRequest
+
+// DisableMfaJSONRequestBody defines body for DisableMfa for application/json ContentType.
+type DisableMfaJSONRequestBody = MFACodeRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// CreatePersonalAccessTokenJSONRequestBody defines body for CreatePersonalAccessToken for application/json ContentType.
+type CreatePersonalAccessTokenJSONRequestBody = CreatePersonalAccessTokenRequest
+
+// SetTenantDeletionPolicyJSONRequestBody defines body for SetTenantDeletionPolicy for application/json ContentType.
+type SetTenantDeletionPolicyJSONRequestBody = DeletionPolicyRequest
+
+// SetTenantMfaPolicyJSONRequestBody defines body for SetTenantMfaPolicy for application/json ContentType.
+type SetTenantMfaPolicyJSONRequestBody = MFAPolicyRequest
+
+// SaveTenantOidcConfigJSONRequestBody defines body for SaveTenantOidcConfig for application/json ContentType.
+type SaveTenantOidcConfigJSONRequestBody = OIDCConfigRequest
+
+// SetTenantPasswordPolicyJSONRequestBody defines body for SetTenantPasswordPolicy for application/json ContentType.
+type SetTenantPasswordPolicyJSONRequestBody = PasswordPolicyRequest
+
+// SetTenantRateLimitJSONRequestBody defines body for SetTenantRateLimit for application/json ContentType.
+type SetTenantRateLimitJSONRequestBody = RateLimitPolicyRequest
+
+// SetTenantTrashRetentionJSONRequestBody defines body for SetTenantTrashRetention for application/json ContentType.
+type SetTenantTrashRetentionJSONRequestBody = TrashRetentionPolicyRequest
+
 // CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
 type CreateTodoJSONRequestBody = CreateTodoRequest
 
+// BatchTodosJSONRequestBody defines body for BatchTodos for application/json ContentType.
+type BatchTodosJSONRequestBody = TodoBatchRequest
+
+// PatchTodoApplicationMergePatchPlusJSONRequestBody defines body for PatchTodo for application/merge-patch+json ContentType.
+type PatchTodoApplicationMergePatchPlusJSONRequestBody = TodoMergePatch
+
 // UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
 type UpdateTodoJSONRequestBody = UpdateTodoRequest
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// Getter for additional properties for Problem. Returns the specified
+// element and whether it was found
+func (a Problem) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// Setter for additional properties for Problem
+func (a *Problem) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// Override default JSON handling for Problem to handle AdditionalProperties
+func (a *Problem) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	if raw, found := object["code"]; found {
+		err = json.Unmarshal(raw, &a.Code)
+		if err != nil {
+			return fmt.Errorf("error reading 'code': %w", err)
+		}
+		delete(object, "code")
+	}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
-}
+	if raw, found := object["detail"]; found {
+		err = json.Unmarshal(raw, &a.Detail)
+		if err != nil {
+			return fmt.Errorf("error reading 'detail': %w", err)
+		}
+		delete(object, "detail")
+	}
+
+	if raw, found := object["errors"]; found {
+		err = json.Unmarshal(raw, &a.Errors)
+		if err != nil {
+			return fmt.Errorf("error reading 'errors': %w", err)
+		}
+		delete(object, "errors")
+	}
+
+	if raw, found := object["instance"]; found {
+		err = json.Unmarshal(raw, &a.Instance)
+		if err != nil {
+			return fmt.Errorf("error reading 'instance': %w", err)
+		}
+		delete(object, "instance")
+	}
+
+	if raw, found := object["request_id"]; found {
+		err = json.Unmarshal(raw, &a.RequestId)
+		if err != nil {
+			return fmt.Errorf("error reading 'request_id': %w", err)
+		}
+		delete(object, "request_id")
+	}
+
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &a.Status)
+		if err != nil {
+			return fmt.Errorf("error reading 'status': %w", err)
+		}
+		delete(object, "status")
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &a.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+		delete(object, "title")
+	}
+
+	if raw, found := object["trace_id"]; found {
+		err = json.Unmarshal(raw, &a.TraceId)
+		if err != nil {
+			return fmt.Errorf("error reading 'trace_id': %w", err)
+		}
+		delete(object, "trace_id")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Problem to handle AdditionalProperties
+func (a Problem) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["code"], err = json.Marshal(a.Code)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'code': %w", err)
+	}
+
+	if a.Detail != nil {
+		object["detail"], err = json.Marshal(a.Detail)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'detail': %w", err)
+		}
+	}
+
+	if a.Errors != nil {
+		object["errors"], err = json.Marshal(a.Errors)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'errors': %w", err)
+		}
+	}
+
+	if a.Instance != nil {
+		object["instance"], err = json.Marshal(a.Instance)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'instance': %w", err)
+		}
+	}
+
+	if a.RequestId != nil {
+		object["request_id"], err = json.Marshal(a.RequestId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'request_id': %w", err)
+		}
+	}
+
+	object["status"], err = json.Marshal(a.Status)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'status': %w", err)
+	}
+
+	object["title"], err = json.Marshal(a.Title)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	}
+
+	if a.TraceId != nil {
+		object["trace_id"], err = json.Marshal(a.TraceId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'trace_id': %w", err)
+		}
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
 
 // ClientOption allows setting custom parameters during construction
 type ClientOption func(*Client) error
@@ -258,11 +1096,33 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJwks request
+	GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditEvents request
+	GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LoginWithBody request with any body
 	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// EnrollMfaChallengeWithBody request with any body
+	EnrollMfaChallengeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EnrollMfaChallenge(ctx context.Context, body EnrollMfaChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyMfaWithBody request with any body
+	VerifyMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	VerifyMfa(ctx context.Context, body VerifyMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OidcCallback request
+	OidcCallback(ctx context.Context, tenantSlug string, params *OidcCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartOidcLogin request
+	StartOidcLogin(ctx context.Context, tenantSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RefreshTokenWithBody request with any body
 	RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -281,14 +1141,120 @@ type ClientInterface interface {
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Livez request
+	Livez(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMeWithBody request with any body
+	DeleteMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeleteMe(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetMe request
 	GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchMeWithBody request with any body
+	PatchMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchMeWithApplicationMergePatchPlusJSONBody(ctx context.Context, body PatchMeApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateMeWithBody request with any body
 	UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SendDeletionCode request
+	SendDeletionCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestEmailChangeWithBody request with any body
+	RequestEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RequestEmailChange(ctx context.Context, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmEmailChangeWithBody request with any body
+	ConfirmEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportMe request
+	ExportMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmMfaWithBody request with any body
+	ConfirmMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmMfa(ctx context.Context, body ConfirmMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableMfaWithBody request with any body
+	DisableMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableMfa(ctx context.Context, body DisableMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EnrollMfa request
+	EnrollMfa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ChangePasswordWithBody request with any body
+	ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAllSessions request
+	RevokeAllSessions(ctx context.Context, params *RevokeAllSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSessions request
+	ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeSession request
+	RevokeSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPersonalAccessTokens request
+	ListPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePersonalAccessTokenWithBody request with any body
+	CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokePersonalAccessToken request
+	RevokePersonalAccessToken(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Readyz request
+	Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTenantDeletionPolicyWithBody request with any body
+	SetTenantDeletionPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTenantDeletionPolicy(ctx context.Context, body SetTenantDeletionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTenantMfaPolicyWithBody request with any body
+	SetTenantMfaPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTenantMfaPolicy(ctx context.Context, body SetTenantMfaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTenantOidcConfig request
+	DeleteTenantOidcConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenantOidcConfig request
+	GetTenantOidcConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SaveTenantOidcConfigWithBody request with any body
+	SaveTenantOidcConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SaveTenantOidcConfig(ctx context.Context, body SaveTenantOidcConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTenantPasswordPolicyWithBody request with any body
+	SetTenantPasswordPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTenantPasswordPolicy(ctx context.Context, body SetTenantPasswordPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTenantRateLimitWithBody request with any body
+	SetTenantRateLimitWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTenantRateLimit(ctx context.Context, body SetTenantRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetTenantTrashRetentionWithBody request with any body
+	SetTenantTrashRetentionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTenantTrashRetention(ctx context.Context, body SetTenantTrashRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTodos request
 	GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -300,20 +1266,45 @@ type ClientInterface interface {
 	// GetPublicTodos request
 	GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// BatchTodosWithBody request with any body
+	BatchTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchTodos(ctx context.Context, body BatchTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrash request
+	GetTrash(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeTodo request
+	PurgeTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreTodo request
+	RestoreTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteTodo request
 	DeleteTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetTodo request
-	GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetTodo(ctx context.Context, todoId string, params *GetTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchTodoWithBody request with any body
+	PatchTodoWithBody(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchTodoWithApplicationMergePatchPlusJSONBody(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateTodoWithBody request with any body
-	UpdateTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateTodoWithBody(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodo(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	UpdateTodo(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// LogoutUser request
+	LogoutUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlockUser request
+	UnlockUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJwksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -324,8 +1315,8 @@ func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.
 	return c.Client.Do(req)
 }
 
-func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewLoginRequest(c.Server, body)
+func (c *Client) GetAuditEvents(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -336,8 +1327,8 @@ func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
+func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -348,8 +1339,8 @@ func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, b
 	return c.Client.Do(req)
 }
 
-func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRefreshTokenRequest(c.Server, body)
+func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -360,8 +1351,8 @@ func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestB
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) EnrollMfaChallengeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollMfaChallengeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +1363,8 @@ func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequest(c.Server, body)
+func (c *Client) EnrollMfaChallenge(ctx context.Context, body EnrollMfaChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollMfaChallengeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -384,8 +1375,8 @@ func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
+func (c *Client) VerifyMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMfaRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +1387,8 @@ func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyEmailRequest(c.Server, body)
+func (c *Client) VerifyMfa(ctx context.Context, body VerifyMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyMfaRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +1399,8 @@ func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBod
 	return c.Client.Do(req)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) OidcCallback(ctx context.Context, tenantSlug string, params *OidcCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOidcCallbackRequest(c.Server, tenantSlug, params)
 	if err != nil {
 		return nil, err
 	}
@@ -420,8 +1411,8 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMeRequest(c.Server)
+func (c *Client) StartOidcLogin(ctx context.Context, tenantSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartOidcLoginRequest(c.Server, tenantSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +1423,8 @@ func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*htt
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMeRequestWithBody(c.Server, contentType, body)
+func (c *Client) RefreshTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -444,8 +1435,8 @@ func (c *Client) UpdateMeWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMeRequest(c.Server, body)
+func (c *Client) RefreshToken(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -456,8 +1447,8 @@ func (c *Client) UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTodosRequest(c.Server, params)
+func (c *Client) RegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +1459,8 @@ func (c *Client) GetTodos(ctx context.Context, params *GetTodosParams, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
+func (c *Client) Register(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +1471,8 @@ func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequest(c.Server, body)
+func (c *Client) VerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +1483,8 @@ func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPublicTodosRequest(c.Server, params)
+func (c *Client) VerifyEmail(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEmailRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +1495,8 @@ func (c *Client) GetPublicTodos(ctx context.Context, params *GetPublicTodosParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequest(c.Server, todoId)
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +1507,8 @@ func (c *Client) DeleteTodo(ctx context.Context, todoId string, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTodoRequest(c.Server, todoId)
+func (c *Client) Livez(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLivezRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +1519,8 @@ func (c *Client) GetTodo(ctx context.Context, todoId string, reqEditors ...Reque
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, contentType, body)
+func (c *Client) DeleteMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +1531,8 @@ func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId string, contentT
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodo(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequest(c.Server, todoId, body)
+func (c *Client) DeleteMe(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -552,1711 +1543,7791 @@ func (c *Client) UpdateTodo(ctx context.Context, todoId string, body UpdateTodoJ
 	return c.Client.Do(req)
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PatchMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PatchMeWithApplicationMergePatchPlusJSONBody(ctx context.Context, body PatchMeApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchMeRequestWithApplicationMergePatchPlusJSONBody(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
-func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateMeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
-func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMe(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/refresh")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SendDeletionCode(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSendDeletionCodeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) RequestEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRegisterRequest calls the generic Register builder with application/json body
-func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RequestEmailChange(ctx context.Context, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestEmailChangeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRegisterRequestWithBody generates requests for Register with any type of body
-func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ConfirmEmailChangeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ConfirmEmailChange(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmEmailChangeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ExportMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
-func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ConfirmMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmMfaRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
-func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ConfirmMfa(ctx context.Context, body ConfirmMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmMfaRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/verify-email")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DisableMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableMfaRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DisableMfa(ctx context.Context, body DisableMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableMfaRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) EnrollMfa(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnrollMfaRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ChangePassword(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewChangePasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetMeRequest generates requests for GetMe
-func NewGetMeRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RevokeAllSessions(ctx context.Context, params *RevokeAllSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAllSessionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RevokeSession(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeSessionRequest(c.Server, sessionId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMeRequest calls the generic UpdateMe builder with application/json body
-func NewUpdateMeRequest(server string, body UpdateMeJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPersonalAccessTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMeRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMeRequestWithBody generates requests for UpdateMe with any type of body
-func NewUpdateMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreatePersonalAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreatePersonalAccessToken(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePersonalAccessTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) RevokePersonalAccessToken(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokePersonalAccessTokenRequest(c.Server, tokenId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTodosRequest generates requests for GetTodos
-func NewGetTodosRequest(server string, params *GetTodosParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Readyz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadyzRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetTenantDeletionPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantDeletionPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) SetTenantDeletionPolicy(ctx context.Context, body SetTenantDeletionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantDeletionPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Completed != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SetTenantMfaPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantMfaPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
-}
-
-// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
-func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+	return c.Client.Do(req)
 }
 
-// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
-func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) SetTenantMfaPolicy(ctx context.Context, body SetTenantMfaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantMfaPolicyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTenantOidcConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTenantOidcConfigRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetPublicTodosRequest generates requests for GetPublicTodos
-func NewGetPublicTodosRequest(server string, params *GetPublicTodosParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTenantOidcConfig(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenantOidcConfigRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos-public")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SaveTenantOidcConfigWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTenantOidcConfigRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteTodoRequest generates requests for DeleteTodo
-func NewDeleteTodoRequest(server string, todoId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+func (c *Client) SaveTenantOidcConfig(ctx context.Context, body SaveTenantOidcConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSaveTenantOidcConfigRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetTenantPasswordPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantPasswordPolicyRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetTodoRequest generates requests for GetTodo
-func NewGetTodoRequest(server string, todoId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+func (c *Client) SetTenantPasswordPolicy(ctx context.Context, body SetTenantPasswordPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantPasswordPolicyRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetTenantRateLimitWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantRateLimitRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetTenantRateLimit(ctx context.Context, body SetTenantRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantRateLimitRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
-func NewUpdateTodoRequest(server string, todoId string, body UpdateTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) SetTenantTrashRetentionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantTrashRetentionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTodoRequestWithBody(server, todoId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
-func NewUpdateTodoRequestWithBody(server string, todoId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+func (c *Client) SetTenantTrashRetention(ctx context.Context, body SetTenantTrashRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTenantTrashRetentionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTodos(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodosRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) GetPublicTodos(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPublicTodosRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) BatchTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchTodosRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) BatchTodos(ctx context.Context, body BatchTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchTodosRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
-
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
-
-	// RefreshTokenWithBodyWithResponse request with any body
-	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
-
-	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
-
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
-
-	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
-
-	// VerifyEmailWithBodyWithResponse request with any body
-	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
-
-	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
-
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
-
-	// GetMeWithResponse request
-	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
-
-	// UpdateMeWithBodyWithResponse request with any body
-	UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
-
-	UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
-
-	// GetTodosWithResponse request
-	GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error)
-
-	// CreateTodoWithBodyWithResponse request with any body
-	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
-
-	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
-
-	// GetPublicTodosWithResponse request
-	GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error)
-
-	// DeleteTodoWithResponse request
-	DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
-
-	// GetTodoWithResponse request
-	GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
-
-	// UpdateTodoWithBodyWithResponse request with any body
-	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
-
-	UpdateTodoWithResponse(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+func (c *Client) GetTrash(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrashRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON401      *ErrorResponse
+func (c *Client) PurgeTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) RestoreTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) DeleteTodo(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequest(c.Server, todoId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type RefreshTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON401      *ErrorResponse
+func (c *Client) GetTodo(ctx context.Context, todoId string, params *GetTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoRequest(c.Server, todoId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r RefreshTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PatchTodoWithBody(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTodoRequestWithBody(c.Server, todoId, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RefreshTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) PatchTodoWithApplicationMergePatchPlusJSONBody(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody(c.Server, todoId, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type RegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AuthResponse
-	JSON400      *ErrorResponse
-	JSON409      *ErrorResponse
+func (c *Client) UpdateTodoWithBody(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, todoId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateTodo(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, todoId, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) LogoutUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutUserRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type VerifyEmailResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Message *string `json:"message,omitempty"`
+func (c *Client) UnlockUser(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlockUserRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	JSON400 *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r VerifyEmailResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetJwksRequest generates requests for GetJwks
+func NewGetJwksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VerifyEmailResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type HealthCheckResponse struct {
-	Body         []byte
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuditEventsRequest generates requests for GetAuditEvents
+func NewGetAuditEventsRequest(server string, params *GetAuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EntityType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_type", runtime.ParamLocationQuery, *params.EntityType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_id", runtime.ParamLocationQuery, *params.EntityId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollMfaChallengeRequest calls the generic EnrollMfaChallenge builder with application/json body
+func NewEnrollMfaChallengeRequest(server string, body EnrollMfaChallengeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEnrollMfaChallengeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewEnrollMfaChallengeRequestWithBody generates requests for EnrollMfaChallenge with any type of body
+func NewEnrollMfaChallengeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/mfa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyMfaRequest calls the generic VerifyMfa builder with application/json body
+func NewVerifyMfaRequest(server string, body VerifyMfaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyMfaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyMfaRequestWithBody generates requests for VerifyMfa with any type of body
+func NewVerifyMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/mfa/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewOidcCallbackRequest generates requests for OidcCallback
+func NewOidcCallbackRequest(server string, tenantSlug string, params *OidcCallbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantSlug", runtime.ParamLocationPath, tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s/callback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Code != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, *params.Code); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Error != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "error", runtime.ParamLocationQuery, *params.Error); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.OidcState != nil {
+			var cookieParam0 string
+
+			cookieParam0, err = runtime.StyleParamWithLocation("simple", true, "oidc_state", runtime.ParamLocationCookie, *params.OidcState)
+			if err != nil {
+				return nil, err
+			}
+
+			cookie0 := &http.Cookie{
+				Name:  "oidc_state",
+				Value: cookieParam0,
+			}
+			req.AddCookie(cookie0)
+		}
+	}
+	return req, nil
+}
+
+// NewStartOidcLoginRequest generates requests for StartOidcLogin
+func NewStartOidcLoginRequest(server string, tenantSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenantSlug", runtime.ParamLocationPath, tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/oidc/%s/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRefreshTokenRequest calls the generic RefreshToken builder with application/json body
+func NewRefreshTokenRequest(server string, body RefreshTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRefreshTokenRequestWithBody generates requests for RefreshToken with any type of body
+func NewRefreshTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterRequest calls the generic Register builder with application/json body
+func NewRegisterRequest(server string, body RegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterRequestWithBody generates requests for Register with any type of body
+func NewRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyEmailRequest calls the generic VerifyEmail builder with application/json body
+func NewVerifyEmailRequest(server string, body VerifyEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewVerifyEmailRequestWithBody generates requests for VerifyEmail with any type of body
+func NewVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/verify-email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLivezRequest generates requests for Livez
+func NewLivezRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/livez")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteMeRequest calls the generic DeleteMe builder with application/json body
+func NewDeleteMeRequest(server string, body DeleteMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeleteMeRequestWithBody generates requests for DeleteMe with any type of body
+func NewDeleteMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeRequest generates requests for GetMe
+func NewGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchMeRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchMe builder with application/merge-patch+json body
+func NewPatchMeRequestWithApplicationMergePatchPlusJSONBody(server string, body PatchMeApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchMeRequestWithBody(server, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchMeRequestWithBody generates requests for PatchMe with any type of body
+func NewPatchMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateMeRequest calls the generic UpdateMe builder with application/json body
+func NewUpdateMeRequest(server string, body UpdateMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateMeRequestWithBody generates requests for UpdateMe with any type of body
+func NewUpdateMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSendDeletionCodeRequest generates requests for SendDeletionCode
+func NewSendDeletionCodeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/deletion-code")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRequestEmailChangeRequest calls the generic RequestEmailChange builder with application/json body
+func NewRequestEmailChangeRequest(server string, body RequestEmailChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestEmailChangeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRequestEmailChangeRequestWithBody generates requests for RequestEmailChange with any type of body
+func NewRequestEmailChangeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmEmailChangeRequest calls the generic ConfirmEmailChange builder with application/json body
+func NewConfirmEmailChangeRequest(server string, body ConfirmEmailChangeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmEmailChangeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmEmailChangeRequestWithBody generates requests for ConfirmEmailChange with any type of body
+func NewConfirmEmailChangeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/email/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportMeRequest generates requests for ExportMe
+func NewExportMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewConfirmMfaRequest calls the generic ConfirmMfa builder with application/json body
+func NewConfirmMfaRequest(server string, body ConfirmMfaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmMfaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConfirmMfaRequestWithBody generates requests for ConfirmMfa with any type of body
+func NewConfirmMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/mfa/confirm")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDisableMfaRequest calls the generic DisableMfa builder with application/json body
+func NewDisableMfaRequest(server string, body DisableMfaJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableMfaRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDisableMfaRequestWithBody generates requests for DisableMfa with any type of body
+func NewDisableMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/mfa/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEnrollMfaRequest generates requests for EnrollMfa
+func NewEnrollMfaRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/mfa/enroll")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewChangePasswordRequest calls the generic ChangePassword builder with application/json body
+func NewChangePasswordRequest(server string, body ChangePasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewChangePasswordRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewChangePasswordRequestWithBody generates requests for ChangePassword with any type of body
+func NewChangePasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeAllSessionsRequest generates requests for RevokeAllSessions
+func NewRevokeAllSessionsRequest(server string, params *RevokeAllSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ExceptCurrent != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "except_current", runtime.ParamLocationQuery, *params.ExceptCurrent); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSessionsRequest generates requests for ListSessions
+func NewListSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeSessionRequest generates requests for RevokeSession
+func NewRevokeSessionRequest(server string, sessionId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPersonalAccessTokensRequest generates requests for ListPersonalAccessTokens
+func NewListPersonalAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePersonalAccessTokenRequest calls the generic CreatePersonalAccessToken builder with application/json body
+func NewCreatePersonalAccessTokenRequest(server string, body CreatePersonalAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePersonalAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePersonalAccessTokenRequestWithBody generates requests for CreatePersonalAccessToken with any type of body
+func NewCreatePersonalAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokePersonalAccessTokenRequest generates requests for RevokePersonalAccessToken
+func NewRevokePersonalAccessTokenRequest(server string, tokenId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tokenId", runtime.ParamLocationPath, tokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadyzRequest generates requests for Readyz
+func NewReadyzRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/readyz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetTenantDeletionPolicyRequest calls the generic SetTenantDeletionPolicy builder with application/json body
+func NewSetTenantDeletionPolicyRequest(server string, body SetTenantDeletionPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTenantDeletionPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetTenantDeletionPolicyRequestWithBody generates requests for SetTenantDeletionPolicy with any type of body
+func NewSetTenantDeletionPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/deletion-policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTenantMfaPolicyRequest calls the generic SetTenantMfaPolicy builder with application/json body
+func NewSetTenantMfaPolicyRequest(server string, body SetTenantMfaPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTenantMfaPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetTenantMfaPolicyRequestWithBody generates requests for SetTenantMfaPolicy with any type of body
+func NewSetTenantMfaPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/mfa-policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTenantOidcConfigRequest generates requests for DeleteTenantOidcConfig
+func NewDeleteTenantOidcConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/oidc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenantOidcConfigRequest generates requests for GetTenantOidcConfig
+func NewGetTenantOidcConfigRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/oidc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSaveTenantOidcConfigRequest calls the generic SaveTenantOidcConfig builder with application/json body
+func NewSaveTenantOidcConfigRequest(server string, body SaveTenantOidcConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSaveTenantOidcConfigRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSaveTenantOidcConfigRequestWithBody generates requests for SaveTenantOidcConfig with any type of body
+func NewSaveTenantOidcConfigRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/oidc")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTenantPasswordPolicyRequest calls the generic SetTenantPasswordPolicy builder with application/json body
+func NewSetTenantPasswordPolicyRequest(server string, body SetTenantPasswordPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTenantPasswordPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetTenantPasswordPolicyRequestWithBody generates requests for SetTenantPasswordPolicy with any type of body
+func NewSetTenantPasswordPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/password-policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTenantRateLimitRequest calls the generic SetTenantRateLimit builder with application/json body
+func NewSetTenantRateLimitRequest(server string, body SetTenantRateLimitJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTenantRateLimitRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetTenantRateLimitRequestWithBody generates requests for SetTenantRateLimit with any type of body
+func NewSetTenantRateLimitRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/rate-limit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSetTenantTrashRetentionRequest calls the generic SetTenantTrashRetention builder with application/json body
+func NewSetTenantTrashRetentionRequest(server string, body SetTenantTrashRetentionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTenantTrashRetentionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewSetTenantTrashRetentionRequestWithBody generates requests for SetTenantTrashRetention with any type of body
+func NewSetTenantTrashRetentionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenant/trash-retention")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTodosRequest generates requests for GetTodos
+func NewGetTodosRequest(server string, params *GetTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Completed != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "completed", runtime.ParamLocationQuery, *params.Completed); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
+func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
+func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPublicTodosRequest generates requests for GetPublicTodos
+func NewGetPublicTodosRequest(server string, params *GetPublicTodosParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos-public")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBatchTodosRequest calls the generic BatchTodos builder with application/json body
+func NewBatchTodosRequest(server string, body BatchTodosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchTodosRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchTodosRequestWithBody generates requests for BatchTodos with any type of body
+func NewBatchTodosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTrashRequest generates requests for GetTrash
+func NewGetTrashRequest(server string, params *GetTrashParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPurgeTodoRequest generates requests for PurgeTodo
+func NewPurgeTodoRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/trash/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreTodoRequest generates requests for RestoreTodo
+func NewRestoreTodoRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/trash/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteTodoRequest generates requests for DeleteTodo
+func NewDeleteTodoRequest(server string, todoId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTodoRequest generates requests for GetTodo
+func NewGetTodoRequest(server string, todoId string, params *GetTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody calls the generic PatchTodo builder with application/merge-patch+json body
+func NewPatchTodoRequestWithApplicationMergePatchPlusJSONBody(server string, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchTodoRequestWithBody(server, todoId, params, "application/merge-patch+json", bodyReader)
+}
+
+// NewPatchTodoRequestWithBody generates requests for PatchTodo with any type of body
+func NewPatchTodoRequestWithBody(server string, todoId string, params *PatchTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, todoId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, todoId string, params *UpdateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewLogoutUserRequest generates requests for LogoutUser
+func NewLogoutUserRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/logout", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnlockUserRequest generates requests for UnlockUser
+func NewUnlockUserRequest(server string, userId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/unlock", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJwksWithResponse request
+	GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error)
+
+	// GetAuditEventsWithResponse request
+	GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// EnrollMfaChallengeWithBodyWithResponse request with any body
+	EnrollMfaChallengeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollMfaChallengeResponse, error)
+
+	EnrollMfaChallengeWithResponse(ctx context.Context, body EnrollMfaChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollMfaChallengeResponse, error)
+
+	// VerifyMfaWithBodyWithResponse request with any body
+	VerifyMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMfaResponse, error)
+
+	VerifyMfaWithResponse(ctx context.Context, body VerifyMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMfaResponse, error)
+
+	// OidcCallbackWithResponse request
+	OidcCallbackWithResponse(ctx context.Context, tenantSlug string, params *OidcCallbackParams, reqEditors ...RequestEditorFn) (*OidcCallbackResponse, error)
+
+	// StartOidcLoginWithResponse request
+	StartOidcLoginWithResponse(ctx context.Context, tenantSlug string, reqEditors ...RequestEditorFn) (*StartOidcLoginResponse, error)
+
+	// RefreshTokenWithBodyWithResponse request with any body
+	RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// VerifyEmailWithBodyWithResponse request with any body
+	VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// LivezWithResponse request
+	LivezWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivezResponse, error)
+
+	// DeleteMeWithBodyWithResponse request with any body
+	DeleteMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	DeleteMeWithResponse(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error)
+
+	// GetMeWithResponse request
+	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+
+	// PatchMeWithBodyWithResponse request with any body
+	PatchMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMeResponse, error)
+
+	PatchMeWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, body PatchMeApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMeResponse, error)
+
+	// UpdateMeWithBodyWithResponse request with any body
+	UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+
+	UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error)
+
+	// SendDeletionCodeWithResponse request
+	SendDeletionCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SendDeletionCodeResponse, error)
+
+	// RequestEmailChangeWithBodyWithResponse request with any body
+	RequestEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error)
+
+	RequestEmailChangeWithResponse(ctx context.Context, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error)
+
+	// ConfirmEmailChangeWithBodyWithResponse request with any body
+	ConfirmEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error)
+
+	ConfirmEmailChangeWithResponse(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error)
+
+	// ExportMeWithResponse request
+	ExportMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportMeResponse, error)
+
+	// ConfirmMfaWithBodyWithResponse request with any body
+	ConfirmMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmMfaResponse, error)
+
+	ConfirmMfaWithResponse(ctx context.Context, body ConfirmMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmMfaResponse, error)
+
+	// DisableMfaWithBodyWithResponse request with any body
+	DisableMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableMfaResponse, error)
+
+	DisableMfaWithResponse(ctx context.Context, body DisableMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableMfaResponse, error)
+
+	// EnrollMfaWithResponse request
+	EnrollMfaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollMfaResponse, error)
+
+	// ChangePasswordWithBodyWithResponse request with any body
+	ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error)
+
+	// RevokeAllSessionsWithResponse request
+	RevokeAllSessionsWithResponse(ctx context.Context, params *RevokeAllSessionsParams, reqEditors ...RequestEditorFn) (*RevokeAllSessionsResponse, error)
+
+	// ListSessionsWithResponse request
+	ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error)
+
+	// RevokeSessionWithResponse request
+	RevokeSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error)
+
+	// ListPersonalAccessTokensWithResponse request
+	ListPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensResponse, error)
+
+	// CreatePersonalAccessTokenWithBodyWithResponse request with any body
+	CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
+	CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error)
+
+	// RevokePersonalAccessTokenWithResponse request
+	RevokePersonalAccessTokenWithResponse(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*RevokePersonalAccessTokenResponse, error)
+
+	// ReadyzWithResponse request
+	ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error)
+
+	// SetTenantDeletionPolicyWithBodyWithResponse request with any body
+	SetTenantDeletionPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantDeletionPolicyResponse, error)
+
+	SetTenantDeletionPolicyWithResponse(ctx context.Context, body SetTenantDeletionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantDeletionPolicyResponse, error)
+
+	// SetTenantMfaPolicyWithBodyWithResponse request with any body
+	SetTenantMfaPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantMfaPolicyResponse, error)
+
+	SetTenantMfaPolicyWithResponse(ctx context.Context, body SetTenantMfaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantMfaPolicyResponse, error)
+
+	// DeleteTenantOidcConfigWithResponse request
+	DeleteTenantOidcConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTenantOidcConfigResponse, error)
+
+	// GetTenantOidcConfigWithResponse request
+	GetTenantOidcConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTenantOidcConfigResponse, error)
+
+	// SaveTenantOidcConfigWithBodyWithResponse request with any body
+	SaveTenantOidcConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTenantOidcConfigResponse, error)
+
+	SaveTenantOidcConfigWithResponse(ctx context.Context, body SaveTenantOidcConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTenantOidcConfigResponse, error)
+
+	// SetTenantPasswordPolicyWithBodyWithResponse request with any body
+	SetTenantPasswordPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantPasswordPolicyResponse, error)
+
+	SetTenantPasswordPolicyWithResponse(ctx context.Context, body SetTenantPasswordPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantPasswordPolicyResponse, error)
+
+	// SetTenantRateLimitWithBodyWithResponse request with any body
+	SetTenantRateLimitWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantRateLimitResponse, error)
+
+	SetTenantRateLimitWithResponse(ctx context.Context, body SetTenantRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantRateLimitResponse, error)
+
+	// SetTenantTrashRetentionWithBodyWithResponse request with any body
+	SetTenantTrashRetentionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantTrashRetentionResponse, error)
+
+	SetTenantTrashRetentionWithResponse(ctx context.Context, body SetTenantTrashRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantTrashRetentionResponse, error)
+
+	// GetTodosWithResponse request
+	GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error)
+
+	// CreateTodoWithBodyWithResponse request with any body
+	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	// GetPublicTodosWithResponse request
+	GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error)
+
+	// BatchTodosWithBodyWithResponse request with any body
+	BatchTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchTodosResponse, error)
+
+	BatchTodosWithResponse(ctx context.Context, body BatchTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchTodosResponse, error)
+
+	// GetTrashWithResponse request
+	GetTrashWithResponse(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*GetTrashResponse, error)
+
+	// PurgeTodoWithResponse request
+	PurgeTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*PurgeTodoResponse, error)
+
+	// RestoreTodoWithResponse request
+	RestoreTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error)
+
+	// DeleteTodoWithResponse request
+	DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	// GetTodoWithResponse request
+	GetTodoWithResponse(ctx context.Context, todoId string, params *GetTodoParams, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
+
+	// PatchTodoWithBodyWithResponse request with any body
+	PatchTodoWithBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error)
+
+	PatchTodoWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// LogoutUserWithResponse request
+	LogoutUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error)
+
+	// UnlockUserWithResponse request
+	UnlockUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UnlockUserResponse, error)
+}
+
+type GetJwksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKS
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJwksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJwksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditEventsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuditEventListResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON423 *Problem
+	ApplicationproblemJSON429 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollMfaChallengeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MFAEnrollmentResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollMfaChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollMfaChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyMfaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON423 *Problem
+	ApplicationproblemJSON429 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OidcCallbackResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r OidcCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OidcCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartOidcLoginResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON502 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r StartOidcLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartOidcLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AuthResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *AuthResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Message *string `json:"message,omitempty"`
+	}
+	ApplicationproblemJSON400 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LivezResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Liveness
+}
+
+// Status returns HTTPResponse.Status
+func (r LivezResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LivezResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON415 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SendDeletionCodeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SendDeletionCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SendDeletionCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestEmailChangeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *UserResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestEmailChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestEmailChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmEmailChangeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmEmailChangeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmEmailChangeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportMeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *AccountExportResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmMfaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MFARecoveryCodesResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableMfaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnrollMfaResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *MFAEnrollmentResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r EnrollMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnrollMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ChangePasswordResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ChangePasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ChangePasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeAllSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RevokedSessionsResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAllSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAllSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SessionListResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeSessionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPersonalAccessTokensResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *PersonalAccessTokenListResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPersonalAccessTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPersonalAccessTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreatedPersonalAccessTokenResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON409 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokePersonalAccessTokenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokePersonalAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokePersonalAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadyzResponse struct {
+	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status *string `json:"status,omitempty"`
+	JSON200      *Readiness
+	JSON503      *Readiness
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadyzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadyzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTenantDeletionPolicyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTenantDeletionPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTenantDeletionPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTenantMfaPolicyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTenantMfaPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTenantMfaPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTenantOidcConfigResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTenantOidcConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTenantOidcConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenantOidcConfigResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OIDCConfigResponse
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenantOidcConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenantOidcConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SaveTenantOidcConfigResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *OIDCConfigResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SaveTenantOidcConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SaveTenantOidcConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTenantPasswordPolicyResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTenantPasswordPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTenantPasswordPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTenantRateLimitResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTenantRateLimitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTenantRateLimitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTenantTrashRetentionResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTenantTrashRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTenantTrashRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodosResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoListResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *TodoResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPublicTodosResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoListResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPublicTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPublicTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchTodosResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoBatchResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrashResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoListResponse
+	ApplicationproblemJSON401 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON412 *Problem
+	ApplicationproblemJSON415 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *TodoResponse
+	ApplicationproblemJSON400 *Problem
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON404 *Problem
+	ApplicationproblemJSON409 *Problem
+	ApplicationproblemJSON412 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *RevokedSessionsResponse
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlockUserResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON401 *Problem
+	ApplicationproblemJSON403 *Problem
+	ApplicationproblemJSON404 *Problem
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlockUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlockUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJwksWithResponse request returning *GetJwksResponse
+func (c *ClientWithResponses) GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error) {
+	rsp, err := c.GetJwks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJwksResponse(rsp)
+}
+
+// GetAuditEventsWithResponse request returning *GetAuditEventsResponse
+func (c *ClientWithResponses) GetAuditEventsWithResponse(ctx context.Context, params *GetAuditEventsParams, reqEditors ...RequestEditorFn) (*GetAuditEventsResponse, error) {
+	rsp, err := c.GetAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditEventsResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// EnrollMfaChallengeWithBodyWithResponse request with arbitrary body returning *EnrollMfaChallengeResponse
+func (c *ClientWithResponses) EnrollMfaChallengeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EnrollMfaChallengeResponse, error) {
+	rsp, err := c.EnrollMfaChallengeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollMfaChallengeResponse(rsp)
+}
+
+func (c *ClientWithResponses) EnrollMfaChallengeWithResponse(ctx context.Context, body EnrollMfaChallengeJSONRequestBody, reqEditors ...RequestEditorFn) (*EnrollMfaChallengeResponse, error) {
+	rsp, err := c.EnrollMfaChallenge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollMfaChallengeResponse(rsp)
+}
+
+// VerifyMfaWithBodyWithResponse request with arbitrary body returning *VerifyMfaResponse
+func (c *ClientWithResponses) VerifyMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyMfaResponse, error) {
+	rsp, err := c.VerifyMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyMfaWithResponse(ctx context.Context, body VerifyMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyMfaResponse, error) {
+	rsp, err := c.VerifyMfa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyMfaResponse(rsp)
+}
+
+// OidcCallbackWithResponse request returning *OidcCallbackResponse
+func (c *ClientWithResponses) OidcCallbackWithResponse(ctx context.Context, tenantSlug string, params *OidcCallbackParams, reqEditors ...RequestEditorFn) (*OidcCallbackResponse, error) {
+	rsp, err := c.OidcCallback(ctx, tenantSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOidcCallbackResponse(rsp)
+}
+
+// StartOidcLoginWithResponse request returning *StartOidcLoginResponse
+func (c *ClientWithResponses) StartOidcLoginWithResponse(ctx context.Context, tenantSlug string, reqEditors ...RequestEditorFn) (*StartOidcLoginResponse, error) {
+	rsp, err := c.StartOidcLogin(ctx, tenantSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartOidcLoginResponse(rsp)
+}
+
+// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
+func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
+	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshTokenResponse(rsp)
+}
+
+// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
+func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.Register(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
+func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
+	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEmailResponse(rsp)
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// LivezWithResponse request returning *LivezResponse
+func (c *ClientWithResponses) LivezWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LivezResponse, error) {
+	rsp, err := c.Livez(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLivezResponse(rsp)
+}
+
+// DeleteMeWithBodyWithResponse request with arbitrary body returning *DeleteMeResponse
+func (c *ClientWithResponses) DeleteMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteMeWithResponse(ctx context.Context, body DeleteMeJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMeResponse, error) {
+	rsp, err := c.DeleteMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMeResponse(rsp)
+}
+
+// GetMeWithResponse request returning *GetMeResponse
+func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
+	rsp, err := c.GetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeResponse(rsp)
+}
+
+// PatchMeWithBodyWithResponse request with arbitrary body returning *PatchMeResponse
+func (c *ClientWithResponses) PatchMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchMeResponse, error) {
+	rsp, err := c.PatchMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchMeWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, body PatchMeApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchMeResponse, error) {
+	rsp, err := c.PatchMeWithApplicationMergePatchPlusJSONBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchMeResponse(rsp)
+}
+
+// UpdateMeWithBodyWithResponse request with arbitrary body returning *UpdateMeResponse
+func (c *ClientWithResponses) UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
+	rsp, err := c.UpdateMeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
+	rsp, err := c.UpdateMe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMeResponse(rsp)
+}
+
+// SendDeletionCodeWithResponse request returning *SendDeletionCodeResponse
+func (c *ClientWithResponses) SendDeletionCodeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SendDeletionCodeResponse, error) {
+	rsp, err := c.SendDeletionCode(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSendDeletionCodeResponse(rsp)
+}
+
+// RequestEmailChangeWithBodyWithResponse request with arbitrary body returning *RequestEmailChangeResponse
+func (c *ClientWithResponses) RequestEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error) {
+	rsp, err := c.RequestEmailChangeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestEmailChangeWithResponse(ctx context.Context, body RequestEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestEmailChangeResponse, error) {
+	rsp, err := c.RequestEmailChange(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestEmailChangeResponse(rsp)
+}
+
+// ConfirmEmailChangeWithBodyWithResponse request with arbitrary body returning *ConfirmEmailChangeResponse
+func (c *ClientWithResponses) ConfirmEmailChangeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error) {
+	rsp, err := c.ConfirmEmailChangeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmEmailChangeWithResponse(ctx context.Context, body ConfirmEmailChangeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmEmailChangeResponse, error) {
+	rsp, err := c.ConfirmEmailChange(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmEmailChangeResponse(rsp)
+}
+
+// ExportMeWithResponse request returning *ExportMeResponse
+func (c *ClientWithResponses) ExportMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportMeResponse, error) {
+	rsp, err := c.ExportMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportMeResponse(rsp)
+}
+
+// ConfirmMfaWithBodyWithResponse request with arbitrary body returning *ConfirmMfaResponse
+func (c *ClientWithResponses) ConfirmMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmMfaResponse, error) {
+	rsp, err := c.ConfirmMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmMfaWithResponse(ctx context.Context, body ConfirmMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmMfaResponse, error) {
+	rsp, err := c.ConfirmMfa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmMfaResponse(rsp)
+}
+
+// DisableMfaWithBodyWithResponse request with arbitrary body returning *DisableMfaResponse
+func (c *ClientWithResponses) DisableMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableMfaResponse, error) {
+	rsp, err := c.DisableMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableMfaWithResponse(ctx context.Context, body DisableMfaJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableMfaResponse, error) {
+	rsp, err := c.DisableMfa(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableMfaResponse(rsp)
+}
+
+// EnrollMfaWithResponse request returning *EnrollMfaResponse
+func (c *ClientWithResponses) EnrollMfaWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*EnrollMfaResponse, error) {
+	rsp, err := c.EnrollMfa(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnrollMfaResponse(rsp)
+}
+
+// ChangePasswordWithBodyWithResponse request with arbitrary body returning *ChangePasswordResponse
+func (c *ClientWithResponses) ChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) ChangePasswordWithResponse(ctx context.Context, body ChangePasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*ChangePasswordResponse, error) {
+	rsp, err := c.ChangePassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseChangePasswordResponse(rsp)
+}
+
+// RevokeAllSessionsWithResponse request returning *RevokeAllSessionsResponse
+func (c *ClientWithResponses) RevokeAllSessionsWithResponse(ctx context.Context, params *RevokeAllSessionsParams, reqEditors ...RequestEditorFn) (*RevokeAllSessionsResponse, error) {
+	rsp, err := c.RevokeAllSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAllSessionsResponse(rsp)
+}
+
+// ListSessionsWithResponse request returning *ListSessionsResponse
+func (c *ClientWithResponses) ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error) {
+	rsp, err := c.ListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSessionsResponse(rsp)
+}
+
+// RevokeSessionWithResponse request returning *RevokeSessionResponse
+func (c *ClientWithResponses) RevokeSessionWithResponse(ctx context.Context, sessionId string, reqEditors ...RequestEditorFn) (*RevokeSessionResponse, error) {
+	rsp, err := c.RevokeSession(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeSessionResponse(rsp)
+}
+
+// ListPersonalAccessTokensWithResponse request returning *ListPersonalAccessTokensResponse
+func (c *ClientWithResponses) ListPersonalAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPersonalAccessTokensResponse, error) {
+	rsp, err := c.ListPersonalAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPersonalAccessTokensResponse(rsp)
+}
+
+// CreatePersonalAccessTokenWithBodyWithResponse request with arbitrary body returning *CreatePersonalAccessTokenResponse
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePersonalAccessTokenWithResponse(ctx context.Context, body CreatePersonalAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePersonalAccessTokenResponse, error) {
+	rsp, err := c.CreatePersonalAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePersonalAccessTokenResponse(rsp)
+}
+
+// RevokePersonalAccessTokenWithResponse request returning *RevokePersonalAccessTokenResponse
+func (c *ClientWithResponses) RevokePersonalAccessTokenWithResponse(ctx context.Context, tokenId string, reqEditors ...RequestEditorFn) (*RevokePersonalAccessTokenResponse, error) {
+	rsp, err := c.RevokePersonalAccessToken(ctx, tokenId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokePersonalAccessTokenResponse(rsp)
+}
+
+// ReadyzWithResponse request returning *ReadyzResponse
+func (c *ClientWithResponses) ReadyzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadyzResponse, error) {
+	rsp, err := c.Readyz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadyzResponse(rsp)
+}
+
+// SetTenantDeletionPolicyWithBodyWithResponse request with arbitrary body returning *SetTenantDeletionPolicyResponse
+func (c *ClientWithResponses) SetTenantDeletionPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantDeletionPolicyResponse, error) {
+	rsp, err := c.SetTenantDeletionPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantDeletionPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTenantDeletionPolicyWithResponse(ctx context.Context, body SetTenantDeletionPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantDeletionPolicyResponse, error) {
+	rsp, err := c.SetTenantDeletionPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantDeletionPolicyResponse(rsp)
+}
+
+// SetTenantMfaPolicyWithBodyWithResponse request with arbitrary body returning *SetTenantMfaPolicyResponse
+func (c *ClientWithResponses) SetTenantMfaPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantMfaPolicyResponse, error) {
+	rsp, err := c.SetTenantMfaPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantMfaPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTenantMfaPolicyWithResponse(ctx context.Context, body SetTenantMfaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantMfaPolicyResponse, error) {
+	rsp, err := c.SetTenantMfaPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantMfaPolicyResponse(rsp)
+}
+
+// DeleteTenantOidcConfigWithResponse request returning *DeleteTenantOidcConfigResponse
+func (c *ClientWithResponses) DeleteTenantOidcConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteTenantOidcConfigResponse, error) {
+	rsp, err := c.DeleteTenantOidcConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTenantOidcConfigResponse(rsp)
+}
+
+// GetTenantOidcConfigWithResponse request returning *GetTenantOidcConfigResponse
+func (c *ClientWithResponses) GetTenantOidcConfigWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTenantOidcConfigResponse, error) {
+	rsp, err := c.GetTenantOidcConfig(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenantOidcConfigResponse(rsp)
+}
+
+// SaveTenantOidcConfigWithBodyWithResponse request with arbitrary body returning *SaveTenantOidcConfigResponse
+func (c *ClientWithResponses) SaveTenantOidcConfigWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SaveTenantOidcConfigResponse, error) {
+	rsp, err := c.SaveTenantOidcConfigWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTenantOidcConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) SaveTenantOidcConfigWithResponse(ctx context.Context, body SaveTenantOidcConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*SaveTenantOidcConfigResponse, error) {
+	rsp, err := c.SaveTenantOidcConfig(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSaveTenantOidcConfigResponse(rsp)
+}
+
+// SetTenantPasswordPolicyWithBodyWithResponse request with arbitrary body returning *SetTenantPasswordPolicyResponse
+func (c *ClientWithResponses) SetTenantPasswordPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantPasswordPolicyResponse, error) {
+	rsp, err := c.SetTenantPasswordPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantPasswordPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTenantPasswordPolicyWithResponse(ctx context.Context, body SetTenantPasswordPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantPasswordPolicyResponse, error) {
+	rsp, err := c.SetTenantPasswordPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantPasswordPolicyResponse(rsp)
+}
+
+// SetTenantRateLimitWithBodyWithResponse request with arbitrary body returning *SetTenantRateLimitResponse
+func (c *ClientWithResponses) SetTenantRateLimitWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantRateLimitResponse, error) {
+	rsp, err := c.SetTenantRateLimitWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantRateLimitResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTenantRateLimitWithResponse(ctx context.Context, body SetTenantRateLimitJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantRateLimitResponse, error) {
+	rsp, err := c.SetTenantRateLimit(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantRateLimitResponse(rsp)
+}
+
+// SetTenantTrashRetentionWithBodyWithResponse request with arbitrary body returning *SetTenantTrashRetentionResponse
+func (c *ClientWithResponses) SetTenantTrashRetentionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTenantTrashRetentionResponse, error) {
+	rsp, err := c.SetTenantTrashRetentionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantTrashRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTenantTrashRetentionWithResponse(ctx context.Context, body SetTenantTrashRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTenantTrashRetentionResponse, error) {
+	rsp, err := c.SetTenantTrashRetention(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTenantTrashRetentionResponse(rsp)
+}
+
+// GetTodosWithResponse request returning *GetTodosResponse
+func (c *ClientWithResponses) GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error) {
+	rsp, err := c.GetTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodosResponse(rsp)
+}
+
+// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
+func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+// GetPublicTodosWithResponse request returning *GetPublicTodosResponse
+func (c *ClientWithResponses) GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error) {
+	rsp, err := c.GetPublicTodos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPublicTodosResponse(rsp)
+}
+
+// BatchTodosWithBodyWithResponse request with arbitrary body returning *BatchTodosResponse
+func (c *ClientWithResponses) BatchTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchTodosResponse, error) {
+	rsp, err := c.BatchTodosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchTodosResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchTodosWithResponse(ctx context.Context, body BatchTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchTodosResponse, error) {
+	rsp, err := c.BatchTodos(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchTodosResponse(rsp)
+}
+
+// GetTrashWithResponse request returning *GetTrashResponse
+func (c *ClientWithResponses) GetTrashWithResponse(ctx context.Context, params *GetTrashParams, reqEditors ...RequestEditorFn) (*GetTrashResponse, error) {
+	rsp, err := c.GetTrash(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrashResponse(rsp)
+}
+
+// PurgeTodoWithResponse request returning *PurgeTodoResponse
+func (c *ClientWithResponses) PurgeTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*PurgeTodoResponse, error) {
+	rsp, err := c.PurgeTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeTodoResponse(rsp)
+}
+
+// RestoreTodoWithResponse request returning *RestoreTodoResponse
+func (c *ClientWithResponses) RestoreTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*RestoreTodoResponse, error) {
+	rsp, err := c.RestoreTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreTodoResponse(rsp)
+}
+
+// DeleteTodoWithResponse request returning *DeleteTodoResponse
+func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodo(ctx, todoId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+// GetTodoWithResponse request returning *GetTodoResponse
+func (c *ClientWithResponses) GetTodoWithResponse(ctx context.Context, todoId string, params *GetTodoParams, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
+	rsp, err := c.GetTodo(ctx, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoResponse(rsp)
+}
+
+// PatchTodoWithBodyWithResponse request with arbitrary body returning *PatchTodoResponse
+func (c *ClientWithResponses) PatchTodoWithBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error) {
+	rsp, err := c.PatchTodoWithBody(ctx, todoId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchTodoWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, todoId string, params *PatchTodoParams, body PatchTodoApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchTodoResponse, error) {
+	rsp, err := c.PatchTodoWithApplicationMergePatchPlusJSONBody(ctx, todoId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchTodoResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, todoId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId string, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, todoId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// LogoutUserWithResponse request returning *LogoutUserResponse
+func (c *ClientWithResponses) LogoutUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*LogoutUserResponse, error) {
+	rsp, err := c.LogoutUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutUserResponse(rsp)
+}
+
+// UnlockUserWithResponse request returning *UnlockUserResponse
+func (c *ClientWithResponses) UnlockUserWithResponse(ctx context.Context, userId string, reqEditors ...RequestEditorFn) (*UnlockUserResponse, error) {
+	rsp, err := c.UnlockUser(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlockUserResponse(rsp)
+}
+
+// ParseGetJwksResponse parses an HTTP response from a GetJwksWithResponse call
+func ParseGetJwksResponse(rsp *http.Response) (*GetJwksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJwksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKS
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditEventsResponse parses an HTTP response from a GetAuditEventsWithResponse call
+func ParseGetAuditEventsResponse(rsp *http.Response) (*GetAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollMfaChallengeResponse parses an HTTP response from a EnrollMfaChallengeWithResponse call
+func ParseEnrollMfaChallengeResponse(rsp *http.Response) (*EnrollMfaChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollMfaChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFAEnrollmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyMfaResponse parses an HTTP response from a VerifyMfaWithResponse call
+func ParseVerifyMfaResponse(rsp *http.Response) (*VerifyMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 423:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON423 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOidcCallbackResponse parses an HTTP response from a OidcCallbackWithResponse call
+func ParseOidcCallbackResponse(rsp *http.Response) (*OidcCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OidcCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartOidcLoginResponse parses an HTTP response from a StartOidcLoginWithResponse call
+func ParseStartOidcLoginResponse(rsp *http.Response) (*StartOidcLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartOidcLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
+func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
+func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Message *string `json:"message,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLivezResponse parses an HTTP response from a LivezWithResponse call
+func ParseLivezResponse(rsp *http.Response) (*LivezResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LivezResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Liveness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMeResponse parses an HTTP response from a DeleteMeWithResponse call
+func ParseDeleteMeResponse(rsp *http.Response) (*DeleteMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchMeResponse parses an HTTP response from a PatchMeWithResponse call
+func ParsePatchMeResponse(rsp *http.Response) (*PatchMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMeResponse parses an HTTP response from a UpdateMeWithResponse call
+func ParseUpdateMeResponse(rsp *http.Response) (*UpdateMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSendDeletionCodeResponse parses an HTTP response from a SendDeletionCodeWithResponse call
+func ParseSendDeletionCodeResponse(rsp *http.Response) (*SendDeletionCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SendDeletionCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRequestEmailChangeResponse parses an HTTP response from a RequestEmailChangeWithResponse call
+func ParseRequestEmailChangeResponse(rsp *http.Response) (*RequestEmailChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RequestEmailChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmEmailChangeResponse parses an HTTP response from a ConfirmEmailChangeWithResponse call
+func ParseConfirmEmailChangeResponse(rsp *http.Response) (*ConfirmEmailChangeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmEmailChangeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportMeResponse parses an HTTP response from a ExportMeWithResponse call
+func ParseExportMeResponse(rsp *http.Response) (*ExportMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmMfaResponse parses an HTTP response from a ConfirmMfaWithResponse call
+func ParseConfirmMfaResponse(rsp *http.Response) (*ConfirmMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFARecoveryCodesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDisableMfaResponse parses an HTTP response from a DisableMfaWithResponse call
+func ParseDisableMfaResponse(rsp *http.Response) (*DisableMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DisableMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnrollMfaResponse parses an HTTP response from a EnrollMfaWithResponse call
+func ParseEnrollMfaResponse(rsp *http.Response) (*EnrollMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnrollMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MFAEnrollmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseChangePasswordResponse parses an HTTP response from a ChangePasswordWithResponse call
+func ParseChangePasswordResponse(rsp *http.Response) (*ChangePasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ChangePasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeAllSessionsResponse parses an HTTP response from a RevokeAllSessionsWithResponse call
+func ParseRevokeAllSessionsResponse(rsp *http.Response) (*RevokeAllSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeAllSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RevokedSessionsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSessionsResponse parses an HTTP response from a ListSessionsWithResponse call
+func ParseListSessionsResponse(rsp *http.Response) (*ListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeSessionResponse parses an HTTP response from a RevokeSessionWithResponse call
+func ParseRevokeSessionResponse(rsp *http.Response) (*RevokeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPersonalAccessTokensResponse parses an HTTP response from a ListPersonalAccessTokensWithResponse call
+func ParseListPersonalAccessTokensResponse(rsp *http.Response) (*ListPersonalAccessTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPersonalAccessTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PersonalAccessTokenListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePersonalAccessTokenResponse parses an HTTP response from a CreatePersonalAccessTokenWithResponse call
+func ParseCreatePersonalAccessTokenResponse(rsp *http.Response) (*CreatePersonalAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePersonalAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedPersonalAccessTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
 	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRevokePersonalAccessTokenResponse parses an HTTP response from a RevokePersonalAccessTokenWithResponse call
+func ParseRevokePersonalAccessTokenResponse(rsp *http.Response) (*RevokePersonalAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &RevokePersonalAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseReadyzResponse parses an HTTP response from a ReadyzWithResponse call
+func ParseReadyzResponse(rsp *http.Response) (*ReadyzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &ReadyzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Readiness
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
 }
 
-type GetMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON401      *ErrorResponse
+// ParseSetTenantDeletionPolicyResponse parses an HTTP response from a SetTenantDeletionPolicyWithResponse call
+func ParseSetTenantDeletionPolicyResponse(rsp *http.Response) (*SetTenantDeletionPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTenantDeletionPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetTenantMfaPolicyResponse parses an HTTP response from a SetTenantMfaPolicyWithResponse call
+func ParseSetTenantMfaPolicyResponse(rsp *http.Response) (*SetTenantMfaPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &SetTenantMfaPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteTenantOidcConfigResponse parses an HTTP response from a DeleteTenantOidcConfigWithResponse call
+func ParseDeleteTenantOidcConfigResponse(rsp *http.Response) (*DeleteTenantOidcConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTenantOidcConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenantOidcConfigResponse parses an HTTP response from a GetTenantOidcConfigWithResponse call
+func ParseGetTenantOidcConfigResponse(rsp *http.Response) (*GetTenantOidcConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenantOidcConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCConfigResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSaveTenantOidcConfigResponse parses an HTTP response from a SaveTenantOidcConfigWithResponse call
+func ParseSaveTenantOidcConfigResponse(rsp *http.Response) (*SaveTenantOidcConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SaveTenantOidcConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OIDCConfigResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTenantPasswordPolicyResponse parses an HTTP response from a SetTenantPasswordPolicyWithResponse call
+func ParseSetTenantPasswordPolicyResponse(rsp *http.Response) (*SetTenantPasswordPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTenantPasswordPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetTenantRateLimitResponse parses an HTTP response from a SetTenantRateLimitWithResponse call
+func ParseSetTenantRateLimitResponse(rsp *http.Response) (*SetTenantRateLimitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetTenantRateLimitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
 	}
-	return 0
-}
 
-type UpdateMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserResponse
-	JSON401      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetTenantTrashRetentionResponse parses an HTTP response from a SetTenantTrashRetentionWithResponse call
+func ParseSetTenantTrashRetentionResponse(rsp *http.Response) (*SetTenantTrashRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetTenantTrashRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoListResponse
-	JSON401      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type CreateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *TodoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetTodosResponse parses an HTTP response from a GetTodosWithResponse call
+func ParseGetTodosResponse(rsp *http.Response) (*GetTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetPublicTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoListResponse
-	JSON401      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetPublicTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPublicTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeleteTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
+func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CreateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoResponse
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type UpdateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON404      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetPublicTodosResponse parses an HTTP response from a GetPublicTodosWithResponse call
+func ParseGetPublicTodosResponse(rsp *http.Response) (*GetPublicTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetPublicTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
 	}
-	return ParseLoginResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
+// ParseBatchTodosResponse parses an HTTP response from a BatchTodosWithResponse call
+func ParseBatchTodosResponse(rsp *http.Response) (*BatchTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
-}
 
-// RefreshTokenWithBodyWithResponse request with arbitrary body returning *RefreshTokenResponse
-func (c *ClientWithResponses) RefreshTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
-	rsp, err := c.RefreshTokenWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &BatchTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRefreshTokenResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoBatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RefreshTokenWithResponse(ctx context.Context, body RefreshTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshTokenResponse, error) {
-	rsp, err := c.RefreshToken(ctx, body, reqEditors...)
+// ParseGetTrashResponse parses an HTTP response from a GetTrashWithResponse call
+func ParseGetTrashResponse(rsp *http.Response) (*GetTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRefreshTokenResponse(rsp)
-}
 
-// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
-func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRegisterResponse(rsp)
-}
 
-func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.Register(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
 	}
-	return ParseRegisterResponse(rsp)
+
+	return response, nil
 }
 
-// VerifyEmailWithBodyWithResponse request with arbitrary body returning *VerifyEmailResponse
-func (c *ClientWithResponses) VerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
-	rsp, err := c.VerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+// ParsePurgeTodoResponse parses an HTTP response from a PurgeTodoWithResponse call
+func ParsePurgeTodoResponse(rsp *http.Response) (*PurgeTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseVerifyEmailResponse(rsp)
-}
 
-func (c *ClientWithResponses) VerifyEmailWithResponse(ctx context.Context, body VerifyEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyEmailResponse, error) {
-	rsp, err := c.VerifyEmail(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PurgeTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseVerifyEmailResponse(rsp)
-}
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	}
-	return ParseHealthCheckResponse(rsp)
+
+	return response, nil
 }
 
-// GetMeWithResponse request returning *GetMeResponse
-func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
-	rsp, err := c.GetMe(ctx, reqEditors...)
+// ParseRestoreTodoResponse parses an HTTP response from a RestoreTodoWithResponse call
+func ParseRestoreTodoResponse(rsp *http.Response) (*RestoreTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetMeResponse(rsp)
-}
 
-// UpdateMeWithBodyWithResponse request with arbitrary body returning *UpdateMeResponse
-func (c *ClientWithResponses) UpdateMeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
-	rsp, err := c.UpdateMeWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &RestoreTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateMeResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateMeWithResponse(ctx context.Context, body UpdateMeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMeResponse, error) {
-	rsp, err := c.UpdateMe(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	}
-	return ParseUpdateMeResponse(rsp)
+
+	return response, nil
 }
 
-// GetTodosWithResponse request returning *GetTodosResponse
-func (c *ClientWithResponses) GetTodosWithResponse(ctx context.Context, params *GetTodosParams, reqEditors ...RequestEditorFn) (*GetTodosResponse, error) {
-	rsp, err := c.GetTodos(ctx, params, reqEditors...)
+// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
+func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTodosResponse(rsp)
-}
 
-// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
-func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	return response, nil
 }
 
-// GetPublicTodosWithResponse request returning *GetPublicTodosResponse
-func (c *ClientWithResponses) GetPublicTodosWithResponse(ctx context.Context, params *GetPublicTodosParams, reqEditors ...RequestEditorFn) (*GetPublicTodosResponse, error) {
-	rsp, err := c.GetPublicTodos(ctx, params, reqEditors...)
+// ParseGetTodoResponse parses an HTTP response from a GetTodoWithResponse call
+func ParseGetTodoResponse(rsp *http.Response) (*GetTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPublicTodosResponse(rsp)
-}
 
-// DeleteTodoWithResponse request returning *DeleteTodoResponse
-func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodo(ctx, todoId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteTodoResponse(rsp)
-}
 
-// GetTodoWithResponse request returning *GetTodoResponse
-func (c *ClientWithResponses) GetTodoWithResponse(ctx context.Context, todoId string, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
-	rsp, err := c.GetTodo(ctx, todoId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
 	}
-	return ParseGetTodoResponse(rsp)
+
+	return response, nil
 }
 
-// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
-func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, todoId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodoWithBody(ctx, todoId, contentType, body, reqEditors...)
+// ParsePatchTodoResponse parses an HTTP response from a PatchTodoWithResponse call
+func ParsePatchTodoResponse(rsp *http.Response) (*PatchTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, todoId string, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodo(ctx, todoId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PatchTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateTodoResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResponse{
+	response := &UpdateTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
+		var dest TodoResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.ApplicationproblemJSON412 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRefreshTokenResponse parses an HTTP response from a RefreshTokenWithResponse call
-func ParseRefreshTokenResponse(rsp *http.Response) (*RefreshTokenResponse, error) {
+// ParseLogoutUserResponse parses an HTTP response from a LogoutUserWithResponse call
+func ParseLogoutUserResponse(rsp *http.Response) (*LogoutUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RefreshTokenResponse{
+	response := &LogoutUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
+		var dest RevokedSessionsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+// ParseUnlockUserResponse parses an HTTP response from a UnlockUserWithResponse call
+func ParseUnlockUserResponse(rsp *http.Response) (*UnlockUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RegisterResponse{
+	response := &UnlockUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AuthResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.ApplicationproblemJSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Problem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.ApplicationproblemJSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseVerifyEmailResponse parses an HTTP response from a VerifyEmailWithResponse call
-func ParseVerifyEmailResponse(rsp *http.Response) (*VerifyEmailResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Keys that access tokens are signed with
+	// (GET /.well-known/jwks.json)
+	GetJwks(ctx echo.Context) error
+	// Get audit events in the current tenant (tenant admins only)
+	// (GET /audit-events)
+	GetAuditEvents(ctx echo.Context, params GetAuditEventsParams) error
+	// Login with email and password
+	// (POST /auth/login)
+	Login(ctx echo.Context) error
+	// Enroll in two-factor authentication during a login the tenant requires it for
+	// (POST /auth/mfa/enroll)
+	EnrollMfaChallenge(ctx echo.Context) error
+	// Complete a login with a TOTP or recovery code
+	// (POST /auth/mfa/verify)
+	VerifyMfa(ctx echo.Context) error
+	// Complete single sign-on
+	// (GET /auth/oidc/{tenantSlug}/callback)
+	OidcCallback(ctx echo.Context, tenantSlug string, params OidcCallbackParams) error
+	// Start single sign-on with the tenant's OpenID Connect provider
+	// (GET /auth/oidc/{tenantSlug}/start)
+	StartOidcLogin(ctx echo.Context, tenantSlug string) error
+	// Refresh access token
+	// (POST /auth/refresh)
+	RefreshToken(ctx echo.Context) error
+	// Register a new user
+	// (POST /auth/register)
+	Register(ctx echo.Context) error
+	// Verify email with token
+	// (POST /auth/verify-email)
+	VerifyEmail(ctx echo.Context) error
+	// Health check
+	// (GET /health)
+	HealthCheck(ctx echo.Context) error
+	// Liveness probe
+	// (GET /livez)
+	Livez(ctx echo.Context) error
+	// Delete the current user's account
+	// (DELETE /me)
+	DeleteMe(ctx echo.Context) error
+	// Get current user info
+	// (GET /me)
+	GetMe(ctx echo.Context) error
+	// Partially update current user info
+	// (PATCH /me)
+	PatchMe(ctx echo.Context) error
+	// Update current user info
+	// (PUT /me)
+	UpdateMe(ctx echo.Context) error
+	// Mail a code that confirms deleting the account
+	// (POST /me/deletion-code)
+	SendDeletionCode(ctx echo.Context) error
+	// Request an email change
+	// (POST /me/email)
+	RequestEmailChange(ctx echo.Context) error
+	// Confirm an email change
+	// (POST /me/email/confirm)
+	ConfirmEmailChange(ctx echo.Context) error
+	// Export the current user's data
+	// (GET /me/export)
+	ExportMe(ctx echo.Context) error
+	// Enable two-factor authentication with a first code
+	// (POST /me/mfa/confirm)
+	ConfirmMfa(ctx echo.Context) error
+	// Disable two-factor authentication
+	// (POST /me/mfa/disable)
+	DisableMfa(ctx echo.Context) error
+	// Start two-factor enrollment with a new TOTP secret
+	// (POST /me/mfa/enroll)
+	EnrollMfa(ctx echo.Context) error
+	// Change the password
+	// (POST /me/password)
+	ChangePassword(ctx echo.Context) error
+	// Log out everywhere
+	// (DELETE /me/sessions)
+	RevokeAllSessions(ctx echo.Context, params RevokeAllSessionsParams) error
+	// List sessions
+	// (GET /me/sessions)
+	ListSessions(ctx echo.Context) error
+	// Revoke a session
+	// (DELETE /me/sessions/{sessionId})
+	RevokeSession(ctx echo.Context, sessionId string) error
+	// List personal access tokens
+	// (GET /me/tokens)
+	ListPersonalAccessTokens(ctx echo.Context) error
+	// Create a personal access token
+	// (POST /me/tokens)
+	CreatePersonalAccessToken(ctx echo.Context) error
+	// Revoke a personal access token
+	// (DELETE /me/tokens/{tokenId})
+	RevokePersonalAccessToken(ctx echo.Context, tokenId string) error
+	// Readiness probe
+	// (GET /readyz)
+	Readyz(ctx echo.Context) error
+	// Choose what happens to the public todos of deleted accounts (tenant admins only)
+	// (PUT /tenant/deletion-policy)
+	SetTenantDeletionPolicy(ctx echo.Context) error
+	// Require two-factor authentication for all members (tenant admins only)
+	// (PUT /tenant/mfa-policy)
+	SetTenantMfaPolicy(ctx echo.Context) error
+	// Turn off single sign-on for the tenant (tenant admins only)
+	// (DELETE /tenant/oidc)
+	DeleteTenantOidcConfig(ctx echo.Context) error
+	// Get the tenant's single sign-on provider (tenant admins only)
+	// (GET /tenant/oidc)
+	GetTenantOidcConfig(ctx echo.Context) error
+	// Configure the tenant's single sign-on provider (tenant admins only)
+	// (PUT /tenant/oidc)
+	SaveTenantOidcConfig(ctx echo.Context) error
+	// Set the password rules for members (tenant admins only)
+	// (PUT /tenant/password-policy)
+	SetTenantPasswordPolicy(ctx echo.Context) error
+	// Set the per-member request rate limit (tenant admins only)
+	// (PUT /tenant/rate-limit)
+	SetTenantRateLimit(ctx echo.Context) error
+	// Set how long deleted todos stay in the trash (tenant admins only)
+	// (PUT /tenant/trash-retention)
+	SetTenantTrashRetention(ctx echo.Context) error
+	// Get all todos for current user
+	// (GET /todos)
+	GetTodos(ctx echo.Context, params GetTodosParams) error
+	// Create a new todo
+	// (POST /todos)
+	CreateTodo(ctx echo.Context) error
+	// Get public todos in the same tenant
+	// (GET /todos-public)
+	GetPublicTodos(ctx echo.Context, params GetPublicTodosParams) error
+	// Run several todo operations at once
+	// (POST /todos/batch)
+	BatchTodos(ctx echo.Context) error
+	// Get deleted todos in the trash for current user
+	// (GET /todos/trash)
+	GetTrash(ctx echo.Context, params GetTrashParams) error
+	// Permanently delete a todo in the trash
+	// (DELETE /todos/trash/{todoId})
+	PurgeTodo(ctx echo.Context, todoId string) error
+	// Restore a todo from the trash
+	// (POST /todos/trash/{todoId}/restore)
+	RestoreTodo(ctx echo.Context, todoId string) error
+	// Delete a todo
+	// (DELETE /todos/{todoId})
+	DeleteTodo(ctx echo.Context, todoId string) error
+	// Get a todo by ID
+	// (GET /todos/{todoId})
+	GetTodo(ctx echo.Context, todoId string, params GetTodoParams) error
+	// Partially update a todo
+	// (PATCH /todos/{todoId})
+	PatchTodo(ctx echo.Context, todoId string, params PatchTodoParams) error
+	// Update a todo
+	// (PUT /todos/{todoId})
+	UpdateTodo(ctx echo.Context, todoId string, params UpdateTodoParams) error
+	// Log a user out of every session (tenant admins only)
+	// (POST /users/{userId}/logout)
+	LogoutUser(ctx echo.Context, userId string) error
+	// Unlock a user locked after failed logins (tenant admins only)
+	// (POST /users/{userId}/unlock)
+	UnlockUser(ctx echo.Context, userId string) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetJwks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJwks(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJwks(ctx)
+	return err
+}
+
+// GetAuditEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuditEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditEventsParams
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", ctx.QueryParams(), &params.EntityType)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entity_type: %s", err))
 	}
 
-	response := &VerifyEmailResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "entity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", ctx.QueryParams(), &params.EntityId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entity_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Message *string `json:"message,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "actor_id" -------------
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "action" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
 	}
 
-	return response, nil
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuditEvents(ctx, params)
+	return err
 }
 
-// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
-func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// Login converts echo context to params.
+func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Login(ctx)
+	return err
+}
+
+// EnrollMfaChallenge converts echo context to params.
+func (w *ServerInterfaceWrapper) EnrollMfaChallenge(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EnrollMfaChallenge(ctx)
+	return err
+}
+
+// VerifyMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyMfa(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyMfa(ctx)
+	return err
+}
+
+// OidcCallback converts echo context to params.
+func (w *ServerInterfaceWrapper) OidcCallback(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenantSlug" -------------
+	var tenantSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantSlug", ctx.Param("tenantSlug"), &tenantSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenantSlug: %s", err))
 	}
 
-	response := &HealthCheckResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OidcCallbackParams
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", ctx.QueryParams(), &params.Code)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status *string `json:"status,omitempty"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", ctx.QueryParams(), &params.Error)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter error: %s", err))
+	}
+
+	if cookie, err := ctx.Cookie("oidc_state"); err == nil {
+
+		var value string
+		err = runtime.BindStyledParameterWithOptions("simple", "oidc_state", cookie.Value, &value, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationCookie, Explode: true, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter oidc_state: %s", err))
 		}
-		response.JSON200 = &dest
+		params.OidcState = &value
+
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.OidcCallback(ctx, tenantSlug, params)
+	return err
+}
+
+// StartOidcLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) StartOidcLogin(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tenantSlug" -------------
+	var tenantSlug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenantSlug", ctx.Param("tenantSlug"), &tenantSlug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tenantSlug: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.StartOidcLogin(ctx, tenantSlug)
+	return err
+}
+
+// RefreshToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RefreshToken(ctx)
+	return err
+}
+
+// Register converts echo context to params.
+func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Register(ctx)
+	return err
+}
+
+// VerifyEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VerifyEmail(ctx)
+	return err
+}
+
+// HealthCheck converts echo context to params.
+func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.HealthCheck(ctx)
+	return err
+}
 
-	}
+// Livez converts echo context to params.
+func (w *ServerInterfaceWrapper) Livez(ctx echo.Context) error {
+	var err error
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Livez(ctx)
+	return err
 }
 
-// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
-func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// DeleteMe converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMe(ctx echo.Context) error {
+	var err error
 
-	response := &GetMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMe(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// GetMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMe(ctx)
+	return err
 }
 
-// ParseUpdateMeResponse parses an HTTP response from a UpdateMeWithResponse call
-func ParseUpdateMeResponse(rsp *http.Response) (*UpdateMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PatchMe converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchMe(ctx echo.Context) error {
+	var err error
 
-	response := &UpdateMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchMe(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// UpdateMe converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateMe(ctx)
+	return err
 }
 
-// ParseGetTodosResponse parses an HTTP response from a GetTodosWithResponse call
-func ParseGetTodosResponse(rsp *http.Response) (*GetTodosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// SendDeletionCode converts echo context to params.
+func (w *ServerInterfaceWrapper) SendDeletionCode(ctx echo.Context) error {
+	var err error
 
-	response := &GetTodosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SendDeletionCode(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// RequestEmailChange converts echo context to params.
+func (w *ServerInterfaceWrapper) RequestEmailChange(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RequestEmailChange(ctx)
+	return err
 }
 
-// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
-func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
-
-	response := &CreateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+// ConfirmEmailChange converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmEmailChange(ctx echo.Context) error {
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmEmailChange(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// ExportMe converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportMe(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ExportMe(ctx)
+	return err
 }
 
-// ParseGetPublicTodosResponse parses an HTTP response from a GetPublicTodosWithResponse call
-func ParseGetPublicTodosResponse(rsp *http.Response) (*GetPublicTodosResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ConfirmMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) ConfirmMfa(ctx echo.Context) error {
+	var err error
 
-	response := &GetPublicTodosResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ConfirmMfa(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+// DisableMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) DisableMfa(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DisableMfa(ctx)
+	return err
 }
 
-// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
-func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// EnrollMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) EnrollMfa(ctx echo.Context) error {
+	var err error
 
-	response := &DeleteTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.EnrollMfa(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// ChangePassword converts echo context to params.
+func (w *ServerInterfaceWrapper) ChangePassword(ctx echo.Context) error {
+	var err error
 
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	return response, nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ChangePassword(ctx)
+	return err
 }
 
-// ParseGetTodoResponse parses an HTTP response from a GetTodoWithResponse call
-func ParseGetTodoResponse(rsp *http.Response) (*GetTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// RevokeAllSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeAllSessions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeAllSessionsParams
+	// ------------- Optional query parameter "except_current" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "except_current", ctx.QueryParams(), &params.ExceptCurrent)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter except_current: %s", err))
 	}
 
-	response := &GetTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeAllSessions(ctx, params)
+	return err
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// ListSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSessions(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSessions(ctx)
+	return err
+}
+
+// RevokeSession converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokeSession(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokeSession(ctx, sessionId)
+	return err
 }
 
-// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
-func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ListPersonalAccessTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPersonalAccessTokens(ctx echo.Context) error {
+	var err error
 
-	response := &UpdateTodoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx.Set(BearerScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPersonalAccessTokens(ctx)
+	return err
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// CreatePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePersonalAccessToken(ctx echo.Context) error {
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	ctx.Set(BearerScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreatePersonalAccessToken(ctx)
+	return err
+}
+
+// RevokePersonalAccessToken converts echo context to params.
+func (w *ServerInterfaceWrapper) RevokePersonalAccessToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId string
 
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", ctx.Param("tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tokenId: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RevokePersonalAccessToken(ctx, tokenId)
+	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Login with email and password
-	// (POST /auth/login)
-	Login(ctx echo.Context) error
-	// Refresh access token
-	// (POST /auth/refresh)
-	RefreshToken(ctx echo.Context) error
-	// Register a new user
-	// (POST /auth/register)
-	Register(ctx echo.Context) error
-	// Verify email with token
-	// (POST /auth/verify-email)
-	VerifyEmail(ctx echo.Context) error
-	// Health check
-	// (GET /health)
-	HealthCheck(ctx echo.Context) error
-	// Get current user info
-	// (GET /me)
-	GetMe(ctx echo.Context) error
-	// Update current user info
-	// (PUT /me)
-	UpdateMe(ctx echo.Context) error
-	// Get all todos for current user
-	// (GET /todos)
-	GetTodos(ctx echo.Context, params GetTodosParams) error
-	// Create a new todo
-	// (POST /todos)
-	CreateTodo(ctx echo.Context) error
-	// Get public todos in the same tenant
-	// (GET /todos-public)
-	GetPublicTodos(ctx echo.Context, params GetPublicTodosParams) error
-	// Delete a todo
-	// (DELETE /todos/{todoId})
-	DeleteTodo(ctx echo.Context, todoId string) error
-	// Get a todo by ID
-	// (GET /todos/{todoId})
-	GetTodo(ctx echo.Context, todoId string) error
-	// Update a todo
-	// (PUT /todos/{todoId})
-	UpdateTodo(ctx echo.Context, todoId string) error
+// Readyz converts echo context to params.
+func (w *ServerInterfaceWrapper) Readyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Readyz(ctx)
+	return err
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// SetTenantDeletionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTenantDeletionPolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetTenantDeletionPolicy(ctx)
+	return err
 }
 
-// Login converts echo context to params.
-func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+// SetTenantMfaPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTenantMfaPolicy(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Login(ctx)
+	err = w.Handler.SetTenantMfaPolicy(ctx)
 	return err
 }
 
-// RefreshToken converts echo context to params.
-func (w *ServerInterfaceWrapper) RefreshToken(ctx echo.Context) error {
+// DeleteTenantOidcConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTenantOidcConfig(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.RefreshToken(ctx)
+	err = w.Handler.DeleteTenantOidcConfig(ctx)
 	return err
 }
 
-// Register converts echo context to params.
-func (w *ServerInterfaceWrapper) Register(ctx echo.Context) error {
+// GetTenantOidcConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTenantOidcConfig(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Register(ctx)
+	err = w.Handler.GetTenantOidcConfig(ctx)
 	return err
 }
 
-// VerifyEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) VerifyEmail(ctx echo.Context) error {
+// SaveTenantOidcConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveTenantOidcConfig(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.VerifyEmail(ctx)
+	err = w.Handler.SaveTenantOidcConfig(ctx)
 	return err
 }
 
-// HealthCheck converts echo context to params.
-func (w *ServerInterfaceWrapper) HealthCheck(ctx echo.Context) error {
+// SetTenantPasswordPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTenantPasswordPolicy(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.HealthCheck(ctx)
+	err = w.Handler.SetTenantPasswordPolicy(ctx)
 	return err
 }
 
-// GetMe converts echo context to params.
-func (w *ServerInterfaceWrapper) GetMe(ctx echo.Context) error {
+// SetTenantRateLimit converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTenantRateLimit(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetMe(ctx)
+	err = w.Handler.SetTenantRateLimit(ctx)
 	return err
 }
 
-// UpdateMe converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateMe(ctx echo.Context) error {
+// SetTenantTrashRetention converts echo context to params.
+func (w *ServerInterfaceWrapper) SetTenantTrashRetention(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateMe(ctx)
+	err = w.Handler.SetTenantTrashRetention(ctx)
 	return err
 }
 
@@ -2332,6 +9403,80 @@ func (w *ServerInterfaceWrapper) GetPublicTodos(ctx echo.Context) error {
 	return err
 }
 
+// BatchTodos converts echo context to params.
+func (w *ServerInterfaceWrapper) BatchTodos(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BatchTodos(ctx)
+	return err
+}
+
+// GetTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrashParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTrash(ctx, params)
+	return err
+}
+
+// PurgeTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PurgeTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PurgeTodo(ctx, todoId)
+	return err
+}
+
+// RestoreTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreTodo(ctx, todoId)
+	return err
+}
+
 // DeleteTodo converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteTodo(ctx echo.Context) error {
 	var err error
@@ -2363,8 +9508,66 @@ func (w *ServerInterfaceWrapper) GetTodo(ctx echo.Context) error {
 
 	ctx.Set(BearerScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTodo(ctx, todoId, params)
+	return err
+}
+
+// PatchTodo converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTodo(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "todoId" -------------
+	var todoId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", ctx.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter todoId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTodo(ctx, todoId)
+	err = w.Handler.PatchTodo(ctx, todoId, params)
 	return err
 }
 
@@ -2381,8 +9584,64 @@ func (w *ServerInterfaceWrapper) UpdateTodo(ctx echo.Context) error {
 
 	ctx.Set(BearerScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateTodo(ctx, todoId, params)
+	return err
+}
+
+// LogoutUser converts echo context to params.
+func (w *ServerInterfaceWrapper) LogoutUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.LogoutUser(ctx, userId)
+	return err
+}
+
+// UnlockUser converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlockUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateTodo(ctx, todoId)
+	err = w.Handler.UnlockUser(ctx, userId)
 	return err
 }
 
@@ -2414,54 +9673,237 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetJwks)
+	router.GET(baseURL+"/audit-events", wrapper.GetAuditEvents)
 	router.POST(baseURL+"/auth/login", wrapper.Login)
+	router.POST(baseURL+"/auth/mfa/enroll", wrapper.EnrollMfaChallenge)
+	router.POST(baseURL+"/auth/mfa/verify", wrapper.VerifyMfa)
+	router.GET(baseURL+"/auth/oidc/:tenantSlug/callback", wrapper.OidcCallback)
+	router.GET(baseURL+"/auth/oidc/:tenantSlug/start", wrapper.StartOidcLogin)
 	router.POST(baseURL+"/auth/refresh", wrapper.RefreshToken)
 	router.POST(baseURL+"/auth/register", wrapper.Register)
 	router.POST(baseURL+"/auth/verify-email", wrapper.VerifyEmail)
 	router.GET(baseURL+"/health", wrapper.HealthCheck)
+	router.GET(baseURL+"/livez", wrapper.Livez)
+	router.DELETE(baseURL+"/me", wrapper.DeleteMe)
 	router.GET(baseURL+"/me", wrapper.GetMe)
+	router.PATCH(baseURL+"/me", wrapper.PatchMe)
 	router.PUT(baseURL+"/me", wrapper.UpdateMe)
+	router.POST(baseURL+"/me/deletion-code", wrapper.SendDeletionCode)
+	router.POST(baseURL+"/me/email", wrapper.RequestEmailChange)
+	router.POST(baseURL+"/me/email/confirm", wrapper.ConfirmEmailChange)
+	router.GET(baseURL+"/me/export", wrapper.ExportMe)
+	router.POST(baseURL+"/me/mfa/confirm", wrapper.ConfirmMfa)
+	router.POST(baseURL+"/me/mfa/disable", wrapper.DisableMfa)
+	router.POST(baseURL+"/me/mfa/enroll", wrapper.EnrollMfa)
+	router.POST(baseURL+"/me/password", wrapper.ChangePassword)
+	router.DELETE(baseURL+"/me/sessions", wrapper.RevokeAllSessions)
+	router.GET(baseURL+"/me/sessions", wrapper.ListSessions)
+	router.DELETE(baseURL+"/me/sessions/:sessionId", wrapper.RevokeSession)
+	router.GET(baseURL+"/me/tokens", wrapper.ListPersonalAccessTokens)
+	router.POST(baseURL+"/me/tokens", wrapper.CreatePersonalAccessToken)
+	router.DELETE(baseURL+"/me/tokens/:tokenId", wrapper.RevokePersonalAccessToken)
+	router.GET(baseURL+"/readyz", wrapper.Readyz)
+	router.PUT(baseURL+"/tenant/deletion-policy", wrapper.SetTenantDeletionPolicy)
+	router.PUT(baseURL+"/tenant/mfa-policy", wrapper.SetTenantMfaPolicy)
+	router.DELETE(baseURL+"/tenant/oidc", wrapper.DeleteTenantOidcConfig)
+	router.GET(baseURL+"/tenant/oidc", wrapper.GetTenantOidcConfig)
+	router.PUT(baseURL+"/tenant/oidc", wrapper.SaveTenantOidcConfig)
+	router.PUT(baseURL+"/tenant/password-policy", wrapper.SetTenantPasswordPolicy)
+	router.PUT(baseURL+"/tenant/rate-limit", wrapper.SetTenantRateLimit)
+	router.PUT(baseURL+"/tenant/trash-retention", wrapper.SetTenantTrashRetention)
 	router.GET(baseURL+"/todos", wrapper.GetTodos)
 	router.POST(baseURL+"/todos", wrapper.CreateTodo)
 	router.GET(baseURL+"/todos-public", wrapper.GetPublicTodos)
+	router.POST(baseURL+"/todos/batch", wrapper.BatchTodos)
+	router.GET(baseURL+"/todos/trash", wrapper.GetTrash)
+	router.DELETE(baseURL+"/todos/trash/:todoId", wrapper.PurgeTodo)
+	router.POST(baseURL+"/todos/trash/:todoId/restore", wrapper.RestoreTodo)
 	router.DELETE(baseURL+"/todos/:todoId", wrapper.DeleteTodo)
 	router.GET(baseURL+"/todos/:todoId", wrapper.GetTodo)
+	router.PATCH(baseURL+"/todos/:todoId", wrapper.PatchTodo)
 	router.PUT(baseURL+"/todos/:todoId", wrapper.UpdateTodo)
+	router.POST(baseURL+"/users/:userId/logout", wrapper.LogoutUser)
+	router.POST(baseURL+"/users/:userId/unlock", wrapper.UnlockUser)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa32/bOBL+VwjePdwBSuy0fejprb8vd1ls0U13H4rAoKWxxZYiVZJK4g38vy+GlGXJ",
-	"Iu0kjd0U26fGEskZfvPxmxmqNzRTZaUkSGtoekNNVkDJ3J8valt8AFMpaQB/V1pVoC0H95ZlGRgzseoL",
-	"SPxtFxXQlBqruZzTZULhuuIazIS71zmYTPPKciVpSl+4ycRNJm4gwzfE8hIIl8RApmRuaLJalksLc9C4",
-	"roaZBlNssezeTPzjGwrXrKwEjngJTINeL7qeURvQOPafGmY0pf8YrUEZNYiMPhrQLRzLZbuKmn6GzOIq",
-	"rzQwC+cqVx/gaw3GDlHrwRBwPa9hkjPrHJ8pXTJLU4oPjhCakOvcTKp6Knjml5+xWliazpgwkGygfjoj",
-	"VteQkEtu+FQAsYowIQju3iDstgBiWAnEgmTSrs1NlRLApAOXW+HcK7k8Azm3BU1PBn65OH2tuYacpp+a",
-	"SRcBzN5orXScZZnKIQwUWMaFZ2Kec9whE+87c91Oh/ZKMIbNQ2uGInqm5lxGgwkl46IXKf8kEKWKGXOl",
-	"dB7mq0N7YkQ9D/vVhXJlol2xPz+E8Qd/Ys7xWEQ3s+tYbbjRHx62OufG4pn5dvgkK8M06OLaIeTzZCfK",
-	"/aNx7l4SnoO0fMZBk3/hwH/T5OHDgQLhpELpISg8TJEIABvOcDTvhsbsnnFj46fNqlx5LyyUZpcgeqFb",
-	"CWJrkGnNFl6GLRMdp1sNX0ac2yYDKOEWuuB0NKl9PWE2qpyyFoJNUbt60rDGOHPyvXWN6Jzp4jZoraK+",
-	"TL49EezcToRJG/lif/lhmGSr/M74oumJ38nGgS2AnL4mauacwmHkqlCkiQexBcfyIlfBAzxg30fn2tbM",
-	"vYOCB4jnoQK3K7FH0PP1UQS9uH4NV+vWWcMw3OOQtokm/GZyCRo1PxLZuylyQrXyMIKsSxRllpdcUqw8",
-	"yinojjIPUlPE0t3PTQjW33GTize44WiUbpn7Yzl/mVADWa25XfyGqucXbaru9IZO3V9vVxv43x/nNPEN",
-	"h4N8ozovrK3oEhflcqaGpH/vDgN58f6UzJQm75TKCZ5gwqpK8Mw1FLSlNV2/xxlHxE+nCb0EbfyK4+Px",
-	"8QlipSqQrOI0pU+Px8dPXXq3hdvNiNW2GAmsCx1+yuOIKDqLpzlNfdlIPWhg7EuVL7yESAvSje/4OPps",
-	"vGb4TLErj/RK0mU/NCgC7oE/Pc7hJ+Pxg9nudYTOdj8kzjdiatfdzWqBWD4bnzyY/X6zEHDgVF4ywXNM",
-	"A66SY8J4WtZlyfSidfGK24K4w0+YzEm3eGNzgxzHrdILnOsj3tS78Zh3i+w9hT5Uxz8yBjjfSAMW5B0u",
-	"iMV3Y0Pjjr9u2OBDgylhnRuJrTTwTc02HjQj9sWBfld1q/ifHCz+mLzbImwY/PHhgv+SYeAbkND2fw5n",
-	"+41XFqGB5QsC19xYM+CdjyNhRMKVq9S20M5VKIujtpAJU6+T4vfEvkARsQcB6hclnRub9VWeR3hVuPWZ",
-	"dotyKBay8IKHpu5Kt5T2d6PYzwSkywejSWMuo21RrwKYsC59zSFAnf+6168KyL7QB42esczWph889eV+",
-	"Mfr1/xsIeK9J1ri92rZ/3GzcV+nBTb8D+wvQPWbLjSvjwYZe1VqDtL59xSoXK2N8dehM+VGizCjN/4S8",
-	"V8fT9NO6gv90sbzowv8OLMk2t9CJA26fXiwTWtUB9H3X2ATg4aVq2JQeuFTaFXyXKpve7vvWSfeLvgf4",
-	"NgTAY9heLcZO4rkbgJ2WZiVY0MZZ73v6lgvMmdMFaa5juJKkkRjsE2lKv9agF6tr0LRzbZN04Nrs8pfJ",
-	"TXC24CW3vZntF5Yn44SW7JqX2OWfjPEXl82vJHDlGTagZjMDEQvdJceBJS/2SN7BXXGo2+PGugs4F7kf",
-	"R7KYEN5nd2/Q5W+HugiA165gqbX+1Lcn+Rp+Szxwpd+/3Q91erl6pJX+o2ehD25T+q9uqfvEazXzaH3p",
-	"G5NOf5UVEdCfsnZPWfO4/3Dq1nU7cvUfYdroBv85zZc+XJg1h3R77Z43wheiWsVssSaCX5FuClcgF7eN",
-	"wDD+zwKff1B9vI+PqnhC488OZ9zBIJUlM1XLOxDFR5GwiPgkW6u0wwV+fNhstvo/JT8pdNtKyvEHy/HT",
-	"18HaKd737Z1I++oo71ySHZjE8Y7yb1mS/SCnqWmmY4Ls19GX4a74TGVMkBwuQaiqxGbGj6UJrbVoPqWm",
-	"o5HAcYUyNn0+Ho/p8mL5VwAAAP//GAs2av4pAAA=",
+	"H4sIAAAAAAAC/+y9a3PbOLIw/FdQfN+qk9Sh5UuS2R1PPR88trPjHTv2kZXN2WeVkmESsrimAB0AtKOd",
+	"8n9/qrsBXiSQkpP4djZfZmKRxKXR3eh7/xElajpTUkhrot0/IpNMxJTjP/eSRBXSHn6ZKW37wsyUNAIe",
+	"zLSaCW0zga8JfC7SEbfw51jpKfwrSrkVGzabiiiO7Hwmot3IWJ3Jq+gujmZCGyV5PuJJIowZWXUtJA6X",
+	"WTHFf/z/Woyj3ej/26wWuOlWt3nmPt/DrwfwcbnAu3I6rjWfw99GGJOpe4x/Th90jWlVqnCcVJhEZzOb",
+	"KRntRoc3Qs8ZPGRqzOxEsMIIHbNMJnmRZvIKf7Oam0kUr7eYgUpV10pgglVjfDRCV2PcxZEW/1NkWqTR",
+	"7j8aJ+iG8/urwa710D6XS1KX/xSJhTXtFWlmD2+EtMeZ6UKeG494a4GiGrb7aCzPYSj3IJNWXAmN++5Y",
+	"afsqeUKn+0ckZDEFkCVacCsAWrOU/pGKXOA/jBrbUfmXFsYqLaLPASLgiVV6lKXLWDRweMNuJ4pNeSoQ",
+	"a5IJl1eCvZJFnrOx0qyQvLATIW2WcCtSBocqjDWvoziCl/hlLqJdqwsRmn1sCW94mmYwL8/Parumr5rL",
+	"2scFpGyciTw1DEfAlU0Ly/Gd1nkrgF+KMQDkWyamIRoz99i5SLSwhnGZMo+pLOWWs1diyrM8HkrJpyIm",
+	"4rSZzQW+W5vpNeNaMC0SpVORMm7YMPpH//Bgb39wePB5GA3X2iDhxv34IZyhnTtcaHtKv4dwJXHwoRfZ",
+	"K2AZMQOiZ0qzgZBc2teheVsmzGbw80oUcvjmlr3i9TDx2UkX2VVsJgyXL7NMCzPK5DJY6Gpg+DHDFxFP",
+	"GJwAyyQzIlEyNVG8xCXiaDrmIyG1yvOpkHZU8coQ7C1Cl7mXDLO3amOMpM1q1AlTA7b5G4FNuGFS2aGk",
+	"iUTaY/s8z9kmfLQ5HfNNesDGmTY2hg8luxE6G8/ZbWYnOBI+Y4lKxVBWO7lUKhdc+p10LF8Xgt3CwDDY",
+	"jBtzq3TKbrlhOruaWHZZWMYdqJjbVGaYFCKFBQ8mQouhBKKRikBt2FzYXWaETNkFzI6/XtCSOS6VWVXb",
+	"JW2pY/nl8S+xhDwXwA/xhZjd8DxLkS2+Y9NMFlaYKIiziYI7egRLCVzgfWELLUXKlExEXEEnV1eZZHAn",
+	"5cIiqXn8qN/jS9Mt3k1ajLUwkw6kxiclrYsvHGaMdqNfBdd0My9+8VUCwBItEo89BFbZJ7pepsik0Boo",
+	"wqNKcANS3I6Q4zbYH/0S4gp1UaT6Nl6e7HPrqs/cK9++8PoLiwgnkmu4Fa54Jo1ltqT9/zAV7cxUniVz",
+	"JPVrqW4lu9SCJxORlq+YKI6mmTwW8spOot0/rwLJ0tIXFhqEipLjTE/xMAlCrZBpQ8SFVdBrwbnwugtK",
+	"5C1TVmx7lPK5o8ExL3Ib7b7ZiqMp/5JNQc5689M7hBX9tR1i1XClN+kkFbNczRkdXYSjeVhvb201YL8d",
+	"ICaTqJlYXyLFnZ7DN8ivMnlEX20vkv4ionMUBNxs7XAl4b8FkA0EDSB0WogRiqdryyGZGc2KyzxLGocy",
+	"5rlZksmOxgzveXaTmewyR7YOFxhwIwM3LBCI4VNPJUEGj2IYzNV5Kou4iB+1wyztUg9Brsjz03G0+49v",
+	"0TFbyagJpHO4B7lhFyDpKJ39CyWBXUbMnA2Lra03CX6L/xQXPXY+AbahZD7HKyiKa7h9ZdPRjNvRT+Pt",
+	"pNfrrWSnbVT7+S6ODlBFcUp+DcUW9NnMTpyQX/I4pf1NPtZqys5Ozwdscyo2UenJlNyAZ1G8yILhxxCW",
+	"dvDk0D114GY5Q07bShuExqMWNf3ThFs24bOZkCAj0v7wC1QPDCjvnOGGgOUTkHpMC25MdiXZVXYjDHw1",
+	"9V+rPBXGMp5OMxnFpaJYUwTp0+jzqkNrrDyE5YdaK73vwNnc1wlPJpkUG1rwFGRxJuBd5g7EL+rXvYNR",
+	"//C/Ph6eD6I4+vhh7+Pgt9P+0f89PIji6P1p/9ejg4PDD1EcfTgdjN6ffvwAv58cDn47PRjBT3vHx6ef",
+	"8OX90w/vj4/2YZiz/uH+6YeDo8HR6YfR+72jY3zh44fzj2dnp/3B4cHo5PDgaG80+PvZIT4465/uH56f",
+	"7/16fDg6/DA4Gvw9iqO9/f3Tjx8Go+PT/d9xgMHp6ehk78Pf/YLPozj6297x0cEeTnTY75/2ozg6+jA4",
+	"7H/YOx6dH/b/dtgvf4et/mVvcPhpD0b/bTA4c49CCvl7UC8RuqZdPw3x2cYZnGl1mYupIZk3k2l2k6UF",
+	"z732iqi1uIWYXYu5SNnl3An2Ik+H8lWqrEXZAUfCR5cqncdAgzOu+VRYoRlcJr+wYQSPhhHKwP5NYD4c",
+	"zAi5eD2UdWbyh2e+UWZYiX8hivtN8NxOUP4JXOYArhCBzZ3NQiTXbMyzXKS/sFRYnuUG1Wx/Qwh9IzSI",
+	"16a+Orim+CU3AvQNbpkWcM0oyXa2dn7a3tr+eWd7a2trKwbVTiQAo/LBDjwIXW45t0Im89HUNCSG7d6f",
+	"4tr1qArQYcvPZTG9JFHDWG4LU7cCqesojmBvq4nafdxYRIi2//rp92VgnhFnuhZzgNpfz08/sE/ikv0u",
+	"5ozWzV713++zP73b/tPrJb7L86v6mvvnO+9+iuLoMD043wtSQaJvAtJvoW8Eoq5kp7+fwVoax3WY7rx7",
+	"t/1zCOwBNtU/34ODwxuXvYJj/ultofOgieI6ZCA74TaZEAdmF9dZesEmgqdCe6Or00WB4YrU68uZccte",
+	"nsPOmzDai+Lo9PezIHxkeD9TlRZ5YVZtpzAL8qrJrkLvfQncxQRjNqvQoXOyBRSETRI4aRUxokYLEp4v",
+	"U/q1mK8vFwMe362QgXHA0PzH2Y2QwpjlNdRosAQgUuFa1BecC/T6dlVlXT22U4yJIxKBRyYvrlbrWn6K",
+	"msZX/z60i5P3eyAQtOu/QWnhp400u8osG5wOzkige6U0GEhZmhkQH2Ig90IWBo3LZDjBF1djG87YstRD",
+	"tJ20LrZh9emepXq1c6ppp3lf2RmYo0aFzpZh5B7ubm6yj/0jgI2ZqFu6Vv+r7yWrZTUSrdHLw/3KjXiz",
+	"QxCnd2K8r6dcgoggpNXz1ehMg8eNlbcAYIWY3G4c7NMTJvDQp2J6WWOvZPO0CrS9dotnQOVb2En575bV",
+	"9x3SAXKb9hNcZdQ7z+RVLjZgsfgGgrw07dUWTfbNQvIbnqExO6SPrWvtW9prY5EtO/4b2kO/mZCVbiPd",
+	"ELZ+FcXF7TR+enSwjzaoq9aN8DxXtyIdpWrKMxnypAIXZO4xs6CwTfkcr3SWyZiZIpmgau1ugl6iphed",
+	"p9NhnYmjJM/AytbiEHFP26ja0UpKOIUOIO/qTRAMPXYsOAhQBVLNtRAzekrGPVZSdEC3QCPMSKtcNIwy",
+	"EZFktKh+9FUuUD+Vjj61Yc4lxRQifKad4wBgudFQV8sxSY/9HDQRmUIEJP4j/J197B97LjHT6iZLhe6x",
+	"i83ercjzDTSJbqqZkFm6QZApyC9zwaaFsezSKQQpK2QqNMtsQ8qcWDszu5ubaIzv1Q5+Jct0q66fc7yE",
+	"g6tQuWI/ARdcnlUHSU4S0Gu0cycExPIl/F/fi9CNrIso03GES4+0SDMtEjsqdB7QAnieX/LkGk/ZKqbF",
+	"VWas0F7Crs48KPrO0ns6RkPaqLf1r7jYJpmxSs8bJLO1SCsfCn+rzUDBVEVlyTeLMRueVJUUMbGjhEup",
+	"EGe1AD4b1UzXO29rluutoJMxk6NkwjVPrNCjJOfGCNO93N/ULQgKc1gwYI9OuBEsF9YKbWJWzGaLP+G1",
+	"QD5xM59eKtC9yy0SySVKWp7J+uLXWnvuzLVLqhl9yeg5KKzlLk2PnU4zC5dTGcNQswG4jbNXZ3vn559O",
+	"+wejk6MPo+PDD38Z/Pa6vr4/7dQW+OdWl3xn3EfAvNsdqvKQIUohs22YHa0wbS8IC18TieCcM/f5poUP",
+	"5dzYERCGG6yJJx+JG4BxZ6qMReGKcee6jeLw1CtDErw/6Pv6dTpPC6+Spj+nAcZGREjwTMlgeL+QGDRT",
+	"Mu3O3lvT0Az0560/sRmNWVrcvJ2Iz2a5k8433Tv/+U+j5OveUJ6iqZ9EAFMJWFpYPR9hsM/IRU3EKIpd",
+	"ArsXRkjLUjETElkliRgMNtlDg2NYfu2CfWXfxsvMOhV8Yf9fZjmXuBFmZiLJxmi7JzOPSohbL7hPXLwK",
+	"hQrQp2SZDBJCaQfuWmvdZAyUII3lMO2yDQ9MuE4w8uvAW6RcQbXOTR98tyLiZkEAO1gc/xX88d8b7o7c",
+	"OEqdlew16ZyJ0lrkJKriBd60xS6TUGl8WbiWBqDM4sPS0+D38nYreIGUfr8FPW2itGWmmE65nvvdXGcy",
+	"pSuayKQOqV95ytz2Qiu2miciCKxPb/YZPmVLYCPgjDPCZ7g+6UVHYvAHPAA5SMgg6oQDtcB8kKVC2mw8",
+	"90LFwt5ipqRgM6GdNYZfqsLuXuZcXuOiZjnPJEN4E3q+bkCj0HL3Sql0A/Bn1425W2H7Bn602msIT/0Z",
+	"xZXpulXd63MrjrNpZtcwNghjzWgm9Mgx+qAuBS8hGOgl5oRlhIHgycQxqTXFif7e4HB0fHRyNBh9PD/s",
+	"j84O+yBZfBwcNqQKdChsNQMOvkay6AueZmHzJXpDOn1LXbym7odZ8jr1hYHNqjEBCGdir7wXJWbT7Io0",
+	"LRMznQN7n9rZ61+YmM7snN1OslywVPNMAkYEdtVG/OqalF4yE9G0M5Rl43I8N3ztbMyksIal6raufFbu",
+	"lDgql7K+Z8UBN4igFHXVHZayKjRryZxTfz08K2lH38O63CrXPIOApSXz9oJ2jA8968uEZq/gxdUm5K+w",
+	"g/fFjboWqYvf77QY4ovLq61UQh/5zoRM6yJCnfibCEFDhtblFtStYXz/NIVFUvETdCzx+2oTTmkOm0zc",
+	"cvz1m/A8B2bBa5G7waCh76iiZLMRT1PtmPUC/ZBF5+jMLxD0GRcHiqI30n8U31fxOW4ZZr2NFEboEb8K",
+	"AhWCPDf24NmqJVcyw4n6V5bnfPNdb4u9OgHRxioz+YUdSStydsITdnrO/pttvx1traZY71v0K2wAuKEH",
+	"LQBpUWFyaBPCU4iI+xXcv6czQTdayEruwnRrh15DoG+Om1uphgaTOri+Ehbji+Iy4AICPujqVDMmviRi",
+	"ZlmZXNIdnre8LzXrzlHxYKmnq0zVTTg7Zb3QvDiyanmrB8LYzClnGO4Cs8RV6hNzkhpPbHYjECQsz1CG",
+	"96v3KVKLYVDVxDdCG3eCzdnfQ2oM7hmvNb/rGFUt0nTebu+wQubIZyZuARRogvqjH3olz1ezbhxt93GW",
+	"fhxvzudWTbNkyZxPPzPwYhrUOTDIUnncNywbo9IAe0ObJaniAlMSZkpbQwKhRvmwBuByvtonQThXc93D",
+	"erJEo3coaXv/i5Oz1wyWra1gBbTbr3vY/VdsgMTq4M3auQ4809ZYqU7DoVNz7+hkvoQS2Typf5WKjmI4",
+	"OUQR74VMZyqTlt2qIk/ZBPxUNbfF8tzw1f2SFhevCdwXbiLuis2AQTCkV+llYLbc6C2CcqvNrm3eVcbg",
+	"VN0Pm75bwiIMdiI0JD3YZPKtd1/jT7zXWJILrglN6k/XuPXq9+aKgQvB3KX0lRdsM1D96QLTg+fTIUR3",
+	"n075uEu4XQmcr5LU3TeX83Ww2VPlnZckwqLuubBVmIW/ZCs7WrvYuxrVHkmEezrE+w7uUyeLt2UYV5bP",
+	"MtfYBwqgFIR8/j6C15FMtJgK6UINSK6lZffYXm4UQ2cBJx5wOOBXziAdxWuyvtIpszT3hRY83b1g5IEh",
+	"iyH7y+GgTIpGWfDiVmdWVK/BOpSdgGtyKC+Qq18wDJYxjKzwMbsA6FS/TjEqLmUXdIi11/HveCg36cDh",
+	"pU0O6eUblObOXt1OMvCpACSkEJQNipEWIN8J8MEMKHDVuZanXPKrMppV6fZQK/K1eNkOYeGdCLRl/xc+",
+	"cjn+9MT9Qd94xHQf0Z8hqXAA5NsXFpawMhmjSvNqaAl8XuZy+1QLXCbGh88KjSnfkGJS8os1Lb6D/t75",
+	"b6P+4QDyCk4/jA72/n7esPW++endd7D0kguzM0Xr6TXRZ3tREvQoMbUFeu3C3PJoRuguscgP1S2XODfu",
+	"V6SyNxJsv48n3luHw09GmDmdtWHW/cTjOHL+2ypzd8EsKm6ZM+SwW56hz5B8iJhp6mowUMweVSMwVcwk",
+	"yzAiyr0bdrf6YKVSPXWJVC4i7XO71bllp98n2ojCMbuzor8xhZaihQud2fk5iFg0qEv33v0jusR/vfcb",
+	"+OunwZKRYE82rKYxpeiVVTDqz9irC59F2Ov1Ll4Tg4X0PXxsMIgBVhHtupkrQE2snUV3d6iZjlVrnsre",
+	"2RHixl+UShlwR1aLOhjKobyglEG6bmNW/3PzEsj3gm7Y+u/I/jf/gD+O0rtNV1flYighEiHhWoMPg10c",
+	"pWI6U5his/G7mJd5Ia+2gbvtvHtXi0Z6/Qvd/aWIMJTZlYTbKLMxM6qMrjA0ATpt6SqGC4oC/HAZaQ+S",
+	"cehnlCzgIhvKheBikSJrxboJLgLTz4Dw4pThY9yY+NvOWzZRhZMmtJjlfC7S2JVTAFCWG7Ybffd4F9m6",
+	"33qthgHycrdZllFkR+byY4ayfONazHusLwowErhF0fLSbDwWWkhbDkJ2AsPe7uyQVMRx0PlQVi4/v9Ny",
+	"WmOzPGe6kOgbLIfY+hmKuOBFTs5tgjLUyCCA4JnYiYDzlhR2h+vvAU6VbmP4SHMrWA4eaYHFYEjAfeXC",
+	"1pG1GAxhAdFNZ66+Dn4wlAjbi7OPAy/SbcJwG/j04nVclvG4wCIWF6X9hDzWifca9Iby2K1gAY+G8qL0",
+	"mG/gf4EIqp/6wIMBNo4M6k+MsP5gTY99mghJB4erA+CCDVmkTrYESqyO6GeHNuyiD2e0sTe2QvvRSIB0",
+	"t3lUkS4MscGIsqOa7B9t9bZ6284+KPksi3ajN72t3ht02NkJMrFG2O8/b69ND2KN4MlVKIy6ynOjCH2q",
+	"DYJRRYV2pBczI3LK+Luc1/K/8Gm1FyRHjK8BfLgUDtPQ5G4sn6O5GQhS2ix3mgoNgQjmEscm3AwleSZS",
+	"uN0qV3mND9STzDgzEw5z/AZpdi4amEBbGjGPUgCwsH+9vSaB3CEHwGNna4ukRmmdh6cesOWBR5r4GilY",
+	"58Stm1A+/T2KI4dCMMY+uHs39pW0WuXN4Ss3EcmQEPf1ZYNfif/zJpRieYezufCdaDeqHULtBlqCGgzE",
+	"rwzckZAmH32GURqaUw1jlsBYFfAyiHouKdZggv+CYyDLrdCAN65QEqy/Xi0pdrWSYlaFfcNdGoi6fI2W",
+	"82g3+p9CYMoOSVaNWk1xDZRLssEfXZ9n6dd8XBYU+7pvycpXffkdi53dxYtncQrCodOLOWp0vpxYZpiT",
+	"z0LrBHGlscr1JLuu6ctyYt0zW/VV84aGQm7dGK30A+1sNUOSugugtE2gxmMjWmbY6o7uvvv8gDyppS5g",
+	"gEvBc/QdwhfupGImxa0wlkQKuHvebm13rK0e4Lr+GksHzPKiPkru6nhQqvzbrTePOf17pS+zNBWyoTQg",
+	"o/Pqwj8+332us+C/CNsAoVfrfTaDE4kWRCPQ3F432HKa2ZIv2wkl4KAmpEJVQz55ibMsddZquIq9L3ix",
+	"jloGpjSLYZlORoZxLuoVzUg+4pJ+dZXGMmms4BhVib+YwO2LSb9RGU77q0rn3w3FGwnFd00l0OpC3D0o",
+	"edXq6YWICtYGgd2JMGZc5E9AQkeSCrUlWmBAGM8xcPrtzqNSkit7w3JFIXJ09ShF6TUUkk1xM6a3IC6X",
+	"pV+G0kXDOyEysxBSoJJrE/vYAs7qdOUfw5tGKSn0UDZFsdpEzW0uMek7hNnPjwmzgQePFomQtgml0hSL",
+	"9iOg6dJwW9eIQPMF8xGjMhu+hihGKMB4hRat8PYlG4eS4A0/SfHFMm6tmM5Q+wE5c2ZF+i2AbYiwRDEo",
+	"29POsLxoLSAxJLg2CjjWuWSTC1Fu+smYl7UMH4glLaXcPzJbCufhB1AMrIy11Pgec4XsgGIu5zV9sKrI",
+	"iEeTWVOrhfmEXE1p5tRFdvJ+jy4gWs7jkmprDVKgkVwLnoIOBCbuNGoiPB0Uigmtg6SFJtMQHUH49gaO",
+	"sIJA6DzbCYSMrydj/nB00Uy3fxHX9dZjYlJFt75iLbsUQjJjubYifWakhsLkrVbyqmIEz1awiJ7ZFb7A",
+	"CPad55LxOqflxJ8xmHihqEQLoassTTb/IP5wnhdXd5uJyyJvtQMOaonkzGekGzYRWtSKjqNuAWYkUGp6",
+	"aL8h05IPYsicbdTxpnDxg7jKXaeCBP9hSj+yVrlwQku5nMyAdd2Qr5qC5Zom/qrmv1FMgheg4qMAKmTB",
+	"5prs+yHT4GmWJj7Rftmihdo+GFhrhokSttEi9/oKQ5A7znt/Zyy3Kz9cDg9yRtwSvpWzgs6HqlGLsUuw",
+	"D83ss9pWLzlR6joT1ZeAm6PVC//8762ynbuTcKcQl3wXMRpiRl0ZhNIj/fimkXq1GKAwWJHLGqTVvH1c",
+	"iEF4LaGwKtfjC544117Fmto4r2kMcy8ei/dzK4PtlzzVqib5veL1QrWuyiuEUiGXPPt9//A1+i+H0tMn",
+	"kg/D2nqgg0m0x1SEdcGI6FiltHn+H2J+57Bu4IDeRPNg7G+RqN9s7bQDahFOTe3yWCVlIkr7hHcvAA/j",
+	"6N3WzmOusHG3NpiJS0NcoA3EjwXCqG7wMr3xdCbk0QGojxJOr3ZsbSTkc6NabZp950flYIR2vsIZz3QJ",
+	"w4V0NjcgvdljhzyZDGXjR+/GxhpdSiZiFwvJoGolsaCwxtQOIVNTnyEG4nNhNeDERiHESeUqh8n69Wmw",
+	"4izmMPoBDDqxQThSWGDVFCL1XhD/ChNf0EMau54o/0Sfa4hk68m1D6SohfJ3n5muhmvzhy7S2o2dz59Q",
+	"S2ogXGlr96gKKh1ixgKVefxZTMVspR1KdG7X5H0q9IPhRzPTei3c2H403MBGPF4rWUaMR1XooWKG9mB6",
+	"dMMUiWmlBQpYjFnCPTpLx2h9O7IW1CMT0kYZtdhlSMLJHwgDA3GCD8CgFnIIhTH8aqGcMEHYS+JNbFsj",
+	"4rHtyMIDPj76Bqw/zsjaQCI6DmeyJ/GgnYdNsK5Ga4xJvezGdz2/exQzXnlKp78vQIBWTQU5atumn93G",
+	"8+xG/KtVSdiT5hZNKoblYFFzaRszrfBSwJh7U8ZO9tiByyJNMlEL3UuoCoWL3eMWRZvCQlqDE5TKSu+p",
+	"EiSgaoHqiwvL8jWVQrLHMe7gAa/9shR1yNYH8FsAu38fK/qINsD7UPRchJLlzoSeclhGPnd5EaZmVSIb",
+	"FuVJvKoyqqlYoUhfx5WUN5TBEGAKJiWjniuT2HMRfrX8C5+RofRQluVEFztdQK0k0LLLxpZeAHctM5jv",
+	"CeLqjPSG0rt0qpKRtb4i9TZhHc1FnFGOAhtydYUFVQ26gah7X4lb7qsQ7lADlJOHcroF+6usdSe8DSXU",
+	"42E8AcP9hNZ0f0axKyycLbPhJ/K8NUNy1g2LIXg2ImGAuirErVEuSG8RdMxpi0B0KPRAHGihc9sSAPZr",
+	"62cQl++yMV7MWUCIUrK4iRD8Zz6zZ4ENw5bgyqHOGJgExDALyLXFePPzT6977D11X8nF2GIZZuBxhfSd",
+	"M1EfrmcDcerBEmIcOPY9+MYUVrSBq//P+599LanpkVXeVZgHz1165/NTa542LnD73WNO724Xavjjjd9d",
+	"CLg2bZ5xDSFauU/jXZNQiwCjpHS/B7tul7MJXxa1vABG/XFtFCAZtymztVtWsV4Pem8hBd0V+HcVSZuG",
+	"XpBb0dopVSmSUDoV9cCF0j1lG9jtsg8sfjdWZE110WJB54OQqW8vt09u0NWi2bmQ9sUc4QmaX1wbXqxh",
+	"TtK4cZKyE+NbhSB3sEvmlsXuTNjkq5Ec6rNrSIeQVTopnR+OiJk5pkxVqwf60df1JFLnkfL6AQ6w6Z72",
+	"2B5O4RgN2N1nOU+cHlWVKhZhezZ+VGue+kAsK9Bxdy2etfN4smX9AE2J6E+ggHim01BEEFVdUnItsM3r",
+	"yv9hnkWKwKMaWPckpbQic25265lwU4fY+kzDixdcOjJNPFV0cAdPiu1G2eUmxQ9FZ63dkJ+ZiFAxQgh6",
+	"carJszKy/iCnWvMrdd3kQBS3NBVcYjbY2vTlLWJr09eXmeoM7iBHNVpP7QRuOpdQjuXEq9g5XurM3Fqe",
+	"TKZC2l3KbJhpNc5yEZeZsalaaIeCVsfYV3AsvcYYHu+MjkPZsDqG7tpD3MrDGlCcCY6mWkl++NZCgiyt",
+	"YuMgMzNlsnUjPV6AOEgwCZnBwCjfioAQu70ue3/Q6O16z8PHz2kIN6cLBlFjpD1Juo24WZcLvdBi7smE",
+	"LJUKFKzEs4v6fuJ74P4JFWuRH37QkWjh3CG11JYuknRNO9tJ8oBeeF4kGfJ20DrTpycE23XuQBZC1lb6",
+	"75SLPAgk/bRC6x6OGTr67p6irRSwdsZd9Cyz3Y6ogguhFETGu0uWkq2eNMHtfyX/pWDSGqrV7j3HemXz",
+	"jFqxr96Goy1+1FNJTdqqjJiHVAQetY2FSFIytEIUxtUVpLaqwv7SCOOb8msvlvsyS2RJoy8gPSYge5Mq",
+	"fFblsj6cjctP8q0Xw/6TqcQrrFCAJv5HhqVTsU+0fjmWYQJtIyCjFdnrjUraglioE4upGeNKJRHl4Ew3",
+	"Y1Ox6tmM3SqNuMypIeAvCzpkSesuaBnQ3Jn8S1vx3BktagX+IVUfC4tRj6BKXS2r1DrcD9uDYSd7ee67",
+	"yqwqM/S77+67RoMTXwcJcx2CyU3YE2LkMC9c0WXMcyMCvbYfMm+prdNOKAvBQ9t3yHl88m1C0eWU4YlX",
+	"lbt8+S/ulR5/fi+Gho/VFYY2IErfToQWHWEsy+KcJ1PESEjtScVNlggTU4NOyhnN55SvgNII+Fk8kqNu",
+	"aIaS+4YzYArC1AVM6ctcxUHEAGCbFKxVT4cgsjXhOD9ja+T3YFgd6tPUIvyW8UINA9jLwZXM2GrVqzj9",
+	"5h/uX0fpXZPrh3ilg+Ja2VvluN+UvBWUFvp1dvPE0vLjpn05kgQFdawKifRWScnpffCEgMh4yQzbUKXq",
+	"jxyMkwN8C5TRe1BqXtXjuZuyfb3Jes0xMuHRE7jTp0bkN6JeD7bsnPKiGEE4TjgY2xNUbrD5vykvUYi1",
+	"oMemLJe6f3yEGi5PrPGx5HWjczyUvmKrVXg7UI3+uCqv5C4IvD3KuySo2mjBrQgc/0NpOW3zPVFCEq0n",
+	"7exBHvD201c9dm6pHqIPu5Dq9heAf9XwnizW2FnyR7DfI5tCSvedY+jer++qRjJZdrB0VLy+DogI0FY5",
+	"fAXnh9rc12I9ASFMmWuketMMP0SF74dNjsCdoPAVcsE9cAXxtT3haJ+Uc8rbqOUSSVfQWlHOEbOaj8dZ",
+	"stvMHkoo15vyo5EefMdjuCkyTJxmWt2yXNyInPlNlg3rnVefU1k6urSG8vxkcFYmzLsuBz4x6t3WG9Jt",
+	"uOt7PKa4N5kuNTweyqrjcZUKlSueskuec5kI7QwgxgWnlXlVQYsEwvFBtXvfwzoYbszTOdUKePM4M37A",
+	"pDCeztHgBqDEUEWA5lL2qBumOwHLFXYvA1QpOwnWGIxdPheWCkP76FDqd/OQmUNLTXW+1mZKo/jI4ye4",
+	"rT9KaiztYPyjau86xliljGC3ZIuazVDbcBVI6nl6alym6bmQXbOqlO/AtXSqk8F0zNemgJMxf1DkP3m/",
+	"9xB432Mn2EjGoLVPFQ23z4JLibxA1AxWZJqKjFLF4x/Iu164aKa7whqwm0ies6k7kq9AWZWlSZf7garB",
+	"Ve3stCqultIJwHsQs8ui1Gx87kHNl2CEZbzuEgklkdIKsXAbigprJQ7Qq56AX8bZDgoN7ozxIiSbdYTW",
+	"PtD2nMrVEP1+wk7V66FLOz6rqhThgp70vJ5dJan7pXs2UsUXUKkqRbY2Erkba1lPRi8hGotcGYKSEywU",
+	"iEoz46LyymYQvh4kZhUZfgN3SFmb5MJXpRwVOr+oal17HK1lt7vtBPON+E2YdXz/a7WO408SL7kekdEb",
+	"BO4nDHynky+9+w6NyvoUpIaWOPNCZEpPq9+X+mp3sr8kl2XJ5bgn/66r2OqoEw2KhvgDDUIKti8wRaXR",
+	"UvxxSLnb+FM9jgZqCmW1kgUmGCTgxTocI5gGVkq8PnjlQcXe5iQvXuf7G88Lgb5oNWYaQPxDdF4nIM3d",
+	"jWUokS5yQb0VvkFWrtrXtZJk3zXj87V2DJtlyTUrZmV0E36P1xq49l1mbSfdlG3rHqromx//+9AMDvWD",
+	"ZF4syQi9QTRSNacsu0B+DdVggtOG9h2uW0ln4NuQYMNqVhhhSqIpv45r2VOoK1GZJR/MVuuI30lSzabb",
+	"D0RXXZ29v5a4yuF+ENhLJLAJuE2ULI0VDn9RsKoj7/3oDMbo6uw4wBfW7unoWq27WDRbmJaIyqole8Bt",
+	"WAVP/mjdt8AWVKrWbdpHR/uSClCBOZKQGrua1+JS6uirUtUIgglFneBLDxlmAhM8UVwJTd1VBDlVz7bQ",
+	"7UsIwvcBGFRiPFXLyFdyzg3yBHUxUKrp2MJGfzC3r2RudQ/ci+Jx9YX7axsbvtOl3YFr1JW/I7epkAaV",
+	"RcW2t7YcB4idrBf7m1nETn5AG85U3QhWoiwuSEkxlFZzaagbMVXNr16qG3Lz7FpQlCAasDZgnWUr9t5Q",
+	"HknGrZpmCZuqtN6I3rUZZODjaxRJgJYUuLLMlFGcDBqAw7dCa6V77EiyTPrgFEtDC8rcKhd5JSyVIgWn",
+	"txamyINpJb8CSD1tPogcr1KFkzyRxbc2fztR9RE+QFYLYASNqdTklE6Ffto7xFc+rc7ZNc9CwyL9kjVw",
+	"7t9Pu9irg8dyjZTAayVUMKo6VexVDUyvn8CbFV4om2YGu2+E1ri297uQzAAucxIo6yzOJbV1MVpUozqV",
+	"Inzhx23+vW7zhkb7oq7zpi7eUMNX6zALGAfBu6laEbt7BgYup96sE6sLA37/UF3UMWZLJdLTf2tjzhPE",
+	"Daeqll9Ux757VJddOkXGfa2p2nhrI++mFsYqLbr60OALj4vEW4+rfzsgPLMisz+o477U4XDVk0QZY7KS",
+	"KNbh5S5u7Vkwc3+RPTt8fUKcuXcrA95iL4o7zesPdfhLrV4PB/yKUJizGeRjqMKU0VIQWEU1C99svWWZ",
+	"K7OI94CpavR7cz5V5quWdzTe+KCk2DhBG8WzZs2psJAi0iwvCKAJRIc68e1G6HoNCXfI3bUH37SSGuDX",
+	"VKWulRM2EYRRqb8LLuSBl/aDptf2SBAFXM7Z0UHQB/EU3TCYzWxes+ilzmA2crbFKkMUvm5tnvGcWA/m",
+	"U1JlezT8UaNLCuB8u73TYEcePkg5HfxoTVb0sM1DAMhP1zxkLX4YbIfw4Bzo3zpX+Un53+MnS8MCoNpP",
+	"eeklSjrLiBMyt3cefUENNoKYizwqk6zkHf+rm8i0y6odnWN+3BmfH7Jzzr2jCn5cFT+uih9XxXO4Ku7Z",
+	"v4l3RJZgG6bNP+B/YE/N1ZUqbLs59RiffyQPw2rOTMM+G2vqN5cw/GFOfaStG6G/Ro2Geojc9e4oakEG",
+	"vmThimjVWuWSBbooZK6S63a6+IjPH5cu3oaToBmt1WnqLmaBCjRSh14tjLA/8Pkl4DOhlUdpf6xjK3Tj",
+	"YM26iI3z6ptwYPWxSngOJUBFrmZYEpvejeKo0Hm0G02sne1ububw3kQZu/vnra2t6O7z3f8bAFgwQk5U",
+	"7gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AccountController struct {
+	accountUsecase   usecase.IAccountInteractor
+	accountPresenter presenter.IAccountPresenter
+}
+
+func NewAccountController(
+	accountUsecase usecase.IAccountInteractor,
+	accountPresenter presenter.IAccountPresenter,
+) *AccountController {
+	return &AccountController{
+		accountUsecase:   accountUsecase,
+		accountPresenter: accountPresenter,
+	}
+}
+
+func (c *AccountController) Export(ctx echo.Context) error {
+	in, err := bindAccountUserInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.accountUsecase.Export(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.accountPresenter.Export(ctx, out)
+}
+
+func (c *AccountController) SendDeletionCode(ctx echo.Context) error {
+	in, err := bindAccountUserInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.accountUsecase.SendDeletionCode(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.accountPresenter.SendDeletionCode(ctx)
+}
+
+func (c *AccountController) Delete(ctx echo.Context) error {
+	user, err := bindAccountUserInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req api.DeleteAccountRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.DeleteAccountInput{
+		TenantID: user.TenantID,
+		UserID:   user.UserID,
+	}
+	if req.Password != nil {
+		in.Password = *req.Password
+	}
+	if req.Code != nil {
+		in.Code = *req.Code
+	}
+
+	if err := c.accountUsecase.Delete(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.accountPresenter.Delete(ctx)
+}
+
+func (c *AccountController) SetDeletionPolicy(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.DeletionPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetDeletionPolicyInput{
+		Role:        role,
+		TenantID:    tenantID,
+		PublicTodos: string(req.PublicTodos),
+	}
+
+	if err := c.accountUsecase.SetDeletionPolicy(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.accountPresenter.SetDeletionPolicy(ctx)
+}
+
+func bindAccountUserInput(ctx echo.Context) (*input.AccountUserInput, error) {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	return &input.AccountUserInput{
+		TenantID: tenantID,
+		UserID:   userID,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditController struct {
+	auditUsecase   usecase.IAuditInteractor
+	auditPresenter presenter.IAuditPresenter
+}
+
+func NewAuditController(
+	auditUsecase usecase.IAuditInteractor,
+	auditPresenter presenter.IAuditPresenter,
+) *AuditController {
+	return &AuditController{
+		auditUsecase:   auditUsecase,
+		auditPresenter: auditPresenter,
+	}
+}
+
+func (c *AuditController) GetAuditEvents(ctx echo.Context, params api.GetAuditEventsParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	limit := 20
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	var action *string
+	if params.Action != nil {
+		a := string(*params.Action)
+		action = &a
+	}
+
+	in := &input.GetAuditEventsInput{
+		Role:       role,
+		EntityType: params.EntityType,
+		EntityID:   params.EntityId,
+		ActorID:    params.ActorId,
+		Action:     action,
+		From:       params.From,
+		To:         params.To,
+		Limit:      limit,
+		Offset:     offset,
+	}
+
+	out, err := c.auditUsecase.GetAuditEvents(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.auditPresenter.GetAuditEvents(ctx, out)
+}
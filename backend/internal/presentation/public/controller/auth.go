@@ -1,11 +1,14 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
 	"good-todo-go/internal/usecase"
 	"good-todo-go/internal/usecase/input"
 
@@ -33,14 +36,6 @@ func (c *AuthController) Register(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	// Validate
-	if string(req.Email) == "" || req.Password == "" || req.TenantSlug == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "email, password and tenant_slug are required")
-	}
-	if len(req.Password) < 8 {
-		return echo.NewHTTPError(http.StatusBadRequest, "password must be at least 8 characters")
-	}
-
 	name := ""
 	if req.Name != nil {
 		name = *req.Name
@@ -51,6 +46,8 @@ func (c *AuthController) Register(ctx echo.Context) error {
 		Password:   req.Password,
 		Name:       name,
 		TenantSlug: req.TenantSlug,
+		IPAddress:  ctx.RealIP(),
+		UserAgent:  ctx.Request().UserAgent(),
 	}
 
 	out, err := c.authUsecase.Register(ctx.Request().Context(), in)
@@ -67,32 +64,67 @@ func (c *AuthController) Login(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if string(req.Email) == "" || req.Password == "" || req.TenantSlug == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "email, password and tenant_slug are required")
-	}
-
 	in := &input.LoginInput{
 		Email:      string(req.Email),
 		Password:   req.Password,
 		TenantSlug: req.TenantSlug,
+		IPAddress:  ctx.RealIP(),
+		UserAgent:  ctx.Request().UserAgent(),
 	}
 
 	out, err := c.authUsecase.Login(ctx.Request().Context(), in)
 	if err != nil {
+		setRetryAfter(ctx, err)
 		return handleError(err)
 	}
 
 	return c.authPresenter.Login(ctx, out)
 }
 
-func (c *AuthController) VerifyEmail(ctx echo.Context) error {
-	var req api.VerifyEmailRequest
+func (c *AuthController) VerifyMFA(ctx echo.Context) error {
+	var req api.MFAVerifyRequest
 	if err := ctx.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if req.Token == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "token is required")
+	in := &input.VerifyMFAInput{
+		MFAToken:  req.MfaToken,
+		Code:      req.Code,
+		IPAddress: ctx.RealIP(),
+		UserAgent: ctx.Request().UserAgent(),
+	}
+
+	out, err := c.authUsecase.VerifyMFA(ctx.Request().Context(), in)
+	if err != nil {
+		setRetryAfter(ctx, err)
+		return handleError(err)
+	}
+
+	return c.authPresenter.VerifyMFA(ctx, out)
+}
+
+func (c *AuthController) EnrollMFA(ctx echo.Context) error {
+	var req api.MFAEnrollRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.EnrollMFAInput{
+		MFAToken: req.MfaToken,
+	}
+
+	out, err := c.authUsecase.EnrollMFA(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.EnrollMFA(ctx, out)
+}
+
+func (c *AuthController) VerifyEmail(ctx echo.Context) error {
+	var req api.VerifyEmailRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
 	in := &input.VerifyEmailInput{
@@ -113,12 +145,10 @@ func (c *AuthController) RefreshToken(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if req.RefreshToken == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "refresh_token is required")
-	}
-
 	in := &input.RefreshTokenInput{
 		RefreshToken: req.RefreshToken,
+		IPAddress:    ctx.RealIP(),
+		UserAgent:    ctx.Request().UserAgent(),
 	}
 
 	out, err := c.authUsecase.RefreshToken(ctx.Request().Context(), in)
@@ -129,9 +159,42 @@ func (c *AuthController) RefreshToken(ctx echo.Context) error {
 	return c.authPresenter.RefreshToken(ctx, out)
 }
 
+func (c *AuthController) UnlockUser(ctx echo.Context, userID string) error {
+	adminID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || adminID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	in := &input.UnlockUserInput{
+		Role:     role,
+		TenantID: tenantID,
+		UserID:   userID,
+	}
+
+	if err := c.authUsecase.UnlockUser(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.authPresenter.UnlockUser(ctx)
+}
+
+// setRetryAfter copies the wait of a throttled or locked login to the Retry-After header
+func setRetryAfter(ctx echo.Context, err error) {
+	var appErr *cerror.AppError
+	if !errors.As(err, &appErr) {
+		return
+	}
+	if seconds, ok := appErr.Details["retry_after_seconds"].(int); ok {
+		ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(seconds))
+	}
+}
+
 func handleError(err error) error {
 	if appErr, ok := err.(*cerror.AppError); ok {
-		return echo.NewHTTPError(appErr.HTTPStatus, appErr.Message)
+		// Kept so the error handler can render the code and details
+		return echo.NewHTTPError(appErr.HTTPStatus, appErr.Message).WithInternal(appErr)
 	}
-	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
+	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error").WithInternal(err)
 }
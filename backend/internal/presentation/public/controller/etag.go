@@ -0,0 +1,40 @@
+package controller
+
+import (
+	"strconv"
+	"strings"
+)
+
+// parseIfMatch returns the versions listed in an If-Match header.
+// "*" matches any version and yields nil (no precondition). Weak and malformed
+// ETags never match, so a header with none of them yields an empty slice.
+func parseIfMatch(header string) []int {
+	versions := []int{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return nil
+		}
+		// If-Match uses the strong comparison
+		if !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+			continue
+		}
+		v, err := strconv.Atoi(strings.Trim(tag, `"`))
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+// etagMatches reports whether an If-None-Match header matches etag (weak comparison)
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
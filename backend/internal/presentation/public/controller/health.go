@@ -0,0 +1,33 @@
+package controller
+
+import (
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthController struct {
+	healthUsecase   usecase.IHealthInteractor
+	healthPresenter presenter.IHealthPresenter
+}
+
+func NewHealthController(
+	healthUsecase usecase.IHealthInteractor,
+	healthPresenter presenter.IHealthPresenter,
+) *HealthController {
+	return &HealthController{
+		healthUsecase:   healthUsecase,
+		healthPresenter: healthPresenter,
+	}
+}
+
+// Livez does not check dependencies: restarting the process would not fix them
+func (c *HealthController) Livez(ctx echo.Context) error {
+	return c.healthPresenter.Livez(ctx)
+}
+
+func (c *HealthController) Readyz(ctx echo.Context) error {
+	out := c.healthUsecase.Ready(ctx.Request().Context())
+	return c.healthPresenter.Readyz(ctx, out)
+}
@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+const mimeMergePatchJSON = "application/merge-patch+json"
+
+// decodeMergePatch reads a JSON Merge Patch (RFC 7396) body into its members
+func decodeMergePatch(ctx echo.Context) (map[string]json.RawMessage, error) {
+	mediaType, _, _ := mime.ParseMediaType(ctx.Request().Header.Get(echo.HeaderContentType))
+	if mediaType != mimeMergePatchJSON {
+		return nil, echo.NewHTTPError(http.StatusUnsupportedMediaType, "content type must be "+mimeMergePatchJSON)
+	}
+
+	var patch map[string]json.RawMessage
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&patch); err != nil || patch == nil {
+		// A patch that is not an object would replace the whole resource
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "request body must be a JSON object")
+	}
+	return patch, nil
+}
+
+// patchField tells apart a member that is absent, null or set
+func patchField[T any](patch map[string]json.RawMessage, name string) (input.Optional[T], error) {
+	raw, ok := patch[name]
+	if !ok {
+		return input.Optional[T]{}, nil
+	}
+	if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+		return input.Null[T](), nil
+	}
+
+	var v T
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return input.Optional[T]{}, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid value for %s", name))
+	}
+	return input.Some(v), nil
+}
@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MFAController struct {
+	mfaUsecase   usecase.IMFAInteractor
+	mfaPresenter presenter.IMFAPresenter
+}
+
+func NewMFAController(
+	mfaUsecase usecase.IMFAInteractor,
+	mfaPresenter presenter.IMFAPresenter,
+) *MFAController {
+	return &MFAController{
+		mfaUsecase:   mfaUsecase,
+		mfaPresenter: mfaPresenter,
+	}
+}
+
+func (c *MFAController) Enroll(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	in := &input.MFAUserInput{
+		TenantID: tenantID,
+		UserID:   userID,
+	}
+
+	out, err := c.mfaUsecase.Enroll(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.mfaPresenter.Enroll(ctx, out)
+}
+
+func (c *MFAController) Confirm(ctx echo.Context) error {
+	in, err := bindMFACodeInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.mfaUsecase.Confirm(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.mfaPresenter.Confirm(ctx, out)
+}
+
+func (c *MFAController) Disable(ctx echo.Context) error {
+	in, err := bindMFACodeInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.mfaUsecase.Disable(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.mfaPresenter.Disable(ctx)
+}
+
+func (c *MFAController) SetTenantRequirement(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.MFAPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetMFARequirementInput{
+		Role:     role,
+		TenantID: tenantID,
+		Required: req.Required,
+	}
+
+	if err := c.mfaUsecase.SetTenantRequirement(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.mfaPresenter.SetTenantRequirement(ctx)
+}
+
+func bindMFACodeInput(ctx echo.Context) (*input.MFACodeInput, error) {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	var req api.MFACodeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	return &input.MFACodeInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Code:     req.Code,
+	}, nil
+}
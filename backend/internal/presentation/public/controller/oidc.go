@@ -0,0 +1,140 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type OIDCController struct {
+	oidcUsecase   usecase.IOIDCInteractor
+	oidcPresenter presenter.IOIDCPresenter
+}
+
+func NewOIDCController(
+	oidcUsecase usecase.IOIDCInteractor,
+	oidcPresenter presenter.IOIDCPresenter,
+) *OIDCController {
+	return &OIDCController{
+		oidcUsecase:   oidcUsecase,
+		oidcPresenter: oidcPresenter,
+	}
+}
+
+func (c *OIDCController) Start(ctx echo.Context, tenantSlug string) error {
+	out, err := c.oidcUsecase.Start(ctx.Request().Context(), &input.OIDCStartInput{
+		TenantSlug: tenantSlug,
+	})
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.oidcPresenter.Start(ctx, out)
+}
+
+func (c *OIDCController) Callback(ctx echo.Context, tenantSlug string, params api.OidcCallbackParams) error {
+	in := &input.OIDCCallbackInput{
+		TenantSlug: tenantSlug,
+		IPAddress:  ctx.RealIP(),
+		UserAgent:  ctx.Request().UserAgent(),
+	}
+	if params.Code != nil {
+		in.Code = *params.Code
+	}
+	if params.State != nil {
+		in.State = *params.State
+	}
+	if params.Error != nil {
+		in.Error = *params.Error
+	}
+	if params.OidcState != nil {
+		in.StateToken = *params.OidcState
+	}
+
+	out, err := c.oidcUsecase.Callback(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.oidcPresenter.Callback(ctx, out)
+}
+
+func (c *OIDCController) GetConfig(ctx echo.Context) error {
+	in, err := oidcConfigInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.oidcUsecase.GetConfig(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.oidcPresenter.GetConfig(ctx, out)
+}
+
+func (c *OIDCController) SaveConfig(ctx echo.Context) error {
+	scope, err := oidcConfigInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req api.OIDCConfigRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SaveOIDCConfigInput{
+		Role:           scope.Role,
+		TenantID:       scope.TenantID,
+		Issuer:         req.Issuer,
+		ClientID:       req.ClientId,
+		AllowedDomains: req.AllowedDomains,
+	}
+	if req.ClientSecret != nil {
+		in.ClientSecret = *req.ClientSecret
+	}
+	if req.DefaultRole != nil {
+		in.DefaultRole = string(*req.DefaultRole)
+	}
+
+	out, err := c.oidcUsecase.SaveConfig(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.oidcPresenter.SaveConfig(ctx, out)
+}
+
+func (c *OIDCController) DeleteConfig(ctx echo.Context) error {
+	in, err := oidcConfigInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := c.oidcUsecase.DeleteConfig(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.oidcPresenter.DeleteConfig(ctx)
+}
+
+func oidcConfigInput(ctx echo.Context) (*input.OIDCConfigInput, error) {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	return &input.OIDCConfigInput{
+		Role:     role,
+		TenantID: tenantID,
+	}, nil
+}
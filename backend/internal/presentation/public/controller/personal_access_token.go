@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PersonalAccessTokenController struct {
+	tokenUsecase   usecase.IPersonalAccessTokenInteractor
+	tokenPresenter presenter.IPersonalAccessTokenPresenter
+}
+
+func NewPersonalAccessTokenController(
+	tokenUsecase usecase.IPersonalAccessTokenInteractor,
+	tokenPresenter presenter.IPersonalAccessTokenPresenter,
+) *PersonalAccessTokenController {
+	return &PersonalAccessTokenController{
+		tokenUsecase:   tokenUsecase,
+		tokenPresenter: tokenPresenter,
+	}
+}
+
+func (c *PersonalAccessTokenController) Create(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	var req api.CreatePersonalAccessTokenRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.CreatePersonalAccessTokenInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Name:     req.Name,
+		Scopes:   make([]string, len(req.Scopes)),
+	}
+	for i, s := range req.Scopes {
+		in.Scopes[i] = string(s)
+	}
+	if req.ExpiresInDays != nil {
+		in.ExpiresInDays = *req.ExpiresInDays
+	}
+
+	out, err := c.tokenUsecase.Create(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tokenPresenter.Create(ctx, out)
+}
+
+func (c *PersonalAccessTokenController) List(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	in := &input.PersonalAccessTokenUserInput{
+		TenantID: tenantID,
+		UserID:   userID,
+	}
+
+	out, err := c.tokenUsecase.List(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.tokenPresenter.List(ctx, out)
+}
+
+func (c *PersonalAccessTokenController) Revoke(ctx echo.Context, tokenID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	in := &input.RevokePersonalAccessTokenInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		TokenID:  tokenID,
+	}
+
+	if err := c.tokenUsecase.Revoke(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.tokenPresenter.Revoke(ctx)
+}
@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/presentation/public/presenter"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SessionController struct {
+	sessionUsecase   usecase.ISessionInteractor
+	sessionPresenter presenter.ISessionPresenter
+}
+
+func NewSessionController(
+	sessionUsecase usecase.ISessionInteractor,
+	sessionPresenter presenter.ISessionPresenter,
+) *SessionController {
+	return &SessionController{
+		sessionUsecase:   sessionUsecase,
+		sessionPresenter: sessionPresenter,
+	}
+}
+
+func (c *SessionController) List(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	sessionID, _ := ctx.Get(context_keys.SessionIDContextKey).(string)
+
+	in := &input.SessionUserInput{
+		TenantID:         tenantID,
+		UserID:           userID,
+		CurrentSessionID: sessionID,
+	}
+
+	out, err := c.sessionUsecase.List(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.sessionPresenter.List(ctx, out)
+}
+
+func (c *SessionController) Revoke(ctx echo.Context, sessionID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	in := &input.RevokeSessionInput{
+		TenantID:  tenantID,
+		UserID:    userID,
+		SessionID: sessionID,
+	}
+
+	if err := c.sessionUsecase.Revoke(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.sessionPresenter.Revoke(ctx)
+}
+
+func (c *SessionController) RevokeAll(ctx echo.Context, params api.RevokeAllSessionsParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	sessionID, _ := ctx.Get(context_keys.SessionIDContextKey).(string)
+
+	in := &input.RevokeAllSessionsInput{
+		TenantID:         tenantID,
+		UserID:           userID,
+		CurrentSessionID: sessionID,
+		ExceptCurrent:    params.ExceptCurrent != nil && *params.ExceptCurrent,
+	}
+
+	revoked, err := c.sessionUsecase.RevokeAll(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.sessionPresenter.RevokeAll(ctx, revoked)
+}
+
+func (c *SessionController) ForceLogout(ctx echo.Context, userID string) error {
+	adminID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || adminID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	in := &input.ForceLogoutInput{
+		Role:     role,
+		TenantID: tenantID,
+		UserID:   userID,
+	}
+
+	revoked, err := c.sessionUsecase.ForceLogout(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.sessionPresenter.RevokeAll(ctx, revoked)
+}
@@ -80,7 +80,7 @@ func (c *TodoController) GetPublicTodos(ctx echo.Context, params api.GetPublicTo
 	return c.todoPresenter.GetTodos(ctx, out)
 }
 
-func (c *TodoController) GetTodo(ctx echo.Context, todoID string) error {
+func (c *TodoController) GetTodo(ctx echo.Context, todoID string, params api.GetTodoParams) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
@@ -91,6 +91,10 @@ func (c *TodoController) GetTodo(ctx echo.Context, todoID string) error {
 		return handleError(err)
 	}
 
+	if params.IfNoneMatch != nil && etagMatches(*params.IfNoneMatch, presenter.TodoETag(out.Version)) {
+		return c.todoPresenter.NotModified(ctx, out)
+	}
+
 	return c.todoPresenter.GetTodo(ctx, out)
 }
 
@@ -110,10 +114,6 @@ func (c *TodoController) CreateTodo(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
 	}
 
-	if req.Title == "" {
-		return echo.NewHTTPError(http.StatusBadRequest, "title is required")
-	}
-
 	description := ""
 	if req.Description != nil {
 		description = *req.Description
@@ -146,7 +146,7 @@ func (c *TodoController) CreateTodo(ctx echo.Context) error {
 	return c.todoPresenter.CreateTodo(ctx, out)
 }
 
-func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
+func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string, params api.UpdateTodoParams) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
 		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
@@ -160,11 +160,56 @@ func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
 	in := &input.UpdateTodoInput{
 		TodoID:      todoID,
 		UserID:      userID,
-		Title:       req.Title,
-		Description: req.Description,
-		Completed:   req.Completed,
-		IsPublic:    req.IsPublic,
-		DueDate:     req.DueDate,
+		Title:       input.FromPtr(req.Title),
+		Description: input.FromPtr(req.Description),
+		Completed:   input.FromPtr(req.Completed),
+		IsPublic:    input.FromPtr(req.IsPublic),
+		DueDate:     input.FromPtr(req.DueDate),
+	}
+	if params.IfMatch != nil {
+		in.IfMatchVersions = parseIfMatch(*params.IfMatch)
+	}
+
+	out, err := c.todoUsecase.UpdateTodo(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.UpdateTodo(ctx, out)
+}
+
+func (c *TodoController) PatchTodo(ctx echo.Context, todoID string, params api.PatchTodoParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	patch, err := decodeMergePatch(ctx)
+	if err != nil {
+		return err
+	}
+
+	in := &input.UpdateTodoInput{
+		TodoID: todoID,
+		UserID: userID,
+	}
+	if in.Title, err = patchField[string](patch, "title"); err != nil {
+		return err
+	}
+	if in.Description, err = patchField[string](patch, "description"); err != nil {
+		return err
+	}
+	if in.Completed, err = patchField[bool](patch, "completed"); err != nil {
+		return err
+	}
+	if in.IsPublic, err = patchField[bool](patch, "is_public"); err != nil {
+		return err
+	}
+	if in.DueDate, err = patchField[time.Time](patch, "due_date"); err != nil {
+		return err
+	}
+	if params.IfMatch != nil {
+		in.IfMatchVersions = parseIfMatch(*params.IfMatch)
 	}
 
 	out, err := c.todoUsecase.UpdateTodo(ctx.Request().Context(), in)
@@ -175,6 +220,89 @@ func (c *TodoController) UpdateTodo(ctx echo.Context, todoID string) error {
 	return c.todoPresenter.UpdateTodo(ctx, out)
 }
 
+func (c *TodoController) BatchTodos(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	tenantID, ok := ctx.Get(context_keys.TenantIDContextKey).(string)
+	if !ok || tenantID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	var req api.TodoBatchRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.BatchTodosInput{
+		UserID:     userID,
+		TenantID:   tenantID,
+		Atomic:     req.Mode == nil || *req.Mode == api.Atomic,
+		Operations: make([]input.BatchTodoOperation, len(req.Operations)),
+	}
+	for i, op := range req.Operations {
+		in.Operations[i] = toBatchTodoOperation(op)
+	}
+
+	out, err := c.todoUsecase.BatchTodos(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.BatchTodos(ctx, out)
+}
+
+func toBatchTodoOperation(op api.TodoBatchOperation) input.BatchTodoOperation {
+	todoID := ""
+	if op.Id != nil {
+		todoID = *op.Id
+	}
+
+	var ifMatch []int
+	if op.Version != nil {
+		ifMatch = []int{*op.Version}
+	}
+
+	in := input.BatchTodoOperation{
+		Op:     input.BatchTodoOp(op.Op),
+		TodoID: todoID,
+	}
+	switch in.Op {
+	case input.BatchTodoOpCreate:
+		in.Create = &input.CreateTodoInput{
+			DueDate: op.DueDate,
+		}
+		if op.Title != nil {
+			in.Create.Title = *op.Title
+		}
+		if op.Description != nil {
+			in.Create.Description = *op.Description
+		}
+		if op.IsPublic != nil {
+			in.Create.IsPublic = *op.IsPublic
+		}
+	case input.BatchTodoOpUpdate:
+		in.Update = &input.UpdateTodoInput{
+			TodoID:          todoID,
+			Title:           input.FromPtr(op.Title),
+			Description:     input.FromPtr(op.Description),
+			Completed:       input.FromPtr(op.Completed),
+			IsPublic:        input.FromPtr(op.IsPublic),
+			DueDate:         input.FromPtr(op.DueDate),
+			IfMatchVersions: ifMatch,
+		}
+	case input.BatchTodoOpComplete:
+		in.IfMatchVersions = ifMatch
+	case input.BatchTodoOpMove:
+		if op.To != nil {
+			in.MoveTo = string(*op.To)
+		}
+	}
+	return in
+}
+
 func (c *TodoController) DeleteTodo(ctx echo.Context, todoID string) error {
 	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
 	if !ok || userID == "" {
@@ -187,3 +315,59 @@ func (c *TodoController) DeleteTodo(ctx echo.Context, todoID string) error {
 
 	return c.todoPresenter.DeleteTodo(ctx)
 }
+
+func (c *TodoController) GetTrash(ctx echo.Context, params api.GetTrashParams) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	limit := 20
+	offset := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	in := &input.GetTrashInput{
+		UserID: userID,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	out, err := c.todoUsecase.GetTrash(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodos(ctx, out)
+}
+
+func (c *TodoController) RestoreTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	out, err := c.todoUsecase.RestoreTodo(ctx.Request().Context(), todoID, userID)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.GetTodo(ctx, out)
+}
+
+func (c *TodoController) PurgeTodo(ctx echo.Context, todoID string) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if err := c.todoUsecase.PurgeTodo(ctx.Request().Context(), todoID, userID); err != nil {
+		return handleError(err)
+	}
+
+	return c.todoPresenter.DeleteTodo(ctx)
+}
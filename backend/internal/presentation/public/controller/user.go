@@ -54,7 +54,7 @@ func (c *UserController) UpdateMe(ctx echo.Context) error {
 
 	in := &input.UpdateUserInput{
 		UserID: userID,
-		Name:   req.Name,
+		Name:   input.FromPtr(req.Name),
 	}
 
 	out, err := c.userUsecase.UpdateMe(ctx.Request().Context(), in)
@@ -64,3 +64,194 @@ func (c *UserController) UpdateMe(ctx echo.Context) error {
 
 	return c.userPresenter.UpdateMe(ctx, out)
 }
+
+func (c *UserController) PatchMe(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	patch, err := decodeMergePatch(ctx)
+	if err != nil {
+		return err
+	}
+
+	in := &input.UpdateUserInput{
+		UserID: userID,
+	}
+	if in.Name, err = patchField[string](patch, "name"); err != nil {
+		return err
+	}
+
+	out, err := c.userUsecase.UpdateMe(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.UpdateMe(ctx, out)
+}
+
+func (c *UserController) ChangePassword(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	sessionID, _ := ctx.Get(context_keys.SessionIDContextKey).(string)
+
+	var req api.ChangePasswordRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.ChangePasswordInput{
+		TenantID:         tenantID,
+		UserID:           userID,
+		CurrentSessionID: sessionID,
+		CurrentPassword:  req.CurrentPassword,
+		NewPassword:      req.NewPassword,
+	}
+
+	if err := c.userUsecase.ChangePassword(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.ChangePassword(ctx)
+}
+
+func (c *UserController) RequestEmailChange(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	var req api.ChangeEmailRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.RequestEmailChangeInput{
+		TenantID:        tenantID,
+		UserID:          userID,
+		NewEmail:        string(req.NewEmail),
+		CurrentPassword: req.CurrentPassword,
+	}
+
+	out, err := c.userUsecase.RequestEmailChange(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.RequestEmailChange(ctx, out)
+}
+
+func (c *UserController) ConfirmEmailChange(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+
+	var req api.ConfirmEmailChangeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.ConfirmEmailChangeInput{
+		TenantID: tenantID,
+		UserID:   userID,
+		Token:    req.Token,
+	}
+
+	out, err := c.userUsecase.ConfirmEmailChange(ctx.Request().Context(), in)
+	if err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.ConfirmEmailChange(ctx, out)
+}
+
+func (c *UserController) SetPasswordPolicy(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.PasswordPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetPasswordPolicyInput{
+		Role:      role,
+		TenantID:  tenantID,
+		MinLength: req.MinLength,
+	}
+	if req.MinCharacterClasses != nil {
+		in.MinCharacterClasses = *req.MinCharacterClasses
+	}
+	if req.History != nil {
+		in.History = *req.History
+	}
+
+	if err := c.userUsecase.SetPasswordPolicy(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.SetPasswordPolicy(ctx)
+}
+
+func (c *UserController) SetRateLimit(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.RateLimitPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetRateLimitInput{
+		Role:      role,
+		TenantID:  tenantID,
+		PerMinute: req.RequestsPerMinute,
+	}
+
+	if err := c.userUsecase.SetRateLimit(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.SetRateLimit(ctx)
+}
+
+func (c *UserController) SetTrashRetention(ctx echo.Context) error {
+	userID, ok := ctx.Get(context_keys.UserIDContextKey).(string)
+	if !ok || userID == "" {
+		return echo.NewHTTPError(http.StatusUnauthorized, "unauthorized")
+	}
+	tenantID, _ := ctx.Get(context_keys.TenantIDContextKey).(string)
+	role, _ := ctx.Get(context_keys.RoleContextKey).(string)
+
+	var req api.TrashRetentionPolicyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	}
+
+	in := &input.SetTrashRetentionInput{
+		Role:     role,
+		TenantID: tenantID,
+		Days:     req.Days,
+	}
+
+	if err := c.userUsecase.SetTrashRetention(ctx.Request().Context(), in); err != nil {
+		return handleError(err)
+	}
+
+	return c.userPresenter.SetTrashRetention(ctx)
+}
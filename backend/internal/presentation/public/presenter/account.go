@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"fmt"
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAccountPresenter interface {
+	Export(ctx echo.Context, out *output.AccountExportOutput) error
+	SendDeletionCode(ctx echo.Context) error
+	Delete(ctx echo.Context) error
+	SetDeletionPolicy(ctx echo.Context) error
+}
+
+type AccountPresenter struct{}
+
+func NewAccountPresenter() IAccountPresenter {
+	return &AccountPresenter{}
+}
+
+// Export is sent as an attachment so browsers save it as a file
+func (p *AccountPresenter) Export(ctx echo.Context, out *output.AccountExportOutput) error {
+	res := &api.AccountExportResponse{
+		ExportedAt:           out.ExportedAt,
+		User:                 *toUserResponse(out.User),
+		Todos:                make([]api.TodoResponse, len(out.Todos)),
+		Sessions:             make([]api.SessionResponse, len(out.Sessions)),
+		PersonalAccessTokens: make([]api.PersonalAccessTokenResponse, len(out.PersonalAccessTokens)),
+	}
+	for i, t := range out.Todos {
+		res.Todos[i] = *toTodoResponse(t)
+	}
+	for i, s := range out.Sessions {
+		res.Sessions[i] = toSessionResponse(s)
+	}
+	for i, t := range out.PersonalAccessTokens {
+		res.PersonalAccessTokens[i] = *toPersonalAccessTokenResponse(t)
+	}
+
+	filename := fmt.Sprintf("good-todo-export-%s.json", out.ExportedAt.UTC().Format("20060102"))
+	ctx.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func (p *AccountPresenter) SendDeletionCode(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *AccountPresenter) Delete(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *AccountPresenter) SetDeletionPolicy(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"net/http"
+	"time"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IAuditPresenter interface {
+	GetAuditEvents(ctx echo.Context, out *output.AuditEventListOutput) error
+}
+
+type AuditPresenter struct{}
+
+func NewAuditPresenter() IAuditPresenter {
+	return &AuditPresenter{}
+}
+
+func (p *AuditPresenter) GetAuditEvents(ctx echo.Context, out *output.AuditEventListOutput) error {
+	events := make([]api.AuditEventResponse, len(out.Events))
+	for i, e := range out.Events {
+		events[i] = *toAuditEventResponse(e)
+	}
+
+	return ctx.JSON(http.StatusOK, api.AuditEventListResponse{
+		Events: &events,
+		Total:  &out.Total,
+	})
+}
+
+func toAuditEventResponse(out *output.AuditEventOutput) *api.AuditEventResponse {
+	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
+	action := api.AuditEventResponseAction(out.Action)
+
+	resp := &api.AuditEventResponse{
+		Id:         &out.ID,
+		ActorId:    out.ActorID,
+		Action:     &action,
+		EntityType: &out.EntityType,
+		EntityId:   &out.EntityID,
+		RequestId:  out.RequestID,
+		Ip:         out.IP,
+		CreatedAt:  &createdAt,
+	}
+
+	if out.Before != nil {
+		resp.Before = &out.Before
+	}
+	if out.After != nil {
+		resp.After = &out.After
+	}
+
+	return resp
+}
@@ -15,6 +15,9 @@ type IAuthPresenter interface {
 	Login(ctx echo.Context, out *output.AuthOutput) error
 	VerifyEmail(ctx echo.Context, out *output.VerifyEmailOutput) error
 	RefreshToken(ctx echo.Context, out *output.AuthOutput) error
+	UnlockUser(ctx echo.Context) error
+	VerifyMFA(ctx echo.Context, out *output.AuthOutput) error
+	EnrollMFA(ctx echo.Context, out *output.MFAEnrollmentOutput) error
 }
 
 type AuthPresenter struct{}
@@ -41,7 +44,33 @@ func (p *AuthPresenter) RefreshToken(ctx echo.Context, out *output.AuthOutput) e
 	return ctx.JSON(http.StatusOK, toAuthResponse(out))
 }
 
+func (p *AuthPresenter) UnlockUser(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *AuthPresenter) VerifyMFA(ctx echo.Context, out *output.AuthOutput) error {
+	return ctx.JSON(http.StatusOK, toAuthResponse(out))
+}
+
+func (p *AuthPresenter) EnrollMFA(ctx echo.Context, out *output.MFAEnrollmentOutput) error {
+	return ctx.JSON(http.StatusOK, toMFAEnrollmentResponse(out))
+}
+
 func toAuthResponse(out *output.AuthOutput) *api.AuthResponse {
+	// A challenge carries no tokens and no user until the second factor
+	if out.MFARequired {
+		return &api.AuthResponse{
+			MfaRequired:           &out.MFARequired,
+			MfaToken:              &out.MFAToken,
+			MfaEnrollmentRequired: &out.MFAEnrollmentRequired,
+		}
+	}
+
+	var recoveryCodes *[]string
+	if len(out.RecoveryCodes) > 0 {
+		recoveryCodes = &out.RecoveryCodes
+	}
+
 	role := api.UserResponseRole(out.User.Role)
 	createdAt, _ := time.Parse(time.RFC3339, out.User.CreatedAt)
 	updatedAt, _ := time.Parse(time.RFC3339, out.User.UpdatedAt)
@@ -60,5 +89,13 @@ func toAuthResponse(out *output.AuthOutput) *api.AuthResponse {
 			CreatedAt:     &createdAt,
 			UpdatedAt:     &updatedAt,
 		},
+		RecoveryCodes: recoveryCodes,
+	}
+}
+
+func toMFAEnrollmentResponse(out *output.MFAEnrollmentOutput) *api.MFAEnrollmentResponse {
+	return &api.MFAEnrollmentResponse{
+		Secret:     out.Secret,
+		OtpauthUri: out.URI,
 	}
 }
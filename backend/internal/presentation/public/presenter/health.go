@@ -0,0 +1,54 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IHealthPresenter interface {
+	Livez(ctx echo.Context) error
+	Readyz(ctx echo.Context, out *output.ReadinessOutput) error
+}
+
+type HealthPresenter struct{}
+
+func NewHealthPresenter() IHealthPresenter {
+	return &HealthPresenter{}
+}
+
+func (p *HealthPresenter) Livez(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, &api.Liveness{Status: "ok"})
+}
+
+// Readyz answers 503 unless every check passed, for load balancers to take the instance out
+func (p *HealthPresenter) Readyz(ctx echo.Context, out *output.ReadinessOutput) error {
+	res := &api.Readiness{Status: api.ReadinessStatusOk, Checks: map[string]api.HealthCheck{}}
+	for _, c := range out.Checks {
+		check := api.HealthCheck{
+			Status:    api.HealthCheckStatusOk,
+			LatencyMs: float64(c.Latency.Microseconds()) / 1000,
+		}
+		if !c.OK {
+			check.Status = api.HealthCheckStatusFail
+			check.Error = &c.Error
+		}
+		res.Checks[c.Name] = check
+	}
+
+	status := http.StatusOK
+	switch {
+	case out.Draining:
+		res.Status = api.ReadinessStatusDraining
+		status = http.StatusServiceUnavailable
+	case !out.Ready:
+		res.Status = api.ReadinessStatusFail
+		status = http.StatusServiceUnavailable
+	}
+	// Probes must see the current state
+	ctx.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return ctx.JSON(status, res)
+}
@@ -0,0 +1,41 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IMFAPresenter interface {
+	Enroll(ctx echo.Context, out *output.MFAEnrollmentOutput) error
+	Confirm(ctx echo.Context, out *output.MFARecoveryCodesOutput) error
+	Disable(ctx echo.Context) error
+	SetTenantRequirement(ctx echo.Context) error
+}
+
+type MFAPresenter struct{}
+
+func NewMFAPresenter() IMFAPresenter {
+	return &MFAPresenter{}
+}
+
+func (p *MFAPresenter) Enroll(ctx echo.Context, out *output.MFAEnrollmentOutput) error {
+	return ctx.JSON(http.StatusOK, toMFAEnrollmentResponse(out))
+}
+
+func (p *MFAPresenter) Confirm(ctx echo.Context, out *output.MFARecoveryCodesOutput) error {
+	return ctx.JSON(http.StatusOK, &api.MFARecoveryCodesResponse{
+		RecoveryCodes: out.RecoveryCodes,
+	})
+}
+
+func (p *MFAPresenter) Disable(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *MFAPresenter) SetTenantRequirement(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
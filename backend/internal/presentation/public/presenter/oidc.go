@@ -0,0 +1,81 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// OIDCStateCookie keeps the sign-in state between the start and the callback
+	OIDCStateCookie = "oidc_state"
+	oidcCookiePath  = "/auth/oidc/"
+	// oidcStateMaxAge matches the lifetime of the state token
+	oidcStateMaxAge = 10 * 60
+)
+
+type IOIDCPresenter interface {
+	Start(ctx echo.Context, out *output.OIDCStartOutput) error
+	Callback(ctx echo.Context, out *output.AuthOutput) error
+	GetConfig(ctx echo.Context, out *output.OIDCConfigOutput) error
+	SaveConfig(ctx echo.Context, out *output.OIDCConfigOutput) error
+	DeleteConfig(ctx echo.Context) error
+}
+
+type OIDCPresenter struct{}
+
+func NewOIDCPresenter() IOIDCPresenter {
+	return &OIDCPresenter{}
+}
+
+func (p *OIDCPresenter) Start(ctx echo.Context, out *output.OIDCStartOutput) error {
+	// Lax, because the provider sends the user back with a top-level redirect
+	ctx.SetCookie(&http.Cookie{
+		Name:     OIDCStateCookie,
+		Value:    out.StateToken,
+		Path:     oidcCookiePath,
+		MaxAge:   oidcStateMaxAge,
+		HttpOnly: true,
+		Secure:   ctx.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+	return ctx.Redirect(http.StatusFound, out.AuthURL)
+}
+
+func (p *OIDCPresenter) Callback(ctx echo.Context, out *output.AuthOutput) error {
+	ctx.SetCookie(&http.Cookie{
+		Name:     OIDCStateCookie,
+		Path:     oidcCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   ctx.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+	return ctx.JSON(http.StatusOK, toAuthResponse(out))
+}
+
+func (p *OIDCPresenter) GetConfig(ctx echo.Context, out *output.OIDCConfigOutput) error {
+	return ctx.JSON(http.StatusOK, toOIDCConfigResponse(out))
+}
+
+func (p *OIDCPresenter) SaveConfig(ctx echo.Context, out *output.OIDCConfigOutput) error {
+	return ctx.JSON(http.StatusOK, toOIDCConfigResponse(out))
+}
+
+func (p *OIDCPresenter) DeleteConfig(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toOIDCConfigResponse(out *output.OIDCConfigOutput) *api.OIDCConfigResponse {
+	return &api.OIDCConfigResponse{
+		Issuer:         &out.Issuer,
+		ClientId:       &out.ClientID,
+		AllowedDomains: &out.AllowedDomains,
+		DefaultRole:    &out.DefaultRole,
+		RedirectUrl:    &out.RedirectURL,
+		UpdatedAt:      &out.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IPersonalAccessTokenPresenter interface {
+	Create(ctx echo.Context, out *output.CreatedPersonalAccessTokenOutput) error
+	List(ctx echo.Context, out []*output.PersonalAccessTokenOutput) error
+	Revoke(ctx echo.Context) error
+}
+
+type PersonalAccessTokenPresenter struct{}
+
+func NewPersonalAccessTokenPresenter() IPersonalAccessTokenPresenter {
+	return &PersonalAccessTokenPresenter{}
+}
+
+func (p *PersonalAccessTokenPresenter) Create(ctx echo.Context, out *output.CreatedPersonalAccessTokenOutput) error {
+	res := toPersonalAccessTokenResponse(&out.PersonalAccessTokenOutput)
+	return ctx.JSON(http.StatusCreated, &api.CreatedPersonalAccessTokenResponse{
+		Id:         res.Id,
+		Name:       res.Name,
+		Scopes:     res.Scopes,
+		ExpiresAt:  res.ExpiresAt,
+		LastUsedAt: res.LastUsedAt,
+		CreatedAt:  res.CreatedAt,
+		Token:      out.Token,
+	})
+}
+
+func (p *PersonalAccessTokenPresenter) List(ctx echo.Context, out []*output.PersonalAccessTokenOutput) error {
+	tokens := make([]api.PersonalAccessTokenResponse, len(out))
+	for i, t := range out {
+		tokens[i] = *toPersonalAccessTokenResponse(t)
+	}
+	return ctx.JSON(http.StatusOK, &api.PersonalAccessTokenListResponse{Tokens: tokens})
+}
+
+func (p *PersonalAccessTokenPresenter) Revoke(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func toPersonalAccessTokenResponse(out *output.PersonalAccessTokenOutput) *api.PersonalAccessTokenResponse {
+	scopes := make([]api.TokenScope, len(out.Scopes))
+	for i, s := range out.Scopes {
+		scopes[i] = api.TokenScope(s)
+	}
+	return &api.PersonalAccessTokenResponse{
+		Id:         out.ID,
+		Name:       out.Name,
+		Scopes:     scopes,
+		ExpiresAt:  out.ExpiresAt,
+		LastUsedAt: out.LastUsedAt,
+		CreatedAt:  out.CreatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package presenter
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ISessionPresenter interface {
+	List(ctx echo.Context, out []*output.SessionOutput) error
+	Revoke(ctx echo.Context) error
+	RevokeAll(ctx echo.Context, revoked int) error
+}
+
+type SessionPresenter struct{}
+
+func NewSessionPresenter() ISessionPresenter {
+	return &SessionPresenter{}
+}
+
+func (p *SessionPresenter) List(ctx echo.Context, out []*output.SessionOutput) error {
+	sessions := make([]api.SessionResponse, len(out))
+	for i, s := range out {
+		sessions[i] = toSessionResponse(s)
+	}
+	return ctx.JSON(http.StatusOK, &api.SessionListResponse{Sessions: sessions})
+}
+
+func (p *SessionPresenter) Revoke(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *SessionPresenter) RevokeAll(ctx echo.Context, revoked int) error {
+	return ctx.JSON(http.StatusOK, &api.RevokedSessionsResponse{Revoked: revoked})
+}
+
+func toSessionResponse(s *output.SessionOutput) api.SessionResponse {
+	return api.SessionResponse{
+		Id:         s.ID,
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.Current,
+	}
+}
@@ -1,21 +1,27 @@
 package presenter
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
+	"good-todo-go/internal/pkg/cerror"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/usecase/output"
 
 	"github.com/labstack/echo/v4"
 )
 
+const headerETag = "ETag"
+
 type ITodoPresenter interface {
 	GetTodos(ctx echo.Context, out *output.TodoListOutput) error
 	GetTodo(ctx echo.Context, out *output.TodoOutput) error
 	CreateTodo(ctx echo.Context, out *output.TodoOutput) error
 	UpdateTodo(ctx echo.Context, out *output.TodoOutput) error
 	DeleteTodo(ctx echo.Context) error
+	NotModified(ctx echo.Context, out *output.TodoOutput) error
+	BatchTodos(ctx echo.Context, out *output.BatchTodosOutput) error
 }
 
 type TodoPresenter struct{}
@@ -37,21 +43,62 @@ func (p *TodoPresenter) GetTodos(ctx echo.Context, out *output.TodoListOutput) e
 }
 
 func (p *TodoPresenter) GetTodo(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set(headerETag, TodoETag(out.Version))
 	return ctx.JSON(http.StatusOK, toTodoResponse(out))
 }
 
 func (p *TodoPresenter) CreateTodo(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set(headerETag, TodoETag(out.Version))
 	return ctx.JSON(http.StatusCreated, toTodoResponse(out))
 }
 
 func (p *TodoPresenter) UpdateTodo(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set(headerETag, TodoETag(out.Version))
 	return ctx.JSON(http.StatusOK, toTodoResponse(out))
 }
 
+func (p *TodoPresenter) NotModified(ctx echo.Context, out *output.TodoOutput) error {
+	ctx.Response().Header().Set(headerETag, TodoETag(out.Version))
+	return ctx.NoContent(http.StatusNotModified)
+}
+
+// TodoETag formats a todo version as a strong ETag
+func TodoETag(version int) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
 func (p *TodoPresenter) DeleteTodo(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+func (p *TodoPresenter) BatchTodos(ctx echo.Context, out *output.BatchTodosOutput) error {
+	results := make([]api.TodoBatchResult, len(out.Results))
+	for i, r := range out.Results {
+		results[i] = api.TodoBatchResult{
+			Index:  r.Index,
+			Op:     r.Op,
+			Status: r.Status,
+		}
+		if r.Todo != nil {
+			results[i].Todo = toTodoResponse(r.Todo)
+		}
+		if r.ErrorCode != "" {
+			code := cerror.ErrorCode(r.ErrorCode)
+			results[i].Error = &api.Problem{
+				Type:   cerror.ProblemType(code),
+				Title:  http.StatusText(r.Status),
+				Status: r.Status,
+				Detail: &r.ErrorMessage,
+				Code:   api.ErrorCode(code),
+			}
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, api.TodoBatchResponse{
+		Results: &results,
+	})
+}
+
 func toTodoResponse(out *output.TodoOutput) *api.TodoResponse {
 	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
 	updatedAt, _ := time.Parse(time.RFC3339, out.UpdatedAt)
@@ -63,6 +110,7 @@ func toTodoResponse(out *output.TodoOutput) *api.TodoResponse {
 		Description: &out.Description,
 		Completed:   &out.Completed,
 		IsPublic:    &out.IsPublic,
+		Version:     &out.Version,
 		CreatedAt:   &createdAt,
 		UpdatedAt:   &updatedAt,
 	}
@@ -72,6 +120,11 @@ func toTodoResponse(out *output.TodoOutput) *api.TodoResponse {
 		resp.DueDate = &dueDate
 	}
 
+	if out.DeletedAt != nil {
+		deletedAt, _ := time.Parse(time.RFC3339, *out.DeletedAt)
+		resp.DeletedAt = &deletedAt
+	}
+
 	if out.CreatedBy != nil {
 		resp.CreatedBy = &api.TodoCreator{
 			Id:   out.CreatedBy.ID,
@@ -13,6 +13,12 @@ import (
 type IUserPresenter interface {
 	GetMe(ctx echo.Context, out *output.UserOutput) error
 	UpdateMe(ctx echo.Context, out *output.UserOutput) error
+	ChangePassword(ctx echo.Context) error
+	RequestEmailChange(ctx echo.Context, out *output.UserOutput) error
+	ConfirmEmailChange(ctx echo.Context, out *output.UserOutput) error
+	SetPasswordPolicy(ctx echo.Context) error
+	SetRateLimit(ctx echo.Context) error
+	SetTrashRetention(ctx echo.Context) error
 }
 
 type UserPresenter struct{}
@@ -29,6 +35,19 @@ func (p *UserPresenter) UpdateMe(ctx echo.Context, out *output.UserOutput) error
 	return ctx.JSON(http.StatusOK, toUserResponse(out))
 }
 
+func (p *UserPresenter) ChangePassword(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// RequestEmailChange answers 202: the email changes only once it is confirmed
+func (p *UserPresenter) RequestEmailChange(ctx echo.Context, out *output.UserOutput) error {
+	return ctx.JSON(http.StatusAccepted, toUserResponse(out))
+}
+
+func (p *UserPresenter) ConfirmEmailChange(ctx echo.Context, out *output.UserOutput) error {
+	return ctx.JSON(http.StatusOK, toUserResponse(out))
+}
+
 func toUserResponse(out *output.UserOutput) *api.UserResponse {
 	role := api.UserResponseRole(out.Role)
 	createdAt, _ := time.Parse(time.RFC3339, out.CreatedAt)
@@ -39,8 +58,21 @@ func toUserResponse(out *output.UserOutput) *api.UserResponse {
 		Name:          &out.Name,
 		Role:          &role,
 		EmailVerified: &out.EmailVerified,
+		PendingEmail:  out.PendingEmail,
 		TenantId:      &out.TenantID,
 		CreatedAt:     &createdAt,
 		UpdatedAt:     &updatedAt,
 	}
 }
+
+func (p *UserPresenter) SetPasswordPolicy(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *UserPresenter) SetRateLimit(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func (p *UserPresenter) SetTrashRetention(ctx echo.Context) error {
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,19 @@
+package router
+
+import "github.com/labstack/echo/v4"
+
+func (s *Server) ExportMe(c echo.Context) error {
+	return s.accountController.Export(c)
+}
+
+func (s *Server) SendDeletionCode(c echo.Context) error {
+	return s.accountController.SendDeletionCode(c)
+}
+
+func (s *Server) DeleteMe(c echo.Context) error {
+	return s.accountController.Delete(c)
+}
+
+func (s *Server) SetTenantDeletionPolicy(c echo.Context) error {
+	return s.accountController.SetDeletionPolicy(c)
+}
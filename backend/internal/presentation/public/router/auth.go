@@ -19,3 +19,7 @@ func (s *Server) Register(c echo.Context) error {
 func (s *Server) VerifyEmail(c echo.Context) error {
 	return s.authController.VerifyEmail(c)
 }
+
+func (s *Server) UnlockUser(c echo.Context, userId string) error {
+	return s.authController.UnlockUser(c, userId)
+}
@@ -5,4 +5,6 @@ const (
 	TenantIDContextKey = "tenant_id"
 	EmailContextKey    = "email"
 	RoleContextKey     = "role"
+	// SessionIDContextKey is empty for personal access tokens and tokens issued before sessions
+	SessionIDContextKey = "session_id"
 )
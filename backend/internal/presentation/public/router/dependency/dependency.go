@@ -1,14 +1,31 @@
 package dependency
 
 import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+
+	domainRepository "good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/ent/migrate/migrations"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/environment"
 	"good-todo-go/internal/infrastructure/repository"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/breach"
+	"good-todo-go/internal/pkg/logger"
+	"good-todo-go/internal/pkg/mailer"
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/pkg/oidc"
+	"good-todo-go/internal/pkg/ratelimit"
+	"good-todo-go/internal/pkg/tracing"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/presenter"
 	"good-todo-go/internal/usecase"
 
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/dig"
 )
 
@@ -18,34 +35,192 @@ func BuildContainer() *dig.Container {
 	// environment
 	container.Provide(environment.LoadConfig)
 
+	// logger
+	container.Provide(func(cfg *environment.Config) (*slog.Logger, error) {
+		return logger.New(os.Stdout, cfg.LogLevel)
+	})
+
+	// tracing
+	container.Provide(func(cfg *environment.Config) (*sdktrace.TracerProvider, error) {
+		return tracing.NewTracerProvider(context.Background(), tracing.Config{
+			ServiceName:  cfg.TracingServiceName,
+			Exporter:     cfg.TracingExporter,
+			OTLPEndpoint: cfg.TracingOTLPEndpoint,
+			SampleRatio:  cfg.TracingSampleRatio,
+			Stdout:       os.Stdout,
+		})
+	})
+	container.Provide(func(tp *sdktrace.TracerProvider) trace.TracerProvider {
+		return tp
+	})
+
+	// metrics
+	container.Provide(metrics.NewRegistry)
+	container.Provide(func(registry *prometheus.Registry) prometheus.Registerer {
+		return registry
+	})
+	container.Provide(metrics.New)
+
 	// infrastructure
 	container.Provide(database.NewEntClient)
+	// read replica for todo listings; nil when POSTGRES_DB_REPLICA_DSN is not set
+	container.Provide(database.NewReadReplica)
 
 	// pkg
-	container.Provide(func(cfg *environment.Config) *pkg.JWTService {
-		return pkg.NewJWTService(cfg.JWTSecret, cfg.JWTExpiresIn, cfg.JWTRefreshExpiresIn)
+	container.Provide(func(cfg *environment.Config) (*pkg.JWTService, error) {
+		if cfg.JWTKeysDir == "" {
+			return pkg.NewJWTService(cfg.JWTSecret, cfg.JWTExpiresIn, cfg.JWTRefreshExpiresIn), nil
+		}
+		legacySecret := ""
+		if cfg.JWTLegacyHS256Verify {
+			legacySecret = cfg.JWTSecret
+		}
+		keys, err := pkg.LoadKeySet(cfg.JWTKeysDir, cfg.JWTSigningKeyID, legacySecret)
+		if err != nil {
+			return nil, err
+		}
+		return pkg.NewJWTServiceWithKeys(keys, cfg.JWTExpiresIn, cfg.JWTRefreshExpiresIn), nil
 	})
 	container.Provide(pkg.NewUUIDGenerator)
+	container.Provide(pkg.NewClock)
+	container.Provide(ratelimit.NewMemoryStore)
+	container.Provide(func(cfg *environment.Config) mailer.IMailer {
+		return mailer.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.SMTPFrom)
+	})
+	container.Provide(func(cfg *environment.Config) (*pkg.PasswordHasher, error) {
+		return pkg.NewPasswordHasher(cfg.PasswordHashAlgorithm, cfg.PasswordBcryptCost, pkg.DefaultArgon2Params)
+	})
+	container.Provide(func(cfg *environment.Config) breach.IChecker {
+		if cfg.BreachedPasswordsDir == "" {
+			return breach.NewNoopChecker()
+		}
+		return breach.NewDirChecker(cfg.BreachedPasswordsDir)
+	})
+	container.Provide(func(cfg *environment.Config) oidc.IClient {
+		return oidc.NewClient(&http.Client{Timeout: cfg.OIDCHTTPTimeout})
+	})
 
 	// repository
 	container.Provide(repository.NewAuthRepository)
 	container.Provide(repository.NewUserRepository)
 	container.Provide(repository.NewTodoRepository)
+	container.Provide(repository.NewAuditEventRepository)
+	container.Provide(repository.NewTransactionManager)
+	container.Provide(repository.NewIdempotencyKeyRepository)
+	container.Provide(repository.NewLoginAttemptRepository)
+	container.Provide(repository.NewOIDCConfigRepository)
+	container.Provide(repository.NewPersonalAccessTokenRepository)
+	container.Provide(repository.NewSessionRepository)
+	container.Provide(repository.NewHealthRepository)
 
 	// usecase
-	container.Provide(usecase.NewAuthInteractor)
+	container.Provide(func(
+		hasher *pkg.PasswordHasher,
+		breached breach.IChecker,
+		cfg *environment.Config,
+	) *usecase.PasswordPolicy {
+		return usecase.NewPasswordPolicy(hasher, breached, cfg.PasswordMinLength)
+	})
+	container.Provide(func(
+		authRepo domainRepository.IAuthRepository,
+		loginAttemptRepo domainRepository.ILoginAttemptRepository,
+		sessionRepo domainRepository.ISessionRepository,
+		jwtService *pkg.JWTService,
+		uuidGen pkg.IUUIDGenerator,
+		clock pkg.IClock,
+		mail mailer.IMailer,
+		passwords *usecase.PasswordPolicy,
+		cfg *environment.Config,
+	) usecase.IAuthInteractor {
+		return usecase.NewAuthInteractor(authRepo, loginAttemptRepo, sessionRepo, jwtService, uuidGen, clock, mail, passwords, usecase.LoginLockoutPolicy{
+			MaxFailures:      cfg.LoginMaxFailures,
+			Window:           cfg.LoginFailureWindow,
+			LockoutDuration:  cfg.LoginLockoutDuration,
+			MaxIPFailures:    cfg.LoginMaxIPFailures,
+			DelayBase:        cfg.LoginDelayBase,
+			AttemptRetention: cfg.LoginAttemptRetention,
+		})
+	})
 	container.Provide(usecase.NewUserInteractor)
 	container.Provide(usecase.NewTodoInteractor)
+	container.Provide(usecase.NewAuditInteractor)
+	container.Provide(usecase.NewMFAInteractor)
+	container.Provide(usecase.NewPersonalAccessTokenInteractor)
+	container.Provide(usecase.NewSessionInteractor)
+	container.Provide(usecase.NewAccountInteractor)
+	container.Provide(func(
+		healthRepo domainRepository.IHealthRepository,
+		mail mailer.IMailer,
+	) usecase.IHealthInteractor {
+		return usecase.NewHealthInteractor(healthRepo, mail, migrations.LatestVersion())
+	})
+	// spans around each auth and todo usecase method, and their metrics
+	container.Decorate(func(next usecase.IAuthInteractor, tp trace.TracerProvider, m *metrics.Metrics) usecase.IAuthInteractor {
+		return usecase.NewTracedAuthInteractor(usecase.NewMeteredAuthInteractor(next, m), tp)
+	})
+	container.Decorate(func(next usecase.ITodoInteractor, tp trace.TracerProvider, m *metrics.Metrics) usecase.ITodoInteractor {
+		return usecase.NewTracedTodoInteractor(usecase.NewMeteredTodoInteractor(next, m), tp)
+	})
+	container.Provide(func(
+		authRepo domainRepository.IAuthRepository,
+		oidcConfigRepo domainRepository.IOIDCConfigRepository,
+		oidcClient oidc.IClient,
+		sessionRepo domainRepository.ISessionRepository,
+		jwtService *pkg.JWTService,
+		uuidGen pkg.IUUIDGenerator,
+		clock pkg.IClock,
+		cfg *environment.Config,
+	) usecase.IOIDCInteractor {
+		return usecase.NewOIDCInteractor(authRepo, oidcConfigRepo, oidcClient, sessionRepo, jwtService, uuidGen, clock, cfg.OIDCRedirectBaseURL)
+	})
+	container.Provide(func(
+		todoRepo domainRepository.ITodoRepository,
+		authRepo domainRepository.IAuthRepository,
+		clock pkg.IClock,
+		cfg *environment.Config,
+	) usecase.ITrashInteractor {
+		return usecase.NewTrashInteractor(todoRepo, authRepo, clock, cfg.TrashRetentionDays)
+	})
+	container.Provide(func(
+		idempotencyKeyRepo domainRepository.IIdempotencyKeyRepository,
+		authRepo domainRepository.IAuthRepository,
+		clock pkg.IClock,
+		cfg *environment.Config,
+	) usecase.IIdempotencyInteractor {
+		return usecase.NewIdempotencyInteractor(idempotencyKeyRepo, authRepo, clock, cfg.IdempotencyKeyTTL)
+	})
+	container.Provide(func(
+		store ratelimit.IStore,
+		authRepo domainRepository.IAuthRepository,
+		clock pkg.IClock,
+		cfg *environment.Config,
+	) usecase.IRateLimitInteractor {
+		return usecase.NewRateLimitInteractor(store, authRepo, clock, cfg.RateLimitAuthPerMinute, cfg.RateLimitUserPerMinute)
+	})
 
 	// presenter
 	container.Provide(presenter.NewAuthPresenter)
 	container.Provide(presenter.NewUserPresenter)
 	container.Provide(presenter.NewTodoPresenter)
+	container.Provide(presenter.NewAuditPresenter)
+	container.Provide(presenter.NewMFAPresenter)
+	container.Provide(presenter.NewOIDCPresenter)
+	container.Provide(presenter.NewPersonalAccessTokenPresenter)
+	container.Provide(presenter.NewSessionPresenter)
+	container.Provide(presenter.NewAccountPresenter)
+	container.Provide(presenter.NewHealthPresenter)
 
 	// controller
 	container.Provide(controller.NewAuthController)
 	container.Provide(controller.NewUserController)
 	container.Provide(controller.NewTodoController)
+	container.Provide(controller.NewAuditController)
+	container.Provide(controller.NewMFAController)
+	container.Provide(controller.NewOIDCController)
+	container.Provide(controller.NewPersonalAccessTokenController)
+	container.Provide(controller.NewSessionController)
+	container.Provide(controller.NewAccountController)
+	container.Provide(controller.NewHealthController)
 
 	return container
 }
@@ -9,3 +9,11 @@ import (
 func (s *Server) HealthCheck(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
+
+func (s *Server) Livez(c echo.Context) error {
+	return s.healthController.Livez(c)
+}
+
+func (s *Server) Readyz(c echo.Context) error {
+	return s.healthController.Readyz(c)
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+// 公開鍵はローテーション時に追加・削除されるため、長くキャッシュさせない
+const jwksCacheControl = "public, max-age=300"
+
+func (s *Server) GetJwks(c echo.Context) error {
+	keys := s.jwtService.JWKS()
+	res := api.JWKS{Keys: make([]api.JWK, 0, len(keys))}
+	for _, k := range keys {
+		res.Keys = append(res.Keys, api.JWK{
+			Kty: api.JWKKty(k.Kty),
+			Kid: k.Kid,
+			Use: k.Use,
+			Alg: api.JWKAlg(k.Alg),
+			N:   optionalString(k.N),
+			E:   optionalString(k.E),
+			Crv: optionalString(k.Crv),
+			X:   optionalString(k.X),
+		})
+	}
+
+	c.Response().Header().Set("Cache-Control", jwksCacheControl)
+	return c.JSON(http.StatusOK, res)
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) VerifyMfa(c echo.Context) error {
+	return s.authController.VerifyMFA(c)
+}
+
+func (s *Server) EnrollMfaChallenge(c echo.Context) error {
+	return s.authController.EnrollMFA(c)
+}
+
+func (s *Server) EnrollMfa(c echo.Context) error {
+	return s.mfaController.Enroll(c)
+}
+
+func (s *Server) ConfirmMfa(c echo.Context) error {
+	return s.mfaController.Confirm(c)
+}
+
+func (s *Server) DisableMfa(c echo.Context) error {
+	return s.mfaController.Disable(c)
+}
+
+func (s *Server) SetTenantMfaPolicy(c echo.Context) error {
+	return s.mfaController.SetTenantRequirement(c)
+}
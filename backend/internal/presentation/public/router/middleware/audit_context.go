@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"good-todo-go/internal/pkg/audit"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AuditContextMiddleware stores the request ID and client IP for the ent audit hook.
+// It must run after the RequestID middleware; JWTAuthMiddleware adds the actor.
+func AuditContextMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+			if requestID == "" {
+				requestID = c.Request().Header.Get(echo.HeaderXRequestID)
+			}
+
+			ctx := audit.WithRequest(c.Request().Context(), requestID, c.RealIP())
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// headerIdempotentReplayed marks a response served from the idempotency store
+	headerIdempotentReplayed = "Idempotent-Replayed"
+)
+
+// storedHeaders are the response headers replayed along with the body
+var storedHeaders = []string{
+	echo.HeaderContentType,
+	echo.HeaderLocation,
+	"ETag",
+}
+
+// idempotentRoutes are the POST routes whose responses may be stored and replayed.
+// They create or change todos and return nothing secret; responses carrying tokens
+// (login, refresh, registration, personal access tokens) must never be stored
+var idempotentRoutes = map[string]bool{
+	"/todos":                       true,
+	"/todos/batch":                 true,
+	"/todos/trash/:todoId/restore": true,
+}
+
+// IdempotencyMiddleware makes the POST routes in idempotentRoutes safe to retry when
+// they carry an Idempotency-Key. The first response for a key is stored and replayed
+// for retries with the same request; a different request with the same key gets 422.
+// Keys are scoped by tenant and user, so anonymous requests are not deduplicated.
+// It must run after JWTAuthMiddleware, which sets the user.
+func IdempotencyMiddleware(idempotency usecase.IIdempotencyInteractor) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(HeaderIdempotencyKey)
+			if key == "" || c.Request().Method != http.MethodPost || !idempotentRoutes[c.Path()] {
+				return next(c)
+			}
+			tenantID, _ := c.Get(context_keys.TenantIDContextKey).(string)
+			userID, _ := c.Get(context_keys.UserIDContextKey).(string)
+			if tenantID == "" || userID == "" {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "failed to read request body")
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			scope := input.IdempotencyScope{
+				TenantID: tenantID,
+				UserID:   userID,
+				Key:      key,
+			}
+
+			ctx := c.Request().Context()
+			stored, err := idempotency.Begin(ctx, &input.BeginIdempotentRequestInput{
+				IdempotencyScope: scope,
+				RequestHash:      requestHash(c.Request(), body),
+			})
+			if err != nil {
+				return toHTTPError(err)
+			}
+			if stored != nil {
+				for name, value := range stored.Headers {
+					c.Response().Header().Set(name, value)
+				}
+				c.Response().Header().Set(headerIdempotentReplayed, "true")
+				c.Response().WriteHeader(stored.StatusCode)
+				_, err := c.Response().Write(stored.Body)
+				return err
+			}
+
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			if err := next(c); err != nil {
+				// Write the error response now so it can be stored like any other
+				c.Error(err)
+			}
+			c.Response().Writer = recorder.ResponseWriter
+
+			if c.Response().Status >= http.StatusInternalServerError {
+				// Server errors may be transient, so let the client retry
+				if err := idempotency.Abandon(ctx, &scope); err != nil {
+					slog.ErrorContext(ctx, "failed to release idempotency key", "error", err)
+				}
+				return nil
+			}
+
+			headers := make(map[string]string)
+			for _, name := range storedHeaders {
+				if value := c.Response().Header().Get(name); value != "" {
+					headers[name] = value
+				}
+			}
+			err = idempotency.Complete(ctx, &input.CompleteIdempotentRequestInput{
+				IdempotencyScope: scope,
+				StatusCode:       c.Response().Status,
+				Headers:          headers,
+				Body:             recorder.body.Bytes(),
+			})
+			if err != nil {
+				// The response is already sent. Release the key rather than leave it in progress until it expires
+				slog.ErrorContext(ctx, "failed to store idempotent response", "error", err)
+				if err := idempotency.Abandon(ctx, &scope); err != nil {
+					slog.ErrorContext(ctx, "failed to release idempotency key", "error", err)
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// requestHash fingerprints the parts of a request a retry must repeat exactly
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func toHTTPError(err error) error {
+	var appErr *cerror.AppError
+	if errors.As(err, &appErr) {
+		return echo.NewHTTPError(appErr.HTTPStatus, appErr.Message).WithInternal(appErr)
+	}
+	return echo.NewHTTPError(http.StatusInternalServerError, "internal server error").WithInternal(err)
+}
+
+// bodyRecorder copies everything written to the response
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
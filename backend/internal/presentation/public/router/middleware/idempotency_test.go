@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	mock_usecase "good-todo-go/internal/usecase/mock"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotencyMiddleware_Routes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		path   string
+		userID string
+		stored bool
+	}{
+		{name: "todo creation is stored", path: "/todos", userID: "user-1", stored: true},
+		{name: "batch is stored", path: "/todos/batch", userID: "user-1", stored: true},
+		{name: "login response carries tokens", path: "/auth/login"},
+		{name: "refresh response carries tokens", path: "/auth/refresh"},
+		{name: "personal access token is shown once", path: "/me/tokens", userID: "user-1"},
+		{name: "anonymous requests have no scope", path: "/todos"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			idempotency := mock_usecase.NewMockIIdempotencyInteractor(ctrl)
+			if tt.stored {
+				idempotency.EXPECT().Begin(gomock.Any(), gomock.Any()).Return(nil, nil)
+				idempotency.EXPECT().Complete(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			e := echo.New()
+			e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+				// Sets the user like JWTAuthMiddleware
+				return func(c echo.Context) error {
+					if tt.userID != "" {
+						c.Set(context_keys.TenantIDContextKey, "tenant-1")
+						c.Set(context_keys.UserIDContextKey, tt.userID)
+					}
+					return next(c)
+				}
+			}, IdempotencyMiddleware(idempotency))
+			e.POST(tt.path, func(c echo.Context) error {
+				return c.JSON(http.StatusOK, map[string]string{"token": "secret"})
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			req.Header.Set(HeaderIdempotencyKey, "key-1")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
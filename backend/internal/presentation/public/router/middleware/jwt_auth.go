@@ -2,11 +2,16 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 	"strings"
 
+	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/audit"
+	"good-todo-go/internal/pkg/logger"
 	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
 
 	"github.com/labstack/echo/v4"
 )
@@ -14,14 +19,30 @@ import (
 // 認証が不要なルートの一覧
 var publicRoutes = []string{
 	"/health",
+	// ロードバランサー・オーケストレーターのプローブ
+	"/livez",
+	"/readyz",
+	// 他サービスがトークンを検証するための公開鍵
+	"/.well-known/jwks.json",
 	"/auth/register",
 	"/auth/login",
 	"/auth/verify-email",
 	"/auth/refresh",
+	// MFAトークンで認証する
+	"/auth/mfa/verify",
+	"/auth/mfa/enroll",
 }
 
-// JWTAuthMiddleware validates JWT tokens and sets user info in context
-func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
+// 認証が不要なルートのプレフィックス
+var publicRoutePrefixes = []string{
+	// テナントごとのシングルサインオン (/auth/oidc/{tenantSlug}/start, /callback)
+	"/auth/oidc/",
+}
+
+// JWTAuthMiddleware validates JWT tokens and personal access tokens and sets user info in context.
+// When sessions is not nil, access tokens of revoked sessions are rejected at once instead of
+// at their expiry, at the cost of a lookup per request
+func JWTAuthMiddleware(jwtService *pkg.JWTService, tokens usecase.IPersonalAccessTokenInteractor, sessions usecase.ISessionInteractor) echo.MiddlewareFunc {
 	// publicRoutesをmapに変換
 	publicRoutesMap := make(map[string]bool)
 	for _, route := range publicRoutes {
@@ -36,7 +57,7 @@ func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
 			}
 
 			// 認証不要なルートの場合は、認証をスキップする
-			if publicRoutesMap[c.Request().URL.Path] {
+			if publicRoutesMap[c.Request().URL.Path] || hasPublicPrefix(c.Request().URL.Path) {
 				return next(c)
 			}
 
@@ -52,6 +73,21 @@ func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
 				return echo.NewHTTPError(http.StatusUnauthorized, "invalid authorization header format")
 			}
 
+			// パーソナルアクセストークンはスコープで許可されたルートだけに使える
+			if strings.HasPrefix(token, usecase.PersonalAccessTokenPrefix) {
+				principal, err := tokens.Authenticate(c.Request().Context(), token)
+				if err != nil {
+					return toHTTPError(err)
+				}
+				scope, ok := requiredScope(c.Request().Method, c.Request().URL.Path)
+				if !ok || !slices.Contains(principal.Scopes, scope) {
+					return echo.NewHTTPError(http.StatusForbidden, "token scope does not allow this request")
+				}
+
+				setUser(c, principal.UserID, principal.TenantID, principal.Email, principal.Role)
+				return next(c)
+			}
+
 			// Validate token
 			claims, err := jwtService.ValidateToken(token)
 			if err != nil {
@@ -62,22 +98,77 @@ func JWTAuthMiddleware(jwtService *pkg.JWTService) echo.MiddlewareFunc {
 				return echo.NewHTTPError(http.StatusUnauthorized, "invalid token type")
 			}
 
-			// Set user info in echo context
-			c.Set(context_keys.UserIDContextKey, claims.UserID)
-			c.Set(context_keys.TenantIDContextKey, claims.TenantID)
-			c.Set(context_keys.EmailContextKey, claims.Email)
-			c.Set(context_keys.RoleContextKey, claims.Role)
-
-			// Also set tenantID in request context for database layer
-			ctx := database.WithTenantID(c.Request().Context(), claims.TenantID)
-			c.SetRequest(c.Request().WithContext(ctx))
+			// ログアウト済みのセッションのトークンを即時に拒否する
+			if sessions != nil && claims.SessionID != "" {
+				active, err := sessions.IsActive(c.Request().Context(), claims.TenantID, claims.SessionID)
+				if err != nil {
+					return toHTTPError(err)
+				}
+				if !active {
+					return echo.NewHTTPError(http.StatusUnauthorized, "session has ended")
+				}
+			}
 
+			setUser(c, claims.UserID, claims.TenantID, claims.Email, claims.Role)
+			c.Set(context_keys.SessionIDContextKey, claims.SessionID)
 			return next(c)
 		}
 	}
 }
 
+// setUser sets user info in echo context and the tenant in the request context
+func setUser(c echo.Context, userID, tenantID, email, role string) {
+	c.Set(context_keys.UserIDContextKey, userID)
+	c.Set(context_keys.TenantIDContextKey, tenantID)
+	c.Set(context_keys.EmailContextKey, email)
+	c.Set(context_keys.RoleContextKey, role)
+
+	// Also set tenantID in request context for database layer
+	ctx := database.WithTenantID(c.Request().Context(), tenantID)
+	ctx = audit.WithActor(ctx, userID)
+	ctx = logger.WithUser(ctx, tenantID, userID)
+	c.SetRequest(c.Request().WithContext(ctx))
+}
+
+// requiredScope returns the personal access token scope a request needs.
+// ok is false for routes tokens may never use, such as token and two-factor
+// management and deleting the account
+func requiredScope(method, path string) (scope string, ok bool) {
+	read := method == http.MethodGet || method == http.MethodHead
+
+	switch {
+	case path == "/me" && method == http.MethodDelete:
+		return "", false
+	case path == "/todos" || path == "/todos-public" || strings.HasPrefix(path, "/todos/"):
+		if read {
+			return model.ScopeReadTodos, true
+		}
+		return model.ScopeWriteTodos, true
+	case path == "/me":
+		if read {
+			return model.ScopeReadUser, true
+		}
+		return model.ScopeWriteUser, true
+	case path == "/audit-events" || strings.HasPrefix(path, "/tenant/") || strings.HasPrefix(path, "/users/"):
+		if read {
+			return model.ScopeReadTenant, true
+		}
+		return model.ScopeWriteTenant, true
+	default:
+		return "", false
+	}
+}
+
+func hasPublicPrefix(path string) bool {
+	for _, prefix := range publicRoutePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // JWTAuth は後方互換性のためのエイリアス
-func JWTAuth(jwtService *pkg.JWTService) echo.MiddlewareFunc {
-	return JWTAuthMiddleware(jwtService)
+func JWTAuth(jwtService *pkg.JWTService, tokens usecase.IPersonalAccessTokenInteractor, sessions usecase.ISessionInteractor) echo.MiddlewareFunc {
+	return JWTAuthMiddleware(jwtService, tokens, sessions)
 }
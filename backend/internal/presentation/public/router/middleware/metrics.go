@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+)
+
+// unmatchedRoute labels requests that match no route, so that scanned paths
+// do not each become a series
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware counts requests and their latency by route and status, and
+// authenticated requests by tenant. It must wrap RequestLoggerMiddleware so that
+// it sees the status errors are rendered with
+func MetricsMiddleware(m *metrics.Metrics) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			route := c.Path()
+			if route == "" || route == "/*" {
+				route = unmatchedRoute
+			}
+			labels := []string{c.Request().Method, route, strconv.Itoa(c.Response().Status)}
+			m.HTTPRequests.WithLabelValues(labels...).Inc()
+			m.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+			// The tenant is set by JWTAuthMiddleware, further down the chain
+			if tenantID, ok := c.Get(context_keys.TenantIDContextKey).(string); ok && tenantID != "" {
+				m.TenantRequests.WithLabelValues(tenantID).Inc()
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	m := metrics.New(prometheus.NewRegistry())
+	e := echo.New()
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
+	e.Use(MetricsMiddleware(m), func(next echo.HandlerFunc) echo.HandlerFunc {
+		// Renders errors like RequestLoggerMiddleware
+		return func(c echo.Context) error {
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+			return err
+		}
+	})
+	e.GET("/todos/:todoId", func(c echo.Context) error {
+		c.Set(context_keys.TenantIDContextKey, "tenant-1")
+		if c.Param("todoId") == "missing" {
+			return cerror.NewNotFound("todo not found", nil)
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	for _, path := range []string{"/todos/todo-1", "/todos/todo-2", "/todos/missing", "/wp-login.php"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.HTTPRequests.WithLabelValues(http.MethodGet, "/todos/:todoId", "204")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.HTTPRequests.WithLabelValues(http.MethodGet, "/todos/:todoId", "404")))
+	// Unknown paths share one series
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.HTTPRequests.WithLabelValues(http.MethodGet, unmatchedRoute, "404")))
+	assert.Equal(t, 3, testutil.CollectAndCount(m.HTTPRequestDuration))
+	assert.Equal(t, float64(3), testutil.ToFloat64(m.TenantRequests.WithLabelValues("tenant-1")))
+}
@@ -1,21 +1,42 @@
 package middleware
 
 import (
+	"log/slog"
+
+	"good-todo-go/internal/pkg/metrics"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func SetupMiddleware(e *echo.Echo) {
-	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
-		LogStatus:   true,
-		LogURI:      true,
-		LogError:    true,
-		HandleError: true,
-		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			return nil
-		},
-	}))
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
-	e.Use(middleware.RequestID())
+// SetupMiddleware installs the middlewares every request goes through, before authentication
+func SetupMiddleware(e *echo.Echo, logger *slog.Logger, serviceName string, tp trace.TracerProvider, m *metrics.Metrics) {
+	e.Use(TracingMiddleware(serviceName, tp))
+	e.Use(MetricsMiddleware(m))
+	e.Use(RequestLoggerMiddleware(logger))
+	e.Use(RecoverMiddleware(logger))
+	e.Use(CORSMiddleware())
+	e.Use(RequestIDMiddleware())
+	e.Use(AuditContextMiddleware())
+}
+
+// corsExposeHeaders are the response headers browsers let scripts read, besides the CORS-safelisted ones
+var corsExposeHeaders = []string{
+	// sent back in If-Match for optimistic locking
+	"ETag",
+	// tells a retried POST apart from a new one
+	headerIdempotentReplayed,
+	// let clients slow down before they are limited, and wait the right time when they are
+	headerRateLimitLimit,
+	headerRateLimitRemaining,
+	headerRateLimitReset,
+	echo.HeaderRetryAfter,
+}
+
+// CORSMiddleware allows any origin, and exposes the headers clients act on
+func CORSMiddleware() echo.MiddlewareFunc {
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		ExposeHeaders: corsExposeHeaders,
+	})
 }
@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORSMiddleware_ExposesHeaders(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+	e.Use(CORSMiddleware())
+	e.GET("/todos/:todoId", func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `"3"`)
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/todos/todo-1", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "*", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Equal(t, "ETag,Idempotent-Replayed,RateLimit-Limit,RateLimit-Remaining,RateLimit-Reset,Retry-After", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+}
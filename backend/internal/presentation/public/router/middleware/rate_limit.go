@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"good-todo-go/internal/presentation/public/router/context_keys"
+	"good-todo-go/internal/usecase"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+)
+
+// RateLimitMiddleware limits authenticated requests per tenant and user, and
+// the unauthenticated /auth routes per client IP. Other public routes such as
+// /health and the /livez and /readyz probes are not limited.
+// It must run after JWTAuthMiddleware, which sets the user.
+func RateLimitMiddleware(rateLimit usecase.IRateLimitInteractor) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method == http.MethodOptions {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			tenantID, _ := c.Get(context_keys.TenantIDContextKey).(string)
+			userID, _ := c.Get(context_keys.UserIDContextKey).(string)
+
+			var (
+				out *output.RateLimitOutput
+				err error
+			)
+			switch {
+			case userID != "":
+				out, err = rateLimit.AllowUser(ctx, tenantID, userID)
+			case strings.HasPrefix(c.Request().URL.Path, "/auth/"):
+				out, err = rateLimit.AllowIP(ctx, c.RealIP())
+			default:
+				return next(c)
+			}
+			if err != nil {
+				// Fail open: an unavailable limiter store must not take the API down
+				slog.ErrorContext(ctx, "rate limit check failed", "error", err)
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set(headerRateLimitLimit, strconv.Itoa(out.Limit))
+			header.Set(headerRateLimitRemaining, strconv.Itoa(out.Remaining))
+			header.Set(headerRateLimitReset, strconv.Itoa(out.ResetSeconds))
+			if !out.Allowed {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(out.RetryAfterSeconds))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded")
+			}
+
+			return next(c)
+		}
+	}
+}
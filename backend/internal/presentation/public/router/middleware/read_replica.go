@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ReadReplicaMiddleware lets users read their own writes while listings come from a replica.
+// Requests that may write, and requests from a user who wrote within the sticky window,
+// read from the primary. It must run after JWTAuthMiddleware, which sets the user.
+func ReadReplicaMiddleware(replica *database.ReadReplica) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			tenantID, _ := c.Get(context_keys.TenantIDContextKey).(string)
+			userID, _ := c.Get(context_keys.UserIDContextKey).(string)
+			if tenantID == "" || userID == "" {
+				return next(c)
+			}
+			key := tenantID + ":" + userID
+
+			writes := !isSafeMethod(c.Request().Method)
+			if writes || replica.RecentlyWrote(key) {
+				c.SetRequest(c.Request().WithContext(database.WithPrimaryReads(c.Request().Context())))
+			}
+			if !writes {
+				return next(c)
+			}
+
+			// The response is sent inside next, so the client may read again before it returns.
+			// Marking after as well starts the window once the write has been committed
+			replica.MarkWrite(key)
+			err := next(c)
+			replica.MarkWrite(key)
+			return err
+		}
+	}
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
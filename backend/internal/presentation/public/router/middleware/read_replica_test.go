@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/infrastructure/database"
+	"good-todo-go/internal/presentation/public/router/context_keys"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadReplicaMiddleware(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	replica := database.NewReadReplicaWithClient(nil, 5*time.Second, func() time.Time { return now })
+
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		// Sets the user like JWTAuthMiddleware
+		return func(c echo.Context) error {
+			if userID := c.Request().Header.Get("X-Test-User"); userID != "" {
+				c.Set(context_keys.TenantIDContextKey, "tenant-1")
+				c.Set(context_keys.UserIDContextKey, userID)
+			}
+			return next(c)
+		}
+	}, ReadReplicaMiddleware(replica))
+	e.GET("/todos", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.POST("/todos", func(c echo.Context) error { return c.NoContent(http.StatusCreated) })
+
+	serve := func(method, userID string) {
+		req := httptest.NewRequest(method, "/todos", nil)
+		if userID != "" {
+			req.Header.Set("X-Test-User", userID)
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	serve(http.MethodGet, "user-1")
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-1"), "reads do not make the user sticky")
+
+	serve(http.MethodPost, "user-1")
+	assert.True(t, replica.RecentlyWrote("tenant-1:user-1"))
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-2"), "other users keep reading from the replica")
+
+	now = now.Add(5 * time.Second)
+	assert.False(t, replica.RecentlyWrote("tenant-1:user-1"))
+}
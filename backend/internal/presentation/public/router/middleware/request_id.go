@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"good-todo-go/internal/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// RequestIDMiddleware takes the X-Request-Id of the request, or generates one, returns
+// it in the response and stores it in the request context for the log lines of the request
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return echoMiddleware.RequestIDWithConfig(echoMiddleware.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, requestID string) {
+			ctx := logger.WithRequestID(c.Request().Context(), requestID)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
+}
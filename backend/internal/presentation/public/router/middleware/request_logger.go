@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// RequestLoggerMiddleware writes one line per request with its route, status and latency,
+// at warn for client errors and error (with the cause) for server errors. It must come
+// right after TracingMiddleware and MetricsMiddleware so that they see the status errors
+// are rendered with; the request ID, tenant and user are added to the request context by
+// the middlewares after it.
+func RequestLoggerMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return echoMiddleware.RequestLoggerWithConfig(echoMiddleware.RequestLoggerConfig{
+		LogMethod:    true,
+		LogRoutePath: true,
+		LogURIPath:   true,
+		LogStatus:    true,
+		LogLatency:   true,
+		LogRemoteIP:  true,
+		LogError:     true,
+		HandleError:  true,
+		LogValuesFunc: func(c echo.Context, v echoMiddleware.RequestLoggerValues) error {
+			level := slog.LevelInfo
+			switch {
+			case v.Status >= http.StatusInternalServerError:
+				level = slog.LevelError
+			case v.Status >= http.StatusBadRequest:
+				level = slog.LevelWarn
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", v.Method),
+				slog.String("route", v.RoutePath),
+				slog.String("path", v.URIPath),
+				slog.Int("status", v.Status),
+				slog.Float64("latency_ms", float64(v.Latency.Microseconds())/1000),
+				slog.String("remote_ip", v.RemoteIP),
+			}
+			if v.Error != nil && level == slog.LevelError {
+				attrs = append(attrs, slog.Any("error", v.Error))
+			}
+
+			logger.LogAttrs(c.Request().Context(), level, "request", attrs...)
+			return nil
+		},
+	})
+}
+
+// RecoverMiddleware turns a panic into a 500 and logs it with its stack.
+// The error is returned so that RequestLoggerMiddleware logs and renders it
+func RecoverMiddleware(logger *slog.Logger) echo.MiddlewareFunc {
+	return echoMiddleware.RecoverWithConfig(echoMiddleware.RecoverConfig{
+		DisableErrorHandler: true,
+		LogErrorFunc: func(c echo.Context, err error, stack []byte) error {
+			logger.ErrorContext(c.Request().Context(), "panic recovered", "error", err, "stack", string(stack))
+			return err
+		},
+	})
+}
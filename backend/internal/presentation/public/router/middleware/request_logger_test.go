@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/logger"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLoggerMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		handler   echo.HandlerFunc
+		requestID string
+		wantLevel string
+		want      map[string]any
+	}{
+		{
+			name: "request line carries the request, tenant and user",
+			handler: func(c echo.Context) error {
+				ctx := logger.WithUser(c.Request().Context(), "tenant-1", "user-1")
+				c.SetRequest(c.Request().WithContext(ctx))
+				return c.NoContent(http.StatusNoContent)
+			},
+			requestID: "request-1",
+			wantLevel: "INFO",
+			want: map[string]any{
+				"msg":        "request",
+				"method":     http.MethodGet,
+				"route":      "/todos/:todoId",
+				"path":       "/todos/todo-1",
+				"status":     float64(http.StatusNoContent),
+				"request_id": "request-1",
+				"tenant_id":  "tenant-1",
+				"user_id":    "user-1",
+			},
+		},
+		{
+			name: "client error is a warning with the rendered status",
+			handler: func(c echo.Context) error {
+				return cerror.NewNotFound("todo not found", nil)
+			},
+			wantLevel: "WARN",
+			want:      map[string]any{"status": float64(http.StatusNotFound)},
+		},
+		{
+			name: "panic is an error with its cause",
+			handler: func(c echo.Context) error {
+				panic("boom")
+			},
+			wantLevel: "ERROR",
+			want: map[string]any{
+				"status": float64(http.StatusInternalServerError),
+				"error":  "boom",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			log, err := logger.New(&buf, "info")
+			require.NoError(t, err)
+			e := echo.New()
+			e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
+			e.Use(RequestLoggerMiddleware(log), RecoverMiddleware(log), RequestIDMiddleware())
+			e.GET("/todos/:todoId", tt.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/todos/todo-1", nil)
+			if tt.requestID != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			requestID := rec.Header().Get(echo.HeaderXRequestID)
+			require.NotEmpty(t, requestID)
+			line := requestLine(t, &buf)
+			assert.Equal(t, tt.wantLevel, line[slog.LevelKey])
+			assert.Equal(t, requestID, line["request_id"])
+			assert.Contains(t, line, "latency_ms")
+			for key, want := range tt.want {
+				assert.Equal(t, want, line[key], key)
+			}
+			if rec.Code >= http.StatusBadRequest {
+				var problem map[string]any
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+				assert.Equal(t, requestID, problem["request_id"])
+			}
+		})
+	}
+}
+
+// requestLine finds the line RequestLoggerMiddleware wrote among the logs
+func requestLine(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+	scanner := bufio.NewScanner(buf)
+	for scanner.Scan() {
+		var line map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		if line[slog.MessageKey] == "request" {
+			return line
+		}
+	}
+	t.Fatal("no request line logged")
+	return nil
+}
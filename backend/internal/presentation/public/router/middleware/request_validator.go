@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"fmt"
+	"mime"
+	"strings"
+
+	"good-todo-go/internal/pkg/cerror"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/labstack/echo/v4"
+)
+
+// bodyField is the details key for problems with the body as a whole, such as malformed JSON
+const bodyField = "body"
+
+// RequestValidatorMiddleware checks requests against the OpenAPI spec before they
+// reach a handler. A request that does not match gets a 400 VALIDATION_ERROR whose
+// details map each offending field (dotted path in the body, or parameter name) to
+// the reason. Paths and methods missing from the spec are left to the router, and
+// bodies of undeclared media types to the handler.
+// It must run after JWTAuthMiddleware, which does the authentication the spec declares.
+func RequestValidatorMiddleware(spec *openapi3.T) (echo.MiddlewareFunc, error) {
+	openapi3.DefineStringFormatValidator("email", openapi3.NewRegexpFormatValidator(openapi3.FormatOfStringForEmail))
+
+	// Handlers are registered without the server URL, so match the bare paths
+	spec.Servers = nil
+	router, err := legacy.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		// Filling in defaults would rewrite the body, and turn omitted merge patch fields into changes
+		SkipSettingDefaults: true,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			route, pathParams, err := router.FindRoute(c.Request())
+			if err != nil {
+				return next(c)
+			}
+			// A body of another media type is refused by the handler with 415, as before
+			if body := route.Operation.RequestBody; body != nil && body.Value != nil {
+				mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+				if body.Value.Content.Get(mediaType) == nil {
+					return next(c)
+				}
+			}
+
+			err = openapi3filter.ValidateRequest(c.Request().Context(), &openapi3filter.RequestValidationInput{
+				Request:    c.Request(),
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			})
+			if err != nil {
+				details := map[string]interface{}{}
+				collectValidationErrors(err, "", details)
+				return cerror.NewValidationError("request validation failed", details)
+			}
+
+			return next(c)
+		}
+	}, nil
+}
+
+// collectValidationErrors flattens the errors of ValidateRequest into details.
+// field is the parameter or body the errors belong to
+func collectValidationErrors(err error, field string, details map[string]interface{}) {
+	// Type switches rather than errors.As, which would see through a RequestError
+	// to its MultiError and lose the field
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, inner := range e {
+			collectValidationErrors(inner, field, details)
+		}
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			field = e.Parameter.Name
+		case e.RequestBody != nil:
+			field = bodyField
+		}
+		if e.Err == nil {
+			addValidationError(details, field, e.Reason)
+			return
+		}
+		collectValidationErrors(e.Err, field, details)
+	case *openapi3filter.ParseError:
+		addValidationError(details, field, e.Error())
+	case *openapi3.SchemaError:
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			path := strings.Join(pointer, ".")
+			if field != "" && field != bodyField {
+				path = field + "." + path
+			}
+			field = path
+		}
+		reason := e.Reason
+		if e.SchemaField == "format" && e.Schema != nil {
+			reason = fmt.Sprintf("must be a valid %s", e.Schema.Format)
+		}
+		addValidationError(details, field, reason)
+	default:
+		addValidationError(details, field, err.Error())
+	}
+}
+
+// addValidationError keeps the first problem found for a field
+func addValidationError(details map[string]interface{}, field, reason string) {
+	if field == "" {
+		field = bodyField
+	}
+	if _, ok := details[field]; !ok {
+		details[field] = reason
+	}
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestValidatorMiddleware(t *testing.T) {
+	t.Parallel()
+
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+	validate, err := RequestValidatorMiddleware(spec)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		wantDetails map[string]interface{}
+		// wantField is checked instead of wantDetails when the reason comes from the JSON decoder
+		wantField string
+	}{
+		{
+			name:        "valid body",
+			method:      http.MethodPost,
+			target:      "/todos",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"title":"Buy milk","due_date":"2026-10-20T09:00:00Z"}`,
+		},
+		{
+			name:        "empty title",
+			method:      http.MethodPost,
+			target:      "/todos",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"title":""}`,
+			wantDetails: map[string]interface{}{"title": "minimum string length is 1"},
+		},
+		{
+			name:        "missing title and malformed date",
+			method:      http.MethodPost,
+			target:      "/todos",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"due_date":"tomorrow"}`,
+			wantDetails: map[string]interface{}{"title": `property "title" is missing`, "due_date": "must be a valid date-time"},
+		},
+		{
+			name:        "malformed JSON",
+			method:      http.MethodPost,
+			target:      "/todos",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"title":`,
+			wantField:   "body",
+		},
+		{
+			name:        "invalid email",
+			method:      http.MethodPost,
+			target:      "/auth/login",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"email":"not-an-email","password":"password123","tenant_slug":"acme"}`,
+			wantDetails: map[string]interface{}{"email": "must be a valid email"},
+		},
+		{
+			name:        "query parameter out of range",
+			method:      http.MethodGet,
+			target:      "/todos?limit=500",
+			wantDetails: map[string]interface{}{"limit": "number must be at most 100"},
+		},
+		{
+			name:        "undeclared media type is left to the handler",
+			method:      http.MethodPatch,
+			target:      "/todos/todo-id",
+			contentType: echo.MIMEApplicationJSON,
+			body:        `{"title":""}`,
+		},
+		{
+			name:        "merge patch may clear nullable fields",
+			method:      http.MethodPatch,
+			target:      "/todos/todo-id",
+			contentType: "application/merge-patch+json",
+			body:        `{"description":null,"due_date":null}`,
+		},
+		{
+			name:   "path not in the spec",
+			method: http.MethodGet,
+			target: "/metrics",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, tt.contentType)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			called := false
+
+			err := validate(func(echo.Context) error {
+				called = true
+				return nil
+			})(c)
+
+			if tt.wantDetails == nil && tt.wantField == "" {
+				require.NoError(t, err)
+				assert.True(t, called)
+				return
+			}
+			var appErr *cerror.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, cerror.ErrCodeValidationError, appErr.Code)
+			if tt.wantField != "" {
+				assert.Contains(t, appErr.Details, tt.wantField)
+			} else {
+				assert.Equal(t, tt.wantDetails, appErr.Details)
+			}
+			assert.False(t, called)
+		})
+	}
+}
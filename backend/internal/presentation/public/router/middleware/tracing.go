@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"good-todo-go/internal/pkg/tracing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware records a server span per request, continuing the trace of a
+// traceparent header. It must be the first middleware so that the request line and
+// error responses, written by RequestLoggerMiddleware, carry the trace ID
+func TracingMiddleware(serviceName string, tp trace.TracerProvider) echo.MiddlewareFunc {
+	return otelecho.Middleware(serviceName,
+		otelecho.WithTracerProvider(tp),
+		otelecho.WithPropagators(tracing.Propagator()),
+	)
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/logger"
+	"good-todo-go/internal/pkg/metrics"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	var buf bytes.Buffer
+	log, err := logger.New(&buf, "info")
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
+	SetupMiddleware(e, log, "good-todo-api", tp, metrics.New(prometheus.NewRegistry()))
+	e.GET("/todos/:todoId", func(c echo.Context) error {
+		return cerror.NewNotFound("todo not found", nil)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/todos/todo-1", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// The server span continues the caller's trace
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, traceID, spans[0].SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String())
+	assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+
+	// The request line and the problem carry the trace
+	line := requestLine(t, &buf)
+	assert.Equal(t, traceID, line["trace_id"])
+	assert.Equal(t, spans[0].SpanContext().SpanID().String(), line["span_id"])
+	var problem map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, traceID, problem["trace_id"])
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) StartOidcLogin(c echo.Context, tenantSlug string) error {
+	return s.oidcController.Start(c, tenantSlug)
+}
+
+func (s *Server) OidcCallback(c echo.Context, tenantSlug string, params api.OidcCallbackParams) error {
+	return s.oidcController.Callback(c, tenantSlug, params)
+}
+
+func (s *Server) GetTenantOidcConfig(c echo.Context) error {
+	return s.oidcController.GetConfig(c)
+}
+
+func (s *Server) SaveTenantOidcConfig(c echo.Context) error {
+	return s.oidcController.SaveConfig(c)
+}
+
+func (s *Server) DeleteTenantOidcConfig(c echo.Context) error {
+	return s.oidcController.DeleteConfig(c)
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListPersonalAccessTokens(c echo.Context) error {
+	return s.tokenController.List(c)
+}
+
+func (s *Server) CreatePersonalAccessToken(c echo.Context) error {
+	return s.tokenController.Create(c)
+}
+
+func (s *Server) RevokePersonalAccessToken(c echo.Context, tokenId string) error {
+	return s.tokenController.Revoke(c, tokenId)
+}
@@ -0,0 +1,41 @@
+package router
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// startPurger runs purge every interval until ctx is done. what names the purged items in logs
+func startPurger(ctx context.Context, what string, interval time.Duration, purge func(context.Context) (int, error)) {
+	if interval <= 0 {
+		slog.Info("purger disabled", "purger", what, "interval", interval.String())
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			runPurge(ctx, what, purge)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func runPurge(ctx context.Context, what string, purge func(context.Context) (int, error)) {
+	n, err := purge(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to purge expired items", "purger", what, "error", err)
+		return
+	}
+	if n > 0 {
+		slog.InfoContext(ctx, "purged expired items", "purger", what, "count", n)
+	}
+}
@@ -2,30 +2,44 @@ package router
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"good-todo-go/internal/ent"
+	"good-todo-go/internal/infrastructure/database"
 	"good-todo-go/internal/infrastructure/environment"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/pkg/tracing"
+	adminRouter "good-todo-go/internal/presentation/admin/router"
 	"good-todo-go/internal/presentation/public/api"
 	"good-todo-go/internal/presentation/public/controller"
 	"good-todo-go/internal/presentation/public/router/dependency"
 	"good-todo-go/internal/presentation/public/router/middleware"
+	"good-todo-go/internal/usecase"
 
 	"github.com/labstack/echo/v4"
-	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 type Server struct {
-	env            *environment.Config
-	jwtService     *pkg.JWTService
-	authController *controller.AuthController
-	userController *controller.UserController
-	todoController *controller.TodoController
+	env               *environment.Config
+	jwtService        *pkg.JWTService
+	authController    *controller.AuthController
+	userController    *controller.UserController
+	todoController    *controller.TodoController
+	auditController   *controller.AuditController
+	mfaController     *controller.MFAController
+	oidcController    *controller.OIDCController
+	tokenController   *controller.PersonalAccessTokenController
+	sessionController *controller.SessionController
+	accountController *controller.AccountController
+	healthController  *controller.HealthController
 }
 
 func NewServer(
@@ -34,88 +48,256 @@ func NewServer(
 	authController *controller.AuthController,
 	userController *controller.UserController,
 	todoController *controller.TodoController,
+	auditController *controller.AuditController,
+	mfaController *controller.MFAController,
+	oidcController *controller.OIDCController,
+	tokenController *controller.PersonalAccessTokenController,
+	sessionController *controller.SessionController,
+	accountController *controller.AccountController,
+	healthController *controller.HealthController,
 ) *Server {
 	return &Server{
-		env:            env,
-		jwtService:     jwtService,
-		authController: authController,
-		userController: userController,
-		todoController: todoController,
+		env:               env,
+		jwtService:        jwtService,
+		authController:    authController,
+		userController:    userController,
+		todoController:    todoController,
+		auditController:   auditController,
+		mfaController:     mfaController,
+		oidcController:    oidcController,
+		tokenController:   tokenController,
+		sessionController: sessionController,
+		accountController: accountController,
+		healthController:  healthController,
 	}
 }
 
-func NewRouter() (*echo.Echo, *environment.Config, *ent.Client, error) {
+// NewRouter returns the public and admin servers, the config, and a channel closed once
+// shutdown has finished and the database connections are closed
+func NewRouter() (*echo.Echo, *echo.Echo, *environment.Config, <-chan struct{}, error) {
 	e := echo.New()
-
-	// ミドルウェア設定
-	e.Use(echoMiddleware.RequestLoggerWithConfig(echoMiddleware.RequestLoggerConfig{
-		LogStatus:   true,
-		LogURI:      true,
-		LogError:    true,
-		HandleError: true,
-		LogValuesFunc: func(c echo.Context, v echoMiddleware.RequestLoggerValues) error {
-			return nil
-		},
-	}))
-	e.Use(echoMiddleware.Recover())
-	e.Use(echoMiddleware.CORS())
+	// すべてのエラーを RFC 7807 の problem+json で返す
+	e.HTTPErrorHandler = cerror.CustomHTTPErrorHandler
 
 	// DIコンテナを作成
 	container := dependency.BuildContainer()
 	container.Provide(NewServer)
 
+	// JSON の構造化ログ (log パッケージの出力も含む)
+	var logger *slog.Logger
+	if err := container.Invoke(func(l *slog.Logger) {
+		logger = l
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	slog.SetDefault(logger)
+
+	// OpenTelemetry のトレース (HTTP・ユースケース・SQL のスパン)
+	var (
+		cfg            *environment.Config
+		tracerProvider *sdktrace.TracerProvider
+	)
+	if err := container.Invoke(func(c *environment.Config, tp *sdktrace.TracerProvider) {
+		cfg = c
+		tracerProvider = tp
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	tracing.SetGlobal(tracerProvider)
+
+	// Prometheus のメトリクス (管理用ポートの /metrics で公開する)
 	var (
-		server *Server
-		client *ent.Client
-		jwtSvc *pkg.JWTService
+		registry *prometheus.Registry
+		m        *metrics.Metrics
+	)
+	if err := container.Invoke(func(r *prometheus.Registry, mt *metrics.Metrics) {
+		registry = r
+		m = mt
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	admin := adminRouter.NewRouter(registry)
+
+	// ミドルウェア設定
+	middleware.SetupMiddleware(e, logger, cfg.TracingServiceName, tracerProvider, m)
+
+	var (
+		server   *Server
+		client   *ent.Client
+		jwtSvc   *pkg.JWTService
+		auth     usecase.IAuthInteractor
+		trash    usecase.ITrashInteractor
+		idem     usecase.IIdempotencyInteractor
+		limit    usecase.IRateLimitInteractor
+		tokens   usecase.IPersonalAccessTokenInteractor
+		sessions usecase.ISessionInteractor
+		health   usecase.IHealthInteractor
+		replica  *database.ReadReplica
 	)
 
 	if err := container.Invoke(func(s *Server) {
 		server = s
 	}); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	if err := container.Invoke(func(c *ent.Client) {
 		client = c
 	}); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	if err := container.Invoke(func(j *pkg.JWTService) {
 		jwtSvc = j
 	}); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(a usecase.IAuthInteractor) {
+		auth = a
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(t usecase.ITrashInteractor) {
+		trash = t
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(i usecase.IIdempotencyInteractor) {
+		idem = i
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(l usecase.IRateLimitInteractor) {
+		limit = l
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(t usecase.IPersonalAccessTokenInteractor) {
+		tokens = t
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(s usecase.ISessionInteractor) {
+		sessions = s
+	}); err != nil {
+		return nil, nil, nil, nil, err
 	}
 
-	// JWT認証ミドルウェア
-	e.Use(middleware.JWTAuthMiddleware(jwtSvc))
+	if err := container.Invoke(func(h usecase.IHealthInteractor) {
+		health = h
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	if err := container.Invoke(func(r *database.ReadReplica) {
+		replica = r
+	}); err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	// X-Forwarded-For はリバースプロキシ配下でのみ信用する (IP単位のレート制限を偽装させないため)
+	if server.env.TrustProxyHeaders {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
+
+	// JWT認証ミドルウェア (パーソナルアクセストークンも受け付ける)
+	// セッションの確認を有効にすると、ログアウトしたセッションのアクセストークンも即時に拒否する
+	var sessionCheck usecase.ISessionInteractor
+	if server.env.SessionCheckAccessTokens {
+		sessionCheck = sessions
+	}
+	e.Use(middleware.JWTAuthMiddleware(jwtSvc, tokens, sessionCheck))
+	// リードレプリカがあれば、書き込んだユーザーの読み取りをしばらくプライマリに向ける (ユーザー単位なので JWT 認証の後に置く)
+	if replica.Enabled() {
+		e.Use(middleware.ReadReplicaMiddleware(replica))
+	}
+	// レート制限はテナント・ユーザー単位なので JWT 認証の後に置く
+	if server.env.RateLimitEnabled {
+		e.Use(middleware.RateLimitMiddleware(limit))
+	}
+	// リクエストを OpenAPI 仕様で検証する (不正なリクエストの応答を Idempotency-Key で保存しないよう、その前に置く)
+	spec, err := api.GetSwagger()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	requestValidator, err := middleware.RequestValidatorMiddleware(spec)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	e.Use(requestValidator)
+	// Idempotency-Key はテナント・ユーザー単位なので JWT 認証の後に置く
+	e.Use(middleware.IdempotencyMiddleware(idem))
 
 	// 依存解決したハンドラーをルーティングに登録
 	api.RegisterHandlers(e, server)
 
+	// ゴミ箱の保持期間切れTodo・期限切れの Idempotency-Key・古いログイン試行・終了したセッションを定期的に削除する
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	startPurger(jobCtx, "trashed todos", server.env.TrashPurgeInterval, trash.PurgeExpired)
+	startPurger(jobCtx, "idempotency keys", server.env.IdempotencyKeyPurgeInterval, idem.PurgeExpired)
+	startPurger(jobCtx, "login attempts", server.env.LoginAttemptPurgeInterval, auth.PurgeLoginAttempts)
+	startPurger(jobCtx, "sessions", server.env.SessionPurgeInterval, sessions.PurgeInactive)
+
 	// グレースフルシャットダウンを仕込む
-	gracefulShutdown(e)
+	done := gracefulShutdown(e, admin, stopJobs, tracerProvider, health, client, replica, server.env.ShutdownDrainDelay)
 
-	return e, server.env, client, nil
+	return e, admin, server.env, done, nil
 }
 
-func gracefulShutdown(e *echo.Echo) {
+func gracefulShutdown(
+	e, admin *echo.Echo,
+	stopJobs context.CancelFunc,
+	tracerProvider *sdktrace.TracerProvider,
+	health usecase.IHealthInteractor,
+	client *ent.Client,
+	replica *database.ReadReplica,
+	drainDelay time.Duration,
+) <-chan struct{} {
 	shutdownCh := make(chan os.Signal, 1)
 	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
 
+	done := make(chan struct{})
 	go func() {
+		defer close(done)
 		sig := <-shutdownCh
-		log.Printf("Received signal %s, shutting down...", sig)
+		slog.Info("shutting down", "signal", sig.String())
+		stopJobs()
+
+		// /readyz を失敗させ、ロードバランサーが振り分けを止めるまで待ってから接続の受け付けをやめる
+		health.Drain()
+		time.Sleep(drainDelay)
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
 		if err := e.Shutdown(ctx); err != nil {
-			log.Printf("failed to shut down echo server gracefully: %v", err)
+			slog.Error("failed to shut down echo server gracefully", "error", err)
 			if err := e.Close(); err != nil {
-				log.Printf("failed to force close echo server: %v", err)
+				slog.Error("failed to force close echo server", "error", err)
 			}
 		}
+		if err := admin.Shutdown(ctx); err != nil {
+			slog.Error("failed to shut down admin server gracefully", "error", err)
+		}
+		// 未送信のスパンを送り切る
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
+		// 処理中のリクエストが終わってからDB接続を閉じる
+		if err := database.CloseEntClient(client); err != nil {
+			slog.Error("failed to close database connections", "error", err)
+		}
+		if err := replica.Close(); err != nil {
+			slog.Error("failed to close read replica connections", "error", err)
+		}
 	}()
+	return done
 }
@@ -0,0 +1,23 @@
+package router
+
+import (
+	"good-todo-go/internal/presentation/public/api"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) ListSessions(c echo.Context) error {
+	return s.sessionController.List(c)
+}
+
+func (s *Server) RevokeAllSessions(c echo.Context, params api.RevokeAllSessionsParams) error {
+	return s.sessionController.RevokeAll(c, params)
+}
+
+func (s *Server) RevokeSession(c echo.Context, sessionId string) error {
+	return s.sessionController.Revoke(c, sessionId)
+}
+
+func (s *Server) LogoutUser(c echo.Context, userId string) error {
+	return s.sessionController.ForceLogout(c, userId)
+}
@@ -22,10 +22,30 @@ func (s *Server) DeleteTodo(c echo.Context, todoId string) error {
 	return s.todoController.DeleteTodo(c, todoId)
 }
 
-func (s *Server) GetTodo(c echo.Context, todoId string) error {
-	return s.todoController.GetTodo(c, todoId)
+func (s *Server) GetTodo(c echo.Context, todoId string, params api.GetTodoParams) error {
+	return s.todoController.GetTodo(c, todoId, params)
 }
 
-func (s *Server) UpdateTodo(c echo.Context, todoId string) error {
-	return s.todoController.UpdateTodo(c, todoId)
+func (s *Server) UpdateTodo(c echo.Context, todoId string, params api.UpdateTodoParams) error {
+	return s.todoController.UpdateTodo(c, todoId, params)
+}
+
+func (s *Server) PatchTodo(c echo.Context, todoId string, params api.PatchTodoParams) error {
+	return s.todoController.PatchTodo(c, todoId, params)
+}
+
+func (s *Server) BatchTodos(c echo.Context) error {
+	return s.todoController.BatchTodos(c)
+}
+
+func (s *Server) GetTrash(c echo.Context, params api.GetTrashParams) error {
+	return s.todoController.GetTrash(c, params)
+}
+
+func (s *Server) RestoreTodo(c echo.Context, todoId string) error {
+	return s.todoController.RestoreTodo(c, todoId)
+}
+
+func (s *Server) PurgeTodo(c echo.Context, todoId string) error {
+	return s.todoController.PurgeTodo(c, todoId)
 }
@@ -11,3 +11,31 @@ func (s *Server) GetMe(c echo.Context) error {
 func (s *Server) UpdateMe(c echo.Context) error {
 	return s.userController.UpdateMe(c)
 }
+
+func (s *Server) PatchMe(c echo.Context) error {
+	return s.userController.PatchMe(c)
+}
+
+func (s *Server) ChangePassword(c echo.Context) error {
+	return s.userController.ChangePassword(c)
+}
+
+func (s *Server) RequestEmailChange(c echo.Context) error {
+	return s.userController.RequestEmailChange(c)
+}
+
+func (s *Server) ConfirmEmailChange(c echo.Context) error {
+	return s.userController.ConfirmEmailChange(c)
+}
+
+func (s *Server) SetTenantPasswordPolicy(c echo.Context) error {
+	return s.userController.SetPasswordPolicy(c)
+}
+
+func (s *Server) SetTenantRateLimit(c echo.Context) error {
+	return s.userController.SetRateLimit(c)
+}
+
+func (s *Server) SetTenantTrashRetention(c echo.Context) error {
+	return s.userController.SetTrashRetention(c)
+}
@@ -0,0 +1,253 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// deletionCodeTTL is how long a mailed account deletion code can be used
+const deletionCodeTTL = 15 * time.Minute
+
+type IAccountInteractor interface {
+	// Export returns everything stored about the user
+	Export(ctx context.Context, in *input.AccountUserInput) (*output.AccountExportOutput, error)
+	// SendDeletionCode mails a code that confirms Delete, for users who sign in without a password
+	SendDeletionCode(ctx context.Context, in *input.AccountUserInput) error
+	// Delete permanently removes the user and their data from the tenant
+	Delete(ctx context.Context, in *input.DeleteAccountInput) error
+	// SetDeletionPolicy chooses what happens to the public todos of deleted accounts (tenant admins only)
+	SetDeletionPolicy(ctx context.Context, in *input.SetDeletionPolicyInput) error
+}
+
+type AccountInteractor struct {
+	userRepo    repository.IUserRepository
+	authRepo    repository.IAuthRepository
+	todoRepo    repository.ITodoRepository
+	sessionRepo repository.ISessionRepository
+	tokenRepo   repository.IPersonalAccessTokenRepository
+	clock       pkg.IClock
+	mailer      mailer.IMailer
+}
+
+func NewAccountInteractor(
+	userRepo repository.IUserRepository,
+	authRepo repository.IAuthRepository,
+	todoRepo repository.ITodoRepository,
+	sessionRepo repository.ISessionRepository,
+	tokenRepo repository.IPersonalAccessTokenRepository,
+	clock pkg.IClock,
+	mail mailer.IMailer,
+) IAccountInteractor {
+	return &AccountInteractor{
+		userRepo:    userRepo,
+		authRepo:    authRepo,
+		todoRepo:    todoRepo,
+		sessionRepo: sessionRepo,
+		tokenRepo:   tokenRepo,
+		clock:       clock,
+		mailer:      mail,
+	}
+}
+
+func (i *AccountInteractor) Export(ctx context.Context, in *input.AccountUserInput) (*output.AccountExportOutput, error) {
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+
+	todos, err := i.todoRepo.FindAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get todos", err)
+	}
+	now := i.clock.Now()
+	sessions, err := i.sessionRepo.FindActiveByUserID(ctx, user.TenantID, user.ID, now)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get sessions", err)
+	}
+	tokens, err := i.tokenRepo.FindByUserID(ctx, user.TenantID, user.ID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get tokens", err)
+	}
+
+	out := &output.AccountExportOutput{
+		ExportedAt:           now,
+		User:                 output.NewUserOutput(user),
+		Todos:                make([]*output.TodoOutput, len(todos)),
+		Sessions:             make([]*output.SessionOutput, len(sessions)),
+		PersonalAccessTokens: make([]*output.PersonalAccessTokenOutput, len(tokens)),
+	}
+	for idx, t := range todos {
+		out.Todos[idx] = output.NewTodoOutput(t)
+	}
+	for idx, s := range sessions {
+		out.Sessions[idx] = output.NewSessionOutput(s, "")
+	}
+	for idx, t := range tokens {
+		out.PersonalAccessTokens[idx] = output.NewPersonalAccessTokenOutput(t)
+	}
+	return out, nil
+}
+
+func (i *AccountInteractor) SendDeletionCode(ctx context.Context, in *input.AccountUserInput) error {
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+
+	code, err := generateDeletionCode()
+	if err != nil {
+		return cerror.NewInternalServerError("failed to generate code", err)
+	}
+	codeHash := hashDeletionCode(code)
+	expiresAt := i.clock.Now().Add(deletionCodeTTL)
+	user.DeletionCodeHash = &codeHash
+	user.DeletionCodeExpiresAt = &expiresAt
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	msg := &mailer.Message{
+		To:      user.Email,
+		Subject: "Confirm deleting your Good Todo account",
+		Body: fmt.Sprintf(
+			"Your code to delete your account is %s\n\n"+
+				"It expires at %s. Deleting the account removes your todos and cannot be undone.\n"+
+				"If you did not ask for this, ignore this email.\n",
+			code,
+			expiresAt.UTC().Format(time.RFC1123),
+		),
+	}
+	if err := i.mailer.Send(ctx, msg); err != nil {
+		return cerror.NewInternalServerError("failed to send code", err)
+	}
+	return nil
+}
+
+func (i *AccountInteractor) Delete(ctx context.Context, in *input.DeleteAccountInput) error {
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+
+	switch {
+	case in.Password != "":
+		if !pkg.CheckPasswordHash(in.Password, user.PasswordHash) {
+			return cerror.NewBadRequest("password is incorrect", nil)
+		}
+	case in.Code != "":
+		if err := i.checkDeletionCode(ctx, user, in.Code); err != nil {
+			return err
+		}
+	default:
+		return cerror.NewBadRequest("password or code is required", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, user.TenantID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to get tenant", err)
+	}
+	publicTodosOwnerID := ""
+	if tenant.DeletedUserPublicTodos == model.DeletedUserPublicTodosReassign {
+		admin, err := i.userRepo.FindOldestAdmin(ctx, user.ID)
+		if err != nil {
+			return cerror.NewInternalServerError("failed to find an admin", err)
+		}
+		// Without another admin the public todos are deleted with the rest
+		if admin != nil {
+			publicTodosOwnerID = admin.ID
+		}
+	}
+
+	// Sessions and tokens go with the user, so every login ends
+	if err := i.userRepo.Delete(ctx, user.ID, publicTodosOwnerID); err != nil {
+		return cerror.NewInternalServerError("failed to delete account", err)
+	}
+
+	i.notifyDeleted(ctx, user)
+	return nil
+}
+
+// checkDeletionCode accepts a code only once; a wrong guess also uses it up
+func (i *AccountInteractor) checkDeletionCode(ctx context.Context, user *model.User, code string) error {
+	if user.DeletionCodeHash == nil || user.DeletionCodeExpiresAt == nil {
+		return cerror.NewBadRequest("invalid or expired code", nil)
+	}
+	valid := subtle.ConstantTimeCompare([]byte(hashDeletionCode(code)), []byte(*user.DeletionCodeHash)) == 1 &&
+		i.clock.Now().Before(*user.DeletionCodeExpiresAt)
+
+	user.DeletionCodeHash = nil
+	user.DeletionCodeExpiresAt = nil
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	if !valid {
+		return cerror.NewBadRequest("invalid or expired code", nil)
+	}
+	return nil
+}
+
+// notifyDeleted confirms the deletion to the user. A failure only logs, the account is gone
+func (i *AccountInteractor) notifyDeleted(ctx context.Context, user *model.User) {
+	msg := &mailer.Message{
+		To:      user.Email,
+		Subject: "Your Good Todo account has been deleted",
+		Body:    "Your account and your todos have been deleted.\n",
+	}
+	if err := i.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "failed to send deletion notice", "user_id", user.ID, "error", err)
+	}
+}
+
+func (i *AccountInteractor) SetDeletionPolicy(ctx context.Context, in *input.SetDeletionPolicyInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can change the account deletion policy", nil)
+	}
+	if in.PublicTodos != model.DeletedUserPublicTodosDelete && in.PublicTodos != model.DeletedUserPublicTodosReassign {
+		return cerror.NewBadRequest("public_todos must be delete or reassign", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+
+	tenant.DeletedUserPublicTodos = in.PublicTodos
+	if _, err := i.authRepo.UpdateTenant(ctx, tenant); err != nil {
+		return cerror.NewInternalServerError("failed to update tenant", err)
+	}
+
+	return nil
+}
+
+// generateDeletionCode returns 6 random digits
+func generateDeletionCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// hashDeletionCode keeps the code out of the database. Six digits are easy to
+// brute-force from a hash, so the code is also short-lived and single-use
+func hashDeletionCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,269 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
+	mock_mailer "good-todo-go/internal/pkg/mailer/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type accountTestMocks struct {
+	userRepo    *mock_repository.MockIUserRepository
+	authRepo    *mock_repository.MockIAuthRepository
+	todoRepo    *mock_repository.MockITodoRepository
+	sessionRepo *mock_repository.MockISessionRepository
+	tokenRepo   *mock_repository.MockIPersonalAccessTokenRepository
+	mailer      *mock_mailer.MockIMailer
+}
+
+func newAccountTestInteractor(t *testing.T, now time.Time) (IAccountInteractor, accountTestMocks) {
+	ctrl := gomock.NewController(t)
+	m := accountTestMocks{
+		userRepo:    mock_repository.NewMockIUserRepository(ctrl),
+		authRepo:    mock_repository.NewMockIAuthRepository(ctrl),
+		todoRepo:    mock_repository.NewMockITodoRepository(ctrl),
+		sessionRepo: mock_repository.NewMockISessionRepository(ctrl),
+		tokenRepo:   mock_repository.NewMockIPersonalAccessTokenRepository(ctrl),
+		mailer:      mock_mailer.NewMockIMailer(ctrl),
+	}
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+	return NewAccountInteractor(m.userRepo, m.authRepo, m.todoRepo, m.sessionRepo, m.tokenRepo, clock, m.mailer), m
+}
+
+func TestAccountInteractor_Export(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	interactor, m := newAccountTestInteractor(t, now)
+	m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com"}, nil)
+	m.todoRepo.EXPECT().FindAllByUserID(gomock.Any(), "user-id").Return([]*model.Todo{
+		{ID: "todo-1", UserID: "user-id"},
+		{ID: "todo-2", UserID: "user-id", DeletedAt: &now},
+	}, nil)
+	m.sessionRepo.EXPECT().FindActiveByUserID(gomock.Any(), "tenant-id", "user-id", now).Return([]*model.Session{{ID: "session-1"}}, nil)
+	m.tokenRepo.EXPECT().FindByUserID(gomock.Any(), "tenant-id", "user-id").Return([]*model.PersonalAccessToken{}, nil)
+
+	out, err := interactor.Export(context.Background(), &input.AccountUserInput{TenantID: "tenant-id", UserID: "user-id"})
+	require.NoError(t, err)
+	assert.Equal(t, now, out.ExportedAt)
+	assert.Equal(t, "test@example.com", out.User.Email)
+	require.Len(t, out.Todos, 2)
+	assert.NotNil(t, out.Todos[1].DeletedAt)
+	assert.Len(t, out.Sessions, 1)
+	assert.Empty(t, out.PersonalAccessTokens)
+}
+
+func TestAccountInteractor_SendDeletionCode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	interactor, m := newAccountTestInteractor(t, now)
+	m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com"}, nil)
+	var saved *model.User
+	m.authRepo.EXPECT().
+		UpdateUser(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+			assert.Equal(t, now.Add(deletionCodeTTL), *u.DeletionCodeExpiresAt)
+			saved = u
+			return u, nil
+		})
+	m.mailer.EXPECT().
+		Send(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msg *mailer.Message) error {
+			assert.Equal(t, "test@example.com", msg.To)
+			code := regexp.MustCompile(`\d{6}`).FindString(msg.Body)
+			assert.Equal(t, hashDeletionCode(code), *saved.DeletionCodeHash)
+			return nil
+		})
+
+	err := interactor.SendDeletionCode(context.Background(), &input.AccountUserInput{TenantID: "tenant-id", UserID: "user-id"})
+	require.NoError(t, err)
+}
+
+func TestAccountInteractor_Delete(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	hash, err := pkg.HashPassword("password123")
+	require.NoError(t, err)
+	newUser := func() *model.User {
+		codeHash := hashDeletionCode("123456")
+		expiresAt := now.Add(time.Minute)
+		return &model.User{
+			ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", PasswordHash: hash,
+			DeletionCodeHash: &codeHash, DeletionCodeExpiresAt: &expiresAt,
+		}
+	}
+	tenant := func(policy string) *model.Tenant {
+		return &model.Tenant{ID: "tenant-id", DeletedUserPublicTodos: policy}
+	}
+	expectDeleted := func(m accountTestMocks, publicTodosOwnerID string) {
+		m.userRepo.EXPECT().Delete(gomock.Any(), "user-id", publicTodosOwnerID).Return(nil)
+		m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name       string
+		in         *input.DeleteAccountInput
+		setupMocks func(m accountTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - password, public todos deleted",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id", Password: "password123"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant(model.DeletedUserPublicTodosDelete), nil)
+				expectDeleted(m, "")
+			},
+		},
+		{
+			name: "success - code, public todos reassigned to the oldest admin",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id", Code: "123456"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Nil(t, u.DeletionCodeHash)
+						return u, nil
+					})
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant(model.DeletedUserPublicTodosReassign), nil)
+				m.userRepo.EXPECT().FindOldestAdmin(gomock.Any(), "user-id").Return(&model.User{ID: "admin-id"}, nil)
+				expectDeleted(m, "admin-id")
+			},
+		},
+		{
+			name: "success - reassign without another admin deletes them",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id", Password: "password123"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant(model.DeletedUserPublicTodosReassign), nil)
+				m.userRepo.EXPECT().FindOldestAdmin(gomock.Any(), "user-id").Return(nil, nil)
+				expectDeleted(m, "")
+			},
+		},
+		{
+			name: "fail - wrong password",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id", Password: "wrong"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - wrong code uses it up",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id", Code: "654321"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Nil(t, u.DeletionCodeHash)
+						assert.Nil(t, u.DeletionCodeExpiresAt)
+						return u, nil
+					})
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - neither password nor code",
+			in:   &input.DeleteAccountInput{TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newAccountTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.Delete(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAccountInteractor_SetDeletionPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		in         *input.SetDeletionPolicyInput
+		setupMocks func(m accountTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			in:   &input.SetDeletionPolicyInput{Role: "admin", TenantID: "tenant-id", PublicTodos: "reassign"},
+			setupMocks: func(m accountTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", DeletedUserPublicTodos: "delete"}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id", DeletedUserPublicTodos: "reassign"}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name:       "fail - not an admin",
+			in:         &input.SetDeletionPolicyInput{Role: "member", TenantID: "tenant-id", PublicTodos: "reassign"},
+			setupMocks: func(accountTestMocks) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:       "fail - unknown policy",
+			in:         &input.SetDeletionPolicyInput{Role: "admin", TenantID: "tenant-id", PublicTodos: "archive"},
+			setupMocks: func(accountTestMocks) {},
+			wantCode:   cerror.ErrCodeBadRequest,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newAccountTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.SetDeletionPolicy(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+var auditActions = map[string]bool{
+	"create":      true,
+	"update":      true,
+	"delete":      true,
+	"soft_delete": true,
+	"restore":     true,
+}
+
+type IAuditInteractor interface {
+	GetAuditEvents(ctx context.Context, in *input.GetAuditEventsInput) (*output.AuditEventListOutput, error)
+}
+
+type AuditInteractor struct {
+	auditEventRepo repository.IAuditEventRepository
+}
+
+func NewAuditInteractor(
+	auditEventRepo repository.IAuditEventRepository,
+) IAuditInteractor {
+	return &AuditInteractor{
+		auditEventRepo: auditEventRepo,
+	}
+}
+
+func (i *AuditInteractor) GetAuditEvents(ctx context.Context, in *input.GetAuditEventsInput) (*output.AuditEventListOutput, error) {
+	// Only tenant admins can read the audit log
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can view audit events", nil)
+	}
+
+	if in.Action != nil && !auditActions[*in.Action] {
+		return nil, cerror.NewBadRequest("invalid action filter", nil)
+	}
+	if in.From != nil && in.To != nil && !in.From.Before(*in.To) {
+		return nil, cerror.NewBadRequest("from must be before to", nil)
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	filter := &model.AuditEventFilter{
+		EntityType: in.EntityType,
+		EntityID:   in.EntityID,
+		ActorID:    in.ActorID,
+		Action:     in.Action,
+		From:       in.From,
+		To:         in.To,
+	}
+
+	events, err := i.auditEventRepo.FindByFilter(ctx, filter, limit, in.Offset)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get audit events", err)
+	}
+
+	total, err := i.auditEventRepo.CountByFilter(ctx, filter)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count audit events", err)
+	}
+
+	return output.NewAuditEventListOutput(events, total), nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditInteractor_GetAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	actorID := "user-1"
+	action := "update"
+	invalidAction := "drop"
+
+	tests := []struct {
+		name     string
+		usecase  func(ctx context.Context, ctrl *gomock.Controller) IAuditInteractor
+		input    *input.GetAuditEventsInput
+		wantLen  int
+		wantCode cerror.ErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "success - admin gets filtered events",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IAuditInteractor {
+				auditEventRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+
+				filter := &model.AuditEventFilter{
+					ActorID: &actorID,
+					Action:  &action,
+				}
+				auditEventRepo.EXPECT().
+					FindByFilter(ctx, filter, 20, 0).
+					Return([]*model.AuditEvent{
+						{
+							ID:         "event-1",
+							TenantID:   "tenant-1",
+							ActorID:    &actorID,
+							Action:     "update",
+							EntityType: "Todo",
+							EntityID:   "todo-1",
+							Before:     map[string]any{"title": "old"},
+							After:      map[string]any{"title": "new"},
+							CreatedAt:  now,
+						},
+					}, nil)
+				auditEventRepo.EXPECT().
+					CountByFilter(ctx, filter).
+					Return(1, nil)
+
+				return NewAuditInteractor(auditEventRepo)
+			},
+			input: &input.GetAuditEventsInput{
+				Role:    "admin",
+				ActorID: &actorID,
+				Action:  &action,
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			name: "error - member is forbidden",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IAuditInteractor {
+				return NewAuditInteractor(mock_repository.NewMockIAuditEventRepository(ctrl))
+			},
+			input: &input.GetAuditEventsInput{
+				Role: "member",
+			},
+			wantCode: cerror.ErrCodeForbidden,
+			wantErr:  true,
+		},
+		{
+			name: "error - invalid action filter",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IAuditInteractor {
+				return NewAuditInteractor(mock_repository.NewMockIAuditEventRepository(ctrl))
+			},
+			input: &input.GetAuditEventsInput{
+				Role:   "admin",
+				Action: &invalidAction,
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "error - repository error",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IAuditInteractor {
+				auditEventRepo := mock_repository.NewMockIAuditEventRepository(ctrl)
+				auditEventRepo.EXPECT().
+					FindByFilter(ctx, gomock.Any(), 100, 0).
+					Return(nil, errors.New("db error"))
+
+				return NewAuditInteractor(auditEventRepo)
+			},
+			input: &input.GetAuditEventsInput{
+				Role:  "admin",
+				Limit: 1000,
+			},
+			wantCode: cerror.ErrCodeInternalServerError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			got, gotErr := interactor.GetAuditEvents(ctx, tt.input)
+
+			if tt.wantErr {
+				assert.Nil(t, got)
+				var appErr *cerror.AppError
+				if assert.ErrorAs(t, gotErr, &appErr) {
+					assert.Equal(t, tt.wantCode, appErr.Code)
+				}
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Len(t, got.Events, tt.wantLen)
+			}
+		})
+	}
+}
@@ -5,57 +5,110 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
-	"log"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
 	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
 
 type IAuthInteractor interface {
 	Register(ctx context.Context, in *input.RegisterInput) (*output.AuthOutput, error)
+	// Login returns an MFA challenge instead of tokens when a second factor is needed
 	Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error)
+	// EnrollMFA starts enrollment for a challenged user whose tenant requires two-factor authentication
+	EnrollMFA(ctx context.Context, in *input.EnrollMFAInput) (*output.MFAEnrollmentOutput, error)
+	// VerifyMFA completes a challenged login with a TOTP or recovery code
+	VerifyMFA(ctx context.Context, in *input.VerifyMFAInput) (*output.AuthOutput, error)
 	VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (*output.VerifyEmailOutput, error)
 	RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (*output.AuthOutput, error)
+	// UnlockUser lifts a lockout and forgets the failed logins (tenant admins only)
+	UnlockUser(ctx context.Context, in *input.UnlockUserInput) error
+	// PurgeLoginAttempts deletes login attempts older than the retention of every tenant
+	PurgeLoginAttempts(ctx context.Context) (int, error)
+}
+
+// LoginLockoutPolicy controls how failed logins are throttled
+type LoginLockoutPolicy struct {
+	// MaxFailures failed logins for an account within Window lock it for LockoutDuration
+	MaxFailures     int
+	Window          time.Duration
+	LockoutDuration time.Duration
+	// MaxIPFailures failed logins from one IP within Window refuse further logins from it
+	MaxIPFailures int
+	// DelayBase is the wait after the first failure for an email, doubled after each further one.
+	// Zero disables the delay
+	DelayBase time.Duration
+	// AttemptRetention is how long login attempts are kept
+	AttemptRetention time.Duration
 }
 
 type AuthInteractor struct {
-	authRepo   repository.IAuthRepository
-	jwtService *pkg.JWTService
-	uuidGen    pkg.IUUIDGenerator
+	authRepo         repository.IAuthRepository
+	loginAttemptRepo repository.ILoginAttemptRepository
+	sessionRepo      repository.ISessionRepository
+	jwtService       *pkg.JWTService
+	uuidGen          pkg.IUUIDGenerator
+	clock            pkg.IClock
+	mailer           mailer.IMailer
+	passwords        *PasswordPolicy
+	lockout          LoginLockoutPolicy
 }
 
 func NewAuthInteractor(
 	authRepo repository.IAuthRepository,
+	loginAttemptRepo repository.ILoginAttemptRepository,
+	sessionRepo repository.ISessionRepository,
 	jwtService *pkg.JWTService,
 	uuidGen pkg.IUUIDGenerator,
+	clock pkg.IClock,
+	mail mailer.IMailer,
+	passwords *PasswordPolicy,
+	lockout LoginLockoutPolicy,
 ) IAuthInteractor {
 	return &AuthInteractor{
-		authRepo:   authRepo,
-		jwtService: jwtService,
-		uuidGen:    uuidGen,
+		authRepo:         authRepo,
+		loginAttemptRepo: loginAttemptRepo,
+		sessionRepo:      sessionRepo,
+		jwtService:       jwtService,
+		uuidGen:          uuidGen,
+		clock:            clock,
+		mailer:           mail,
+		passwords:        passwords,
+		lockout:          lockout,
 	}
 }
 
 func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput) (*output.AuthOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Find or create tenant
 	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
 	if err != nil {
-		// Create new tenant if not exists
+		// A new tenant has the default password policy, checked before it is created
 		tenant = &model.Tenant{
 			ID:   i.uuidGen.Generate(),
 			Name: in.TenantSlug, // Use slug as name initially
 			Slug: in.TenantSlug,
 		}
+		if err := i.passwords.Validate(ctx, tenant, nil, "password", in.Password); err != nil {
+			return nil, err
+		}
 		tenant, err = i.authRepo.CreateTenant(ctx, tenant)
 		if err != nil {
-			log.Printf("failed to create tenant: %v", err)
+			slog.ErrorContext(ctx, "failed to create tenant", "tenant_slug", in.TenantSlug, "error", err)
 			return nil, cerror.NewInternalServerError("failed to create tenant", err)
 		}
+	} else if err := i.passwords.Validate(ctx, tenant, nil, "password", in.Password); err != nil {
+		return nil, err
 	}
 
 	// Check if user already exists
@@ -65,7 +118,7 @@ func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput)
 	}
 
 	// Hash password
-	passwordHash, err := pkg.HashPassword(in.Password)
+	passwordHash, err := i.passwords.Hash(in.Password)
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to hash password", err)
 	}
@@ -95,57 +148,308 @@ func (i *AuthInteractor) Register(ctx context.Context, in *input.RegisterInput)
 		return nil, cerror.NewInternalServerError("failed to create user", err)
 	}
 
-	// Generate JWT tokens
-	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
-	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
-	}
-
 	// TODO: Send verification email
 
-	return &output.AuthOutput{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    tokenPair.ExpiresIn,
-		User:         output.NewUserOutput(user),
-	}, nil
+	return startSession(ctx, i.sessionRepo, i.jwtService, i.uuidGen, user, sessionClient{
+		IPAddress: in.IPAddress,
+		UserAgent: in.UserAgent,
+	}, i.clock.Now())
 }
 
 func (i *AuthInteractor) Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Find tenant
 	tenant, err := i.authRepo.FindTenantBySlug(ctx, in.TenantSlug)
 	if err != nil {
 		return nil, cerror.NewUnauthorized("invalid credentials", nil)
 	}
 
+	now := i.clock.Now()
+	failures, err := i.checkLoginThrottle(ctx, tenant.ID, in.Email, in.IPAddress, now)
+	if err != nil {
+		return nil, err
+	}
+
 	// Find user
 	user, err := i.authRepo.FindUserByEmail(ctx, tenant.ID, in.Email)
 	if err != nil {
+		// Unknown emails are recorded too, so they are throttled like real accounts
+		if err := i.recordLoginFailure(ctx, tenant.ID, in.Email, in.IPAddress, nil, failures, now); err != nil {
+			return nil, err
+		}
 		return nil, cerror.NewUnauthorized("invalid credentials", nil)
 	}
 
+	if user.LockedUntil != nil && now.Before(*user.LockedUntil) {
+		return nil, withRetryAfter(cerror.NewAccountLocked("account is temporarily locked", nil), user.LockedUntil.Sub(now))
+	}
+
 	// Verify password
 	if !pkg.CheckPasswordHash(in.Password, user.PasswordHash) {
+		if err := i.recordLoginFailure(ctx, tenant.ID, in.Email, in.IPAddress, user, failures, now); err != nil {
+			return nil, err
+		}
 		return nil, cerror.NewUnauthorized("invalid credentials", nil)
 	}
+	user = i.upgradePasswordHash(ctx, user, in.Password)
 
-	// Generate JWT tokens
-	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
+	// The login is not recorded as successful until the second factor, so the
+	// password step cannot be used to reset failed code attempts
+	if user.MFAEnabled || tenant.RequireMFA {
+		return i.mfaChallenge(user)
+	}
+
+	return i.completeLogin(ctx, user, sessionClient{IPAddress: in.IPAddress, UserAgent: in.UserAgent}, now)
+}
+
+// upgradePasswordHash rehashes a verified password whose hash was made with an
+// older algorithm or cost. Failing to store it only delays the upgrade
+func (i *AuthInteractor) upgradePasswordHash(ctx context.Context, user *model.User, password string) *model.User {
+	if !i.passwords.NeedsRehash(user.PasswordHash) {
+		return user
+	}
+	hash, err := i.passwords.Hash(password)
 	if err != nil {
-		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
+		slog.ErrorContext(ctx, "failed to rehash password", "user_id", user.ID, "error", err)
+		return user
+	}
+	previous := user.PasswordHash
+	user.PasswordHash = hash
+	updated, err := i.authRepo.UpdateUser(ctx, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to store rehashed password", "user_id", user.ID, "error", err)
+		user.PasswordHash = previous
+		return user
+	}
+	return updated
+}
+
+// completeLogin records a successful login and starts a session
+func (i *AuthInteractor) completeLogin(ctx context.Context, user *model.User, client sessionClient, now time.Time) (*output.AuthOutput, error) {
+	if err := i.recordLoginAttempt(ctx, user.TenantID, user.Email, client.IPAddress, true, now); err != nil {
+		// The failures keep counting, which is safer than refusing a valid login
+		slog.ErrorContext(ctx, "failed to record login", "user_id", user.ID, "error", err)
+	}
+
+	// Clear an expired lock
+	if user.LockedUntil != nil {
+		user.LockedUntil = nil
+		updated, err := i.authRepo.UpdateUser(ctx, user)
+		if err != nil {
+			return nil, cerror.NewInternalServerError("failed to update user", err)
+		}
+		user = updated
+	}
+
+	return startSession(ctx, i.sessionRepo, i.jwtService, i.uuidGen, user, client, now)
+}
+
+// mfaChallenge asks for the second factor, or for enrollment when the tenant
+// requires two-factor authentication and the user has not enrolled yet
+func (i *AuthInteractor) mfaChallenge(user *model.User) (*output.AuthOutput, error) {
+	token, err := i.jwtService.GenerateMFAChallengeToken(user.ID, user.TenantID, user.Email, user.Role)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate MFA token", err)
 	}
 
 	return &output.AuthOutput{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    tokenPair.ExpiresIn,
-		User:         output.NewUserOutput(user),
+		MFARequired:           true,
+		MFAToken:              token,
+		MFAEnrollmentRequired: !user.MFAEnabled,
 	}, nil
 }
 
+func (i *AuthInteractor) EnrollMFA(ctx context.Context, in *input.EnrollMFAInput) (*output.MFAEnrollmentOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	claims, err := i.jwtService.ValidateMFAChallengeToken(in.MFAToken)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("invalid or expired MFA token", err)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, claims.TenantID, claims.UserID)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("user not found", nil)
+	}
+	if user.MFAEnabled {
+		return nil, cerror.NewConflict("two-factor authentication is already enabled", nil)
+	}
+
+	out, err := startMFAEnrollment(user)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	return out, nil
+}
+
+func (i *AuthInteractor) VerifyMFA(ctx context.Context, in *input.VerifyMFAInput) (*output.AuthOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	claims, err := i.jwtService.ValidateMFAChallengeToken(in.MFAToken)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("invalid or expired MFA token", err)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, claims.TenantID, claims.UserID)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("user not found", nil)
+	}
+
+	// Wrong codes count as failed logins, so codes cannot be guessed faster than passwords
+	now := i.clock.Now()
+	failures, err := i.checkLoginThrottle(ctx, user.TenantID, user.Email, in.IPAddress, now)
+	if err != nil {
+		return nil, err
+	}
+	if user.LockedUntil != nil && now.Before(*user.LockedUntil) {
+		return nil, withRetryAfter(cerror.NewAccountLocked("account is temporarily locked", nil), user.LockedUntil.Sub(now))
+	}
+
+	var (
+		recoveryCodes []string
+		ok            bool
+	)
+	switch {
+	case user.MFAEnabled:
+		ok = acceptMFACode(user, in.Code, now)
+	case user.MFASecret != nil:
+		// Enrollment required by the tenant: the first code confirms it
+		recoveryCodes, ok, err = finishMFAEnrollment(user, in.Code, now)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, cerror.NewBadRequest("two-factor enrollment has not been started", nil)
+	}
+
+	if !ok {
+		if err := i.recordLoginFailure(ctx, user.TenantID, user.Email, in.IPAddress, user, failures, now); err != nil {
+			return nil, err
+		}
+		return nil, cerror.NewUnauthorized("invalid two-factor code", nil)
+	}
+
+	// Save the used step, the used recovery code or the new enrollment
+	user, err = i.authRepo.UpdateUser(ctx, user)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	out, err := i.completeLogin(ctx, user, sessionClient{IPAddress: in.IPAddress, UserAgent: in.UserAgent}, now)
+	if err != nil {
+		return nil, err
+	}
+	out.RecoveryCodes = recoveryCodes
+	return out, nil
+}
+
+// checkLoginThrottle refuses clients that keep failing, before the account is looked at.
+// It returns the failures for the email since its last successful login.
+func (i *AuthInteractor) checkLoginThrottle(ctx context.Context, tenantID, email, ip string, now time.Time) (*model.LoginFailures, error) {
+	since := now.Add(-i.lockout.Window)
+
+	ipFailures, err := i.loginAttemptRepo.CountFailuresByIP(ctx, tenantID, ip, since)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to check login attempts", err)
+	}
+	if ipFailures >= i.lockout.MaxIPFailures {
+		return nil, withRetryAfter(cerror.NewTooManyRequests("too many failed login attempts", nil), i.lockout.Window)
+	}
+
+	failures, err := i.loginAttemptRepo.FindFailuresByEmail(ctx, tenantID, email, since)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to check login attempts", err)
+	}
+	if wait := i.loginDelay(failures, now); wait > 0 {
+		return nil, withRetryAfter(cerror.NewTooManyRequests("too many failed login attempts", nil), wait)
+	}
+
+	return failures, nil
+}
+
+// recordLoginFailure records a failed login and locks the account when it reaches the limit.
+// user is nil when the email is unknown.
+func (i *AuthInteractor) recordLoginFailure(
+	ctx context.Context,
+	tenantID, email, ip string,
+	user *model.User,
+	previous *model.LoginFailures,
+	now time.Time,
+) error {
+	if err := i.recordLoginAttempt(ctx, tenantID, email, ip, false, now); err != nil {
+		return cerror.NewInternalServerError("failed to record login attempt", err)
+	}
+
+	if user != nil && previous.Count+1 >= i.lockout.MaxFailures {
+		lockedUntil := now.Add(i.lockout.LockoutDuration)
+		user.LockedUntil = &lockedUntil
+		if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+			return cerror.NewInternalServerError("failed to lock account", err)
+		}
+		i.notifyLocked(ctx, user, lockedUntil)
+	}
+
+	return nil
+}
+
+func (i *AuthInteractor) recordLoginAttempt(ctx context.Context, tenantID, email, ip string, succeeded bool, now time.Time) error {
+	return i.loginAttemptRepo.Create(ctx, &model.LoginAttempt{
+		TenantID:  tenantID,
+		Email:     email,
+		IPAddress: ip,
+		Succeeded: succeeded,
+		CreatedAt: now,
+	})
+}
+
+// loginDelay is how much longer the caller must wait after the last failure
+func (i *AuthInteractor) loginDelay(failures *model.LoginFailures, now time.Time) time.Duration {
+	if failures.Count == 0 || failures.LastFailedAt == nil || i.lockout.DelayBase <= 0 {
+		return 0
+	}
+
+	// Cap the shift so the delay cannot overflow before it is capped
+	delay := i.lockout.DelayBase << min(failures.Count-1, 20)
+	if delay > i.lockout.LockoutDuration {
+		delay = i.lockout.LockoutDuration
+	}
+	return failures.LastFailedAt.Add(delay).Sub(now)
+}
+
+// notifyLocked tells the user about the lockout. A failure only logs, the lock stays
+func (i *AuthInteractor) notifyLocked(ctx context.Context, user *model.User, lockedUntil time.Time) {
+	msg := &mailer.Message{
+		To:      user.Email,
+		Subject: "Your Good Todo account has been locked",
+		Body: fmt.Sprintf(
+			"Your account was locked after %d failed login attempts.\n"+
+				"You can log in again after %s, or ask a tenant admin to unlock it.\n"+
+				"If these attempts were not yours, change your password after logging in.\n",
+			i.lockout.MaxFailures,
+			lockedUntil.UTC().Format(time.RFC1123),
+		),
+	}
+	if err := i.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "failed to send lockout notification", "user_id", user.ID, "error", err)
+	}
+}
+
 func (i *AuthInteractor) VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (*output.VerifyEmailOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Find user by verification token
 	user, err := i.authRepo.FindUserByVerificationToken(ctx, in.Token)
 	if err != nil {
@@ -173,11 +477,38 @@ func (i *AuthInteractor) VerifyEmail(ctx context.Context, in *input.VerifyEmailI
 }
 
 func (i *AuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (*output.AuthOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Validate refresh token
 	claims, err := i.jwtService.ValidateRefreshToken(in.RefreshToken)
 	if err != nil {
 		return nil, cerror.NewUnauthorized("invalid refresh token", err)
 	}
+	// Tokens issued before sessions cannot be revoked, so they must log in again
+	if claims.SessionID == "" {
+		return nil, cerror.NewUnauthorized("invalid refresh token", nil)
+	}
+
+	now := i.clock.Now()
+	session, err := i.sessionRepo.FindByID(ctx, claims.TenantID, claims.SessionID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get session", err)
+	}
+	if session == nil || session.UserID != claims.UserID || !session.Active(now) {
+		return nil, cerror.NewUnauthorized("session has ended", nil)
+	}
+
+	oldHash := hashRefreshToken(in.RefreshToken)
+	if session.RefreshTokenHash != oldHash {
+		// An older refresh token of the session was used again, so it may have been
+		// stolen. End the session, which logs out both the thief and the user
+		if _, err := i.sessionRepo.Revoke(ctx, session.TenantID, session.UserID, session.ID, now); err != nil {
+			slog.ErrorContext(ctx, "failed to revoke session after refresh token reuse", "session_id", session.ID, "error", err)
+		}
+		return nil, cerror.NewUnauthorized("invalid refresh token", nil)
+	}
 
 	// Find user to ensure they still exist
 	user, err := i.authRepo.FindUserByID(ctx, claims.TenantID, claims.UserID)
@@ -186,18 +517,80 @@ func (i *AuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshToke
 	}
 
 	// Generate new token pair
-	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role)
+	tokenPair, err := i.jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role, session.ID)
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
 	}
 
-	return &output.AuthOutput{
-		AccessToken:  tokenPair.AccessToken,
-		RefreshToken: tokenPair.RefreshToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    tokenPair.ExpiresIn,
-		User:         output.NewUserOutput(user),
-	}, nil
+	session.RefreshTokenHash = hashRefreshToken(tokenPair.RefreshToken)
+	session.LastUsedAt = now
+	session.ExpiresAt = tokenPair.RefreshExpiresAt
+	session.IPAddress = in.IPAddress
+	session.UserAgent = truncateUserAgent(in.UserAgent)
+	rotated, err := i.sessionRepo.Rotate(ctx, session, oldHash)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update session", err)
+	}
+	if !rotated {
+		// Another refresh with the same token, or a revocation, got there first
+		return nil, cerror.NewUnauthorized("invalid refresh token", nil)
+	}
+
+	return newAuthOutput(tokenPair, user), nil
+}
+
+func (i *AuthInteractor) UnlockUser(ctx context.Context, in *input.UnlockUserInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can unlock users", nil)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+
+	// Forget the failures first, so the next failed login does not lock the user again
+	if err := i.loginAttemptRepo.DeleteFailuresByEmail(ctx, user.TenantID, user.Email); err != nil {
+		return cerror.NewInternalServerError("failed to reset login attempts", err)
+	}
+
+	if user.LockedUntil != nil {
+		user.LockedUntil = nil
+		if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+			return cerror.NewInternalServerError("failed to update user", err)
+		}
+	}
+
+	return nil
+}
+
+func (i *AuthInteractor) PurgeLoginAttempts(ctx context.Context) (int, error) {
+	tenants, err := i.authRepo.FindAllTenants(ctx)
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to get tenants", err)
+	}
+
+	before := i.clock.Now().Add(-i.lockout.AttemptRetention)
+	purged := 0
+	for _, t := range tenants {
+		n, err := i.loginAttemptRepo.DeleteBefore(ctx, t.ID, before)
+		if err != nil {
+			// Keep going so one broken tenant does not block the others
+			slog.ErrorContext(ctx, "failed to purge login attempts", "tenant_id", t.ID, "error", err)
+			continue
+		}
+		purged += n
+	}
+
+	return purged, nil
+}
+
+// withRetryAfter tells the client how long to wait, as the Retry-After header
+func withRetryAfter(appErr *cerror.AppError, wait time.Duration) *cerror.AppError {
+	appErr.Details = map[string]interface{}{
+		"retry_after_seconds": ceilSeconds(wait),
+	}
+	return appErr
 }
 
 func generateVerificationToken() (string, error) {
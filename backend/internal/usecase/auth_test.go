@@ -9,7 +9,11 @@ import (
 	"good-todo-go/internal/domain/model"
 	mock_repository "good-todo-go/internal/domain/repository/mock"
 	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
+	mock_mailer "good-todo-go/internal/pkg/mailer/mock"
 	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/totp"
 	"good-todo-go/internal/usecase/input"
 
 	"github.com/stretchr/testify/assert"
@@ -147,10 +151,13 @@ func TestAuthInteractor_Register(t *testing.T) {
 			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
 			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
 			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)).AnyTimes()
 
 			tt.setupMocks(authRepo, uuidGen)
+			sessionRepo := expectSessionStart(t, ctrl, uuidGen, tt.wantErr)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, mock_repository.NewMockILoginAttemptRepository(ctrl), sessionRepo, jwtService, uuidGen, clock, mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
 
 			result, err := interactor.Register(context.Background(), tt.input)
 
@@ -171,17 +178,67 @@ func TestAuthInteractor_Register(t *testing.T) {
 	}
 }
 
+// expectSessionStart expects one session to be started, unless the call fails before it
+func expectSessionStart(t *testing.T, ctrl *gomock.Controller, uuidGen *mock_pkg.MockIUUIDGenerator, fails bool) *mock_repository.MockISessionRepository {
+	t.Helper()
+	sessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	if fails {
+		return sessionRepo
+	}
+	uuidGen.EXPECT().Generate().Return("session-id")
+	sessionRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, s *model.Session) error {
+			assert.Equal(t, "session-id", s.ID)
+			assert.Len(t, s.RefreshTokenHash, 64)
+			assert.True(t, s.ExpiresAt.After(s.CreatedAt))
+			return nil
+		})
+	return sessionRepo
+}
+
+// testLockoutPolicy locks after 3 failures, with delays of 1s, 2s, 4s...
+var testLockoutPolicy = LoginLockoutPolicy{
+	MaxFailures:      3,
+	Window:           15 * time.Minute,
+	LockoutDuration:  15 * time.Minute,
+	MaxIPFailures:    10,
+	DelayBase:        time.Second,
+	AttemptRetention: 24 * time.Hour,
+}
+
 func TestAuthInteractor_Login(t *testing.T) {
 	t.Parallel()
 
 	// Create a valid password hash
 	passwordHash, _ := pkg.HashPassword("password123")
+	// and one from before the cost was raised
+	lowCost, _ := pkg.NewPasswordHasher(pkg.PasswordAlgorithmBcrypt, 4, pkg.DefaultArgon2Params)
+	outdatedHash, _ := lowCost.Hash("password123")
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-testLockoutPolicy.Window)
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "test-tenant"}
+	noFailures := &model.LoginFailures{}
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	type mocks struct {
+		authRepo         *mock_repository.MockIAuthRepository
+		loginAttemptRepo *mock_repository.MockILoginAttemptRepository
+		mailer           *mock_mailer.MockIMailer
+	}
 
 	tests := []struct {
 		name        string
 		input       *input.LoginInput
-		setupMocks  func(authRepo *mock_repository.MockIAuthRepository)
+		setupMocks  func(m mocks)
 		wantErr     bool
+		wantCode    cerror.ErrorCode
+		wantRetry   int
+		wantMFA     bool
 		errContains string
 	}{
 		{
@@ -190,16 +247,20 @@ func TestAuthInteractor_Login(t *testing.T) {
 				Email:      "test@example.com",
 				Password:   "password123",
 				TenantSlug: "test-tenant",
+				IPAddress:  "192.0.2.1",
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
-				authRepo.EXPECT().
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().
 					FindTenantBySlug(gomock.Any(), "test-tenant").
-					Return(&model.Tenant{
-						ID:   "tenant-id",
-						Slug: "test-tenant",
-					}, nil)
+					Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().
+					CountFailuresByIP(gomock.Any(), "tenant-id", "192.0.2.1", since).
+					Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(noFailures, nil)
 
-				authRepo.EXPECT().
+				m.authRepo.EXPECT().
 					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
 					Return(&model.User{
 						ID:           "user-id",
@@ -209,9 +270,125 @@ func TestAuthInteractor_Login(t *testing.T) {
 						Name:         "Test User",
 						Role:         "member",
 					}, nil)
+
+				m.loginAttemptRepo.EXPECT().
+					Create(gomock.Any(), &model.LoginAttempt{
+						TenantID:  "tenant-id",
+						Email:     "test@example.com",
+						IPAddress: "192.0.2.1",
+						Succeeded: true,
+						CreatedAt: now,
+					}).
+					Return(nil)
 			},
 			wantErr: false,
 		},
+		{
+			name: "success - outdated hash is upgraded",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+				IPAddress:  "192.0.2.1",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "192.0.2.1", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).Return(noFailures, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", PasswordHash: outdatedHash, Role: "member"}, nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.NotEqual(t, outdatedHash, u.PasswordHash)
+						assert.True(t, pkg.CheckPasswordHash("password123", u.PasswordHash))
+						return u, nil
+					})
+				m.loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "success - expired lock is cleared",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 3, LastFailedAt: at(-10 * time.Minute)}, nil)
+
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
+						LockedUntil:  at(-time.Second),
+					}, nil)
+
+				m.loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Nil(t, u.LockedUntil)
+						return u, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - two-factor user gets a challenge and no success is recorded",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).Return(noFailures, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
+						MFAEnabled:   true,
+					}, nil)
+			},
+			wantMFA: true,
+		},
+		{
+			name: "success - tenant requiring two-factor sends unenrolled user to a challenge",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().
+					FindTenantBySlug(gomock.Any(), "test-tenant").
+					Return(&model.Tenant{ID: "tenant-id", Slug: "test-tenant", RequireMFA: true}, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).Return(noFailures, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
+					}, nil)
+			},
+			wantMFA: true,
+		},
 		{
 			name: "fail - tenant not found",
 			input: &input.LoginInput{
@@ -219,52 +396,96 @@ func TestAuthInteractor_Login(t *testing.T) {
 				Password:   "password123",
 				TenantSlug: "non-existent",
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
-				authRepo.EXPECT().
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().
 					FindTenantBySlug(gomock.Any(), "non-existent").
 					Return(nil, errors.New("not found"))
 			},
 			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
 			errContains: "invalid credentials",
 		},
 		{
-			name: "fail - user not found",
+			name: "fail - user not found is recorded",
 			input: &input.LoginInput{
 				Email:      "nonexistent@example.com",
 				Password:   "password123",
 				TenantSlug: "test-tenant",
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
-				authRepo.EXPECT().
-					FindTenantBySlug(gomock.Any(), "test-tenant").
-					Return(&model.Tenant{
-						ID:   "tenant-id",
-						Slug: "test-tenant",
-					}, nil)
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "nonexistent@example.com", since).
+					Return(&model.LoginFailures{Count: 5, LastFailedAt: at(-time.Hour)}, nil)
 
-				authRepo.EXPECT().
+				m.authRepo.EXPECT().
 					FindUserByEmail(gomock.Any(), "tenant-id", "nonexistent@example.com").
 					Return(nil, errors.New("not found"))
+
+				// Recorded, but there is no account to lock
+				m.loginAttemptRepo.EXPECT().
+					Create(gomock.Any(), &model.LoginAttempt{
+						TenantID:  "tenant-id",
+						Email:     "nonexistent@example.com",
+						Succeeded: false,
+						CreatedAt: now,
+					}).
+					Return(nil)
 			},
 			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
 			errContains: "invalid credentials",
 		},
 		{
-			name: "fail - wrong password",
+			name: "fail - wrong password is recorded",
 			input: &input.LoginInput{
 				Email:      "test@example.com",
 				Password:   "wrongpassword",
 				TenantSlug: "test-tenant",
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
-				authRepo.EXPECT().
-					FindTenantBySlug(gomock.Any(), "test-tenant").
-					Return(&model.Tenant{
-						ID:   "tenant-id",
-						Slug: "test-tenant",
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 1, LastFailedAt: at(-time.Minute)}, nil)
+
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
 					}, nil)
 
-				authRepo.EXPECT().
+				m.loginAttemptRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *model.LoginAttempt) error {
+						assert.False(t, a.Succeeded)
+						return nil
+					})
+			},
+			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
+			errContains: "invalid credentials",
+		},
+		{
+			name: "fail - failure reaching the limit locks the account and notifies the user",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "wrongpassword",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 2, LastFailedAt: at(-time.Minute)}, nil)
+
+				m.authRepo.EXPECT().
 					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
 					Return(&model.User{
 						ID:           "user-id",
@@ -272,10 +493,133 @@ func TestAuthInteractor_Login(t *testing.T) {
 						Email:        "test@example.com",
 						PasswordHash: passwordHash,
 					}, nil)
+
+				m.loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, at(15*time.Minute), u.LockedUntil)
+						return u, nil
+					})
+				m.mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg *mailer.Message) error {
+						assert.Equal(t, "test@example.com", msg.To)
+						return nil
+					})
 			},
 			wantErr:     true,
+			wantCode:    cerror.ErrCodeUnauthorized,
 			errContains: "invalid credentials",
 		},
+		{
+			name: "fail - notification failure still locks the account",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "wrongpassword",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 2, LastFailedAt: at(-time.Minute)}, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", PasswordHash: passwordHash}, nil)
+				m.loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				m.authRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(&model.User{}, nil)
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errors.New("smtp down"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+		{
+			name: "fail - locked account refuses the right password",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 3, LastFailedAt: at(-5 * time.Minute)}, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{
+						ID:           "user-id",
+						TenantID:     "tenant-id",
+						Email:        "test@example.com",
+						PasswordHash: passwordHash,
+						LockedUntil:  at(10 * time.Minute),
+					}, nil)
+			},
+			wantErr:   true,
+			wantCode:  cerror.ErrCodeAccountLocked,
+			wantRetry: 600,
+		},
+		{
+			name: "fail - attempt within the progressive delay is throttled",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				// Third attempt: the wait after two failures is 2s
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(&model.LoginFailures{Count: 2, LastFailedAt: at(-500 * time.Millisecond)}, nil)
+			},
+			wantErr:   true,
+			wantCode:  cerror.ErrCodeTooManyRequests,
+			wantRetry: 2,
+		},
+		{
+			name: "fail - too many failures from the IP",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "password123",
+				TenantSlug: "test-tenant",
+				IPAddress:  "192.0.2.1",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().
+					CountFailuresByIP(gomock.Any(), "tenant-id", "192.0.2.1", since).
+					Return(10, nil)
+			},
+			wantErr:   true,
+			wantCode:  cerror.ErrCodeTooManyRequests,
+			wantRetry: 900,
+		},
+		{
+			name: "fail - failure cannot be recorded",
+			input: &input.LoginInput{
+				Email:      "test@example.com",
+				Password:   "wrongpassword",
+				TenantSlug: "test-tenant",
+			},
+			setupMocks: func(m mocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "test-tenant").Return(tenant, nil)
+				m.loginAttemptRepo.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "", since).Return(0, nil)
+				m.loginAttemptRepo.EXPECT().
+					FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).
+					Return(noFailures, nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", PasswordHash: passwordHash}, nil)
+				m.loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeInternalServerError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -283,18 +627,31 @@ func TestAuthInteractor_Login(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			m := mocks{
+				authRepo:         mock_repository.NewMockIAuthRepository(ctrl),
+				loginAttemptRepo: mock_repository.NewMockILoginAttemptRepository(ctrl),
+				mailer:           mock_mailer.NewMockIMailer(ctrl),
+			}
 			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
 			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
 
-			tt.setupMocks(authRepo)
+			tt.setupMocks(m)
+			sessionRepo := expectSessionStart(t, ctrl, uuidGen, tt.wantErr || tt.wantMFA)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(m.authRepo, m.loginAttemptRepo, sessionRepo, jwtService, uuidGen, clock, m.mailer, newTestPasswordPolicy(t), testLockoutPolicy)
 
 			result, err := interactor.Login(context.Background(), tt.input)
 
 			if tt.wantErr {
 				require.Error(t, err)
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				if tt.wantRetry != 0 {
+					assert.Equal(t, tt.wantRetry, appErr.Details["retry_after_seconds"])
+				}
 				if tt.errContains != "" {
 					assert.Contains(t, err.Error(), tt.errContains)
 				}
@@ -302,6 +659,12 @@ func TestAuthInteractor_Login(t *testing.T) {
 			}
 
 			require.NoError(t, err)
+			if tt.wantMFA {
+				assert.True(t, result.MFARequired)
+				assert.NotEmpty(t, result.MFAToken)
+				assert.Empty(t, result.AccessToken)
+				return
+			}
 			assert.NotEmpty(t, result.AccessToken)
 			assert.NotEmpty(t, result.RefreshToken)
 			assert.Equal(t, "Bearer", result.TokenType)
@@ -309,6 +672,160 @@ func TestAuthInteractor_Login(t *testing.T) {
 	}
 }
 
+func TestAuthInteractor_VerifyMFA(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	since := now.Add(-testLockoutPolicy.Window)
+	secret := testMFASecret
+	jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+	challenge, err := jwtService.GenerateMFAChallengeToken("user-id", "tenant-id", "test@example.com", "member")
+	require.NoError(t, err)
+
+	enabledUser := func() *model.User {
+		return &model.User{
+			ID:                    "user-id",
+			TenantID:              "tenant-id",
+			Email:                 "test@example.com",
+			MFAEnabled:            true,
+			MFASecret:             &secret,
+			MFARecoveryCodeHashes: []string{hashRecoveryCode("abcde-fghij")},
+		}
+	}
+	expectThrottle := func(m *mock_repository.MockILoginAttemptRepository) {
+		m.EXPECT().CountFailuresByIP(gomock.Any(), "tenant-id", "192.0.2.1", since).Return(0, nil)
+		m.EXPECT().FindFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com", since).Return(&model.LoginFailures{}, nil)
+	}
+
+	tests := []struct {
+		name              string
+		token             string
+		code              func(t *testing.T) string
+		setupMocks        func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository)
+		wantErr           bool
+		wantCode          cerror.ErrorCode
+		wantRecoveryCodes bool
+	}{
+		{
+			name:  "success - valid TOTP code",
+			token: challenge,
+			code:  func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+				expectThrottle(loginAttemptRepo)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, totp.Step(now), *u.MFALastUsedStep)
+						return u, nil
+					})
+				loginAttemptRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *model.LoginAttempt) error {
+						assert.True(t, a.Succeeded)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "success - recovery code is used up",
+			token: challenge,
+			code:  func(*testing.T) string { return "abcde-fghij" },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+				expectThrottle(loginAttemptRepo)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Empty(t, u.MFARecoveryCodeHashes)
+						return u, nil
+					})
+				loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "success - first code completes enrollment required by the tenant",
+			token: challenge,
+			code:  func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", MFASecret: &secret}, nil)
+				expectThrottle(loginAttemptRepo)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, u.MFAEnabled)
+						return u, nil
+					})
+				loginAttemptRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantRecoveryCodes: true,
+		},
+		{
+			name:  "fail - wrong code is recorded as a failure",
+			token: challenge,
+			code:  func(*testing.T) string { return "000000" },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+				expectThrottle(loginAttemptRepo)
+				loginAttemptRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, a *model.LoginAttempt) error {
+						assert.False(t, a.Succeeded)
+						return nil
+					})
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:  "fail - invalid challenge token",
+			token: "invalid-token",
+			code:  func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(*mock_repository.MockIAuthRepository, *mock_repository.MockILoginAttemptRepository) {
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			loginAttemptRepo := mock_repository.NewMockILoginAttemptRepository(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMocks(authRepo, loginAttemptRepo)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			sessionRepo := expectSessionStart(t, ctrl, uuidGen, tt.wantErr)
+
+			interactor := NewAuthInteractor(authRepo, loginAttemptRepo, sessionRepo, jwtService, uuidGen, clock, mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
+			result, err := interactor.VerifyMFA(context.Background(), &input.VerifyMFAInput{
+				MFAToken:  tt.token,
+				Code:      tt.code(t),
+				IPAddress: "192.0.2.1",
+			})
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.AccessToken)
+			assert.False(t, result.MFARequired)
+			if tt.wantRecoveryCodes {
+				assert.Len(t, result.RecoveryCodes, recoveryCodeCount)
+			} else {
+				assert.Empty(t, result.RecoveryCodes)
+			}
+		})
+	}
+}
+
 func TestAuthInteractor_VerifyEmail(t *testing.T) {
 	t.Parallel()
 
@@ -398,7 +915,7 @@ func TestAuthInteractor_VerifyEmail(t *testing.T) {
 
 			tt.setupMocks(authRepo)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, mock_repository.NewMockILoginAttemptRepository(ctrl), mock_repository.NewMockISessionRepository(ctrl), jwtService, uuidGen, mock_pkg.NewMockIClock(ctrl), mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
 
 			result, err := interactor.VerifyEmail(context.Background(), tt.input)
 
@@ -419,32 +936,49 @@ func TestAuthInteractor_VerifyEmail(t *testing.T) {
 func TestAuthInteractor_RefreshToken(t *testing.T) {
 	t.Parallel()
 
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
 	jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
 
 	// Generate a valid refresh token
-	tokenPair, _ := jwtService.GenerateTokenPair("user-id", "tenant-id", "test@example.com", "member")
+	tokenPair, _ := jwtService.GenerateTokenPair("user-id", "tenant-id", "test@example.com", "member", "session-id")
+	legacyPair, _ := jwtService.GenerateTokenPair("user-id", "tenant-id", "test@example.com", "member", "")
+	session := func() *model.Session {
+		return &model.Session{
+			ID:               "session-id",
+			TenantID:         "tenant-id",
+			UserID:           "user-id",
+			RefreshTokenHash: hashRefreshToken(tokenPair.RefreshToken),
+			ExpiresAt:        now.Add(time.Hour),
+		}
+	}
+	user := &model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", Role: "member"}
 
 	tests := []struct {
 		name        string
 		input       *input.RefreshTokenInput
-		setupMocks  func(authRepo *mock_repository.MockIAuthRepository)
+		setupMocks  func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository)
 		wantErr     bool
 		errContains string
 	}{
 		{
-			name: "success - valid refresh token",
+			name: "success - the session's token is rotated",
 			input: &input.RefreshTokenInput{
 				RefreshToken: tokenPair.RefreshToken,
+				IPAddress:    "192.0.2.1",
+				UserAgent:    "test-agent",
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
-				authRepo.EXPECT().
-					FindUserByID(gomock.Any(), "tenant-id", "user-id").
-					Return(&model.User{
-						ID:       "user-id",
-						TenantID: "tenant-id",
-						Email:    "test@example.com",
-						Role:     "member",
-					}, nil)
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(session(), nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(user, nil)
+				sessionRepo.EXPECT().
+					Rotate(gomock.Any(), gomock.Any(), hashRefreshToken(tokenPair.RefreshToken)).
+					DoAndReturn(func(_ context.Context, s *model.Session, _ string) (bool, error) {
+						assert.NotEqual(t, hashRefreshToken(tokenPair.RefreshToken), s.RefreshTokenHash)
+						assert.Equal(t, now, s.LastUsedAt)
+						assert.Equal(t, "192.0.2.1", s.IPAddress)
+						assert.Equal(t, "test-agent", s.UserAgent)
+						return true, nil
+					})
 			},
 			wantErr: false,
 		},
@@ -453,7 +987,67 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 			input: &input.RefreshTokenInput{
 				RefreshToken: "invalid-token",
 			},
-			setupMocks:  func(authRepo *mock_repository.MockIAuthRepository) {},
+			setupMocks:  func(*mock_repository.MockIAuthRepository, *mock_repository.MockISessionRepository) {},
+			wantErr:     true,
+			errContains: "invalid refresh token",
+		},
+		{
+			name: "fail - token issued before sessions",
+			input: &input.RefreshTokenInput{
+				RefreshToken: legacyPair.RefreshToken,
+			},
+			setupMocks:  func(*mock_repository.MockIAuthRepository, *mock_repository.MockISessionRepository) {},
+			wantErr:     true,
+			errContains: "invalid refresh token",
+		},
+		{
+			name: "fail - session revoked",
+			input: &input.RefreshTokenInput{
+				RefreshToken: tokenPair.RefreshToken,
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				revoked := session()
+				revoked.RevokedAt = &now
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(revoked, nil)
+			},
+			wantErr:     true,
+			errContains: "session has ended",
+		},
+		{
+			name: "fail - session deleted",
+			input: &input.RefreshTokenInput{
+				RefreshToken: tokenPair.RefreshToken,
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(nil, nil)
+			},
+			wantErr:     true,
+			errContains: "session has ended",
+		},
+		{
+			name: "fail - reused token ends the session",
+			input: &input.RefreshTokenInput{
+				RefreshToken: tokenPair.RefreshToken,
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				rotated := session()
+				rotated.RefreshTokenHash = hashRefreshToken("a-newer-token")
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(rotated, nil)
+				sessionRepo.EXPECT().Revoke(gomock.Any(), "tenant-id", "user-id", "session-id", now).Return(true, nil)
+			},
+			wantErr:     true,
+			errContains: "invalid refresh token",
+		},
+		{
+			name: "fail - concurrent refresh won the race",
+			input: &input.RefreshTokenInput{
+				RefreshToken: tokenPair.RefreshToken,
+			},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(session(), nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(user, nil)
+				sessionRepo.EXPECT().Rotate(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
 			wantErr:     true,
 			errContains: "invalid refresh token",
 		},
@@ -462,7 +1056,8 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 			input: &input.RefreshTokenInput{
 				RefreshToken: tokenPair.RefreshToken,
 			},
-			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "session-id").Return(session(), nil)
 				authRepo.EXPECT().
 					FindUserByID(gomock.Any(), "tenant-id", "user-id").
 					Return(nil, errors.New("not found"))
@@ -478,11 +1073,14 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 			defer ctrl.Finish()
 
 			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			sessionRepo := mock_repository.NewMockISessionRepository(ctrl)
 			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
 
-			tt.setupMocks(authRepo)
+			tt.setupMocks(authRepo, sessionRepo)
 
-			interactor := NewAuthInteractor(authRepo, jwtService, uuidGen)
+			interactor := NewAuthInteractor(authRepo, mock_repository.NewMockILoginAttemptRepository(ctrl), sessionRepo, jwtService, uuidGen, clock, mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
 
 			result, err := interactor.RefreshToken(context.Background(), tt.input)
 
@@ -497,6 +1095,123 @@ func TestAuthInteractor_RefreshToken(t *testing.T) {
 			require.NoError(t, err)
 			assert.NotEmpty(t, result.AccessToken)
 			assert.NotEmpty(t, result.RefreshToken)
+			claims, err := jwtService.ValidateRefreshToken(result.RefreshToken)
+			require.NoError(t, err)
+			assert.Equal(t, "session-id", claims.SessionID)
 		})
 	}
 }
+
+func TestAuthInteractor_UnlockUser(t *testing.T) {
+	t.Parallel()
+
+	lockedUntil := time.Date(2026, 3, 31, 12, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      *input.UnlockUserInput
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name:  "success - lock is cleared and failures are forgotten",
+			input: &input.UnlockUserInput{Role: "admin", TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com", LockedUntil: &lockedUntil}, nil)
+				loginAttemptRepo.EXPECT().
+					DeleteFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(nil)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), &model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com"}).
+					Return(&model.User{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "success - user that is not locked only has failures forgotten",
+			input: &input.UnlockUserInput{Role: "admin", TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, loginAttemptRepo *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com"}, nil)
+				loginAttemptRepo.EXPECT().
+					DeleteFailuresByEmail(gomock.Any(), "tenant-id", "test@example.com").
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "fail - not an admin",
+			input:      &input.UnlockUserInput{Role: "member", TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(*mock_repository.MockIAuthRepository, *mock_repository.MockILoginAttemptRepository) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:  "fail - user not found",
+			input: &input.UnlockUserInput{Role: "admin", TenantID: "tenant-id", UserID: "missing"},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository, _ *mock_repository.MockILoginAttemptRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "missing").
+					Return(nil, errors.New("not found"))
+			},
+			wantCode: cerror.ErrCodeNotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			loginAttemptRepo := mock_repository.NewMockILoginAttemptRepository(ctrl)
+			jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+			tt.setupMocks(authRepo, loginAttemptRepo)
+
+			interactor := NewAuthInteractor(authRepo, loginAttemptRepo, mock_repository.NewMockISessionRepository(ctrl), jwtService, mock_pkg.NewMockIUUIDGenerator(ctrl), mock_pkg.NewMockIClock(ctrl), mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
+
+			err := interactor.UnlockUser(context.Background(), tt.input)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAuthInteractor_PurgeLoginAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-testLockoutPolicy.AttemptRetention)
+
+	authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+	loginAttemptRepo := mock_repository.NewMockILoginAttemptRepository(ctrl)
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(now)
+
+	authRepo.EXPECT().
+		FindAllTenants(gomock.Any()).
+		Return([]*model.Tenant{{ID: "tenant-1"}, {ID: "tenant-2"}, {ID: "tenant-3"}}, nil)
+	loginAttemptRepo.EXPECT().DeleteBefore(gomock.Any(), "tenant-1", before).Return(4, nil)
+	// A failing tenant does not stop the others
+	loginAttemptRepo.EXPECT().DeleteBefore(gomock.Any(), "tenant-2", before).Return(0, errors.New("db error"))
+	loginAttemptRepo.EXPECT().DeleteBefore(gomock.Any(), "tenant-3", before).Return(1, nil)
+
+	interactor := NewAuthInteractor(authRepo, loginAttemptRepo, mock_repository.NewMockISessionRepository(ctrl), pkg.NewJWTService("test-secret", 3600, 86400), mock_pkg.NewMockIUUIDGenerator(ctrl), clock, mock_mailer.NewMockIMailer(ctrl), newTestPasswordPolicy(t), testLockoutPolicy)
+
+	purged, err := interactor.PurgeLoginAttempts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 5, purged)
+}
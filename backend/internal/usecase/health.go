@@ -0,0 +1,143 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg/mailer"
+	"good-todo-go/internal/usecase/output"
+)
+
+// readinessCheckTimeout bounds each check, so that a hung dependency fails the probe instead of timing it out
+const readinessCheckTimeout = 2 * time.Second
+
+// Readiness checks
+const (
+	HealthCheckDatabase   = "database"
+	HealthCheckMigrations = "migrations"
+	HealthCheckRLS        = "rls"
+	HealthCheckSMTP       = "smtp"
+)
+
+type IHealthInteractor interface {
+	// Ready runs the readiness checks concurrently
+	Ready(ctx context.Context) *output.ReadinessOutput
+	// Drain fails readiness from now on, so that load balancers stop sending requests before shutdown
+	Drain()
+}
+
+type HealthInteractor struct {
+	healthRepo repository.IHealthRepository
+	mailer     mailer.IMailer
+	// migrationVersion is the last migration this build ships
+	migrationVersion string
+	draining         atomic.Bool
+}
+
+func NewHealthInteractor(
+	healthRepo repository.IHealthRepository,
+	mail mailer.IMailer,
+	migrationVersion string,
+) IHealthInteractor {
+	return &HealthInteractor{
+		healthRepo:       healthRepo,
+		mailer:           mail,
+		migrationVersion: migrationVersion,
+	}
+}
+
+// readinessCheck returns the reason for a failure, and the error to log
+type readinessCheck func(ctx context.Context) (reason string, err error)
+
+func (i *HealthInteractor) Ready(ctx context.Context) *output.ReadinessOutput {
+	if i.draining.Load() {
+		return &output.ReadinessOutput{Draining: true}
+	}
+
+	checks := []struct {
+		name  string
+		check readinessCheck
+	}{
+		{HealthCheckDatabase, i.checkDatabase},
+		{HealthCheckMigrations, i.checkMigrations},
+		{HealthCheckRLS, i.checkRLS},
+		{HealthCheckSMTP, i.checkSMTP},
+	}
+
+	out := &output.ReadinessOutput{Ready: true, Checks: make([]*output.HealthCheckOutput, len(checks))}
+	var wg sync.WaitGroup
+	for idx, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			reason, err := c.check(checkCtx)
+			result := &output.HealthCheckOutput{Name: c.name, OK: reason == "", Latency: time.Since(start), Error: reason}
+			if err != nil {
+				slog.WarnContext(ctx, "readiness check failed", "check", c.name, "error", err)
+			}
+			out.Checks[idx] = result
+		}()
+	}
+	wg.Wait()
+
+	for _, c := range out.Checks {
+		out.Ready = out.Ready && c.OK
+	}
+	return out
+}
+
+func (i *HealthInteractor) Drain() {
+	i.draining.Store(true)
+}
+
+func (i *HealthInteractor) checkDatabase(ctx context.Context) (string, error) {
+	if err := i.healthRepo.Ping(ctx); err != nil {
+		return "database unreachable", err
+	}
+	return "", nil
+}
+
+// checkMigrations accepts a newer revision: during a rolling deploy the database is
+// migrated while instances of the previous build still serve traffic
+func (i *HealthInteractor) checkMigrations(ctx context.Context) (string, error) {
+	version, err := i.healthRepo.MigrationVersion(ctx)
+	if err != nil {
+		return "cannot read the migration revision", err
+	}
+	// Versions are timestamps of the same length, ordered as strings
+	if version < i.migrationVersion {
+		return fmt.Sprintf("database is at revision %s, expected %s", version, i.migrationVersion), nil
+	}
+	return "", nil
+}
+
+func (i *HealthInteractor) checkRLS(ctx context.Context) (string, error) {
+	tables, err := i.healthRepo.TablesWithoutRLS(ctx)
+	if err != nil {
+		return "cannot inspect row level security", err
+	}
+	if len(tables) > 0 {
+		return "row level security is not enforced on " + strings.Join(tables, ", "), nil
+	}
+	return "", nil
+}
+
+// checkSMTP only validates the configuration: mail is optional, so an unreachable
+// relay must not take every instance out of the load balancer
+func (i *HealthInteractor) checkSMTP(ctx context.Context) (string, error) {
+	if err := i.mailer.Check(ctx); err != nil {
+		return "SMTP misconfigured", err
+	}
+	return "", nil
+}
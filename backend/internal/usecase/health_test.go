@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_mailer "good-todo-go/internal/pkg/mailer/mock"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHealthInteractor_Ready(t *testing.T) {
+	t.Parallel()
+
+	const expected = "20261019230000"
+
+	tests := []struct {
+		name      string
+		setup     func(healthRepo *mock_repository.MockIHealthRepository, mail *mock_mailer.MockIMailer)
+		wantReady bool
+		// wantErrors maps the failing checks to their reason
+		wantErrors map[string]string
+	}{
+		{
+			name: "success - every check passes",
+			setup: func(healthRepo *mock_repository.MockIHealthRepository, mail *mock_mailer.MockIMailer) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().MigrationVersion(gomock.Any()).Return(expected, nil)
+				healthRepo.EXPECT().TablesWithoutRLS(gomock.Any()).Return(nil, nil)
+				mail.EXPECT().Check(gomock.Any()).Return(nil)
+			},
+			wantReady: true,
+		},
+		{
+			name: "success - database migrated ahead by a rolling deploy",
+			setup: func(healthRepo *mock_repository.MockIHealthRepository, mail *mock_mailer.MockIMailer) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().MigrationVersion(gomock.Any()).Return("20261020000000", nil)
+				healthRepo.EXPECT().TablesWithoutRLS(gomock.Any()).Return(nil, nil)
+				mail.EXPECT().Check(gomock.Any()).Return(nil)
+			},
+			wantReady: true,
+		},
+		{
+			name: "error - migrations behind and RLS missing",
+			setup: func(healthRepo *mock_repository.MockIHealthRepository, mail *mock_mailer.MockIMailer) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(nil)
+				healthRepo.EXPECT().MigrationVersion(gomock.Any()).Return("20261019220000", nil)
+				healthRepo.EXPECT().TablesWithoutRLS(gomock.Any()).Return([]string{"todos", "sessions"}, nil)
+				mail.EXPECT().Check(gomock.Any()).Return(nil)
+			},
+			wantErrors: map[string]string{
+				HealthCheckMigrations: "database is at revision 20261019220000, expected 20261019230000",
+				HealthCheckRLS:        "row level security is not enforced on todos, sessions",
+			},
+		},
+		{
+			name: "error - causes are not exposed",
+			setup: func(healthRepo *mock_repository.MockIHealthRepository, mail *mock_mailer.MockIMailer) {
+				healthRepo.EXPECT().Ping(gomock.Any()).Return(errors.New("dial tcp 10.0.0.5:5432: connection refused"))
+				healthRepo.EXPECT().MigrationVersion(gomock.Any()).Return("", errors.New("dial tcp 10.0.0.5:5432: connection refused"))
+				healthRepo.EXPECT().TablesWithoutRLS(gomock.Any()).Return(nil, errors.New("dial tcp 10.0.0.5:5432: connection refused"))
+				mail.EXPECT().Check(gomock.Any()).Return(errors.New("mailer: SMTP host and port are required"))
+			},
+			wantErrors: map[string]string{
+				HealthCheckDatabase:   "database unreachable",
+				HealthCheckMigrations: "cannot read the migration revision",
+				HealthCheckRLS:        "cannot inspect row level security",
+				HealthCheckSMTP:       "SMTP misconfigured",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			healthRepo := mock_repository.NewMockIHealthRepository(ctrl)
+			mail := mock_mailer.NewMockIMailer(ctrl)
+			tt.setup(healthRepo, mail)
+			interactor := NewHealthInteractor(healthRepo, mail, expected)
+
+			got := interactor.Ready(context.Background())
+
+			assert.Equal(t, tt.wantReady, got.Ready)
+			assert.False(t, got.Draining)
+			names := make([]string, len(got.Checks))
+			for i, c := range got.Checks {
+				names[i] = c.Name
+				assert.Equal(t, tt.wantErrors[c.Name], c.Error, c.Name)
+				assert.Equal(t, c.Error == "", c.OK, c.Name)
+			}
+			assert.Equal(t, []string{HealthCheckDatabase, HealthCheckMigrations, HealthCheckRLS, HealthCheckSMTP}, names)
+		})
+	}
+}
+
+func TestHealthInteractor_Drain(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// No check runs once draining
+	interactor := NewHealthInteractor(mock_repository.NewMockIHealthRepository(ctrl), mock_mailer.NewMockIMailer(ctrl), "20261019230000")
+	interactor.Drain()
+
+	got := interactor.Ready(context.Background())
+
+	assert.False(t, got.Ready)
+	assert.True(t, got.Draining)
+	assert.Empty(t, got.Checks)
+}
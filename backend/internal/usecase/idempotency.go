@@ -0,0 +1,132 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+type IIdempotencyInteractor interface {
+	// Begin reserves the key for this request and returns nil, or returns the
+	// stored response when the request was already processed
+	Begin(ctx context.Context, in *input.BeginIdempotentRequestInput) (*output.StoredResponseOutput, error)
+	// Complete stores the response so retries replay it
+	Complete(ctx context.Context, in *input.CompleteIdempotentRequestInput) error
+	// Abandon releases the key so the request can be retried, e.g. after a server error
+	Abandon(ctx context.Context, in *input.IdempotencyScope) error
+	// PurgeExpired deletes expired keys of every tenant
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type IdempotencyInteractor struct {
+	idempotencyKeyRepo repository.IIdempotencyKeyRepository
+	authRepo           repository.IAuthRepository
+	clock              pkg.IClock
+	ttl                time.Duration
+}
+
+func NewIdempotencyInteractor(
+	idempotencyKeyRepo repository.IIdempotencyKeyRepository,
+	authRepo repository.IAuthRepository,
+	clock pkg.IClock,
+	ttl time.Duration,
+) IIdempotencyInteractor {
+	return &IdempotencyInteractor{
+		idempotencyKeyRepo: idempotencyKeyRepo,
+		authRepo:           authRepo,
+		clock:              clock,
+		ttl:                ttl,
+	}
+}
+
+func (i *IdempotencyInteractor) Begin(ctx context.Context, in *input.BeginIdempotentRequestInput) (*output.StoredResponseOutput, error) {
+	if in.Key == "" || len(in.Key) > 255 {
+		return nil, cerror.NewBadRequest("Idempotency-Key must be 1 to 255 characters", nil)
+	}
+
+	now := i.clock.Now()
+	existing, err := i.idempotencyKeyRepo.Reserve(ctx, &model.IdempotencyKey{
+		TenantID:    in.TenantID,
+		UserID:      in.UserID,
+		Key:         in.Key,
+		RequestHash: in.RequestHash,
+		ExpiresAt:   now.Add(i.ttl),
+	}, now)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to reserve idempotency key", err)
+	}
+	if existing == nil {
+		return nil, nil
+	}
+
+	if existing.RequestHash != in.RequestHash {
+		return nil, cerror.NewUnprocessable("Idempotency-Key was already used for a different request", nil)
+	}
+	if existing.StatusCode == nil {
+		return nil, cerror.NewConflict("a request with this Idempotency-Key is still being processed", nil)
+	}
+
+	return &output.StoredResponseOutput{
+		StatusCode: *existing.StatusCode,
+		Headers:    existing.ResponseHeaders,
+		Body:       existing.ResponseBody,
+	}, nil
+}
+
+func (i *IdempotencyInteractor) Complete(ctx context.Context, in *input.CompleteIdempotentRequestInput) error {
+	status := in.StatusCode
+	err := i.idempotencyKeyRepo.SaveResponse(ctx, &model.IdempotencyKey{
+		TenantID:        in.TenantID,
+		UserID:          in.UserID,
+		Key:             in.Key,
+		StatusCode:      &status,
+		ResponseHeaders: in.Headers,
+		ResponseBody:    in.Body,
+	})
+	if err != nil {
+		return cerror.NewInternalServerError("failed to store idempotent response", err)
+	}
+	return nil
+}
+
+func (i *IdempotencyInteractor) Abandon(ctx context.Context, in *input.IdempotencyScope) error {
+	if err := i.idempotencyKeyRepo.Delete(ctx, in.TenantID, in.UserID, in.Key); err != nil {
+		return cerror.NewInternalServerError("failed to release idempotency key", err)
+	}
+	return nil
+}
+
+func (i *IdempotencyInteractor) PurgeExpired(ctx context.Context) (int, error) {
+	tenants, err := i.authRepo.FindAllTenants(ctx)
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to get tenants", err)
+	}
+
+	// "" holds the keys of unauthenticated requests
+	tenantIDs := []string{""}
+	for _, t := range tenants {
+		tenantIDs = append(tenantIDs, t.ID)
+	}
+
+	now := i.clock.Now()
+	purged := 0
+	for _, tenantID := range tenantIDs {
+		n, err := i.idempotencyKeyRepo.DeleteExpired(ctx, tenantID, now)
+		if err != nil {
+			// Keep going so one broken tenant does not block the others
+			slog.ErrorContext(ctx, "failed to purge idempotency keys", "tenant_id", tenantID, "error", err)
+			continue
+		}
+		purged += n
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIdempotencyInteractor_Begin(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	created := 201
+	scope := input.IdempotencyScope{TenantID: "tenant-1", UserID: "user-1", Key: "key-1"}
+
+	tests := []struct {
+		name     string
+		usecase  func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor
+		input    *input.BeginIdempotentRequestInput
+		want     *output.StoredResponseOutput
+		wantCode cerror.ErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "success - new key is reserved with the TTL",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor {
+				repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+				repo.EXPECT().
+					Reserve(ctx, &model.IdempotencyKey{
+						TenantID:    "tenant-1",
+						UserID:      "user-1",
+						Key:         "key-1",
+						RequestHash: "hash-1",
+						ExpiresAt:   now.Add(24 * time.Hour),
+					}, now).
+					Return(nil, nil)
+
+				return NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+			},
+			input:   &input.BeginIdempotentRequestInput{IdempotencyScope: scope, RequestHash: "hash-1"},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "success - completed request is replayed",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor {
+				repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+				repo.EXPECT().
+					Reserve(ctx, gomock.Any(), now).
+					Return(&model.IdempotencyKey{
+						RequestHash:     "hash-1",
+						StatusCode:      &created,
+						ResponseHeaders: map[string]string{"Content-Type": "application/json"},
+						ResponseBody:    []byte(`{"id":"todo-1"}`),
+					}, nil)
+
+				return NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+			},
+			input: &input.BeginIdempotentRequestInput{IdempotencyScope: scope, RequestHash: "hash-1"},
+			want: &output.StoredResponseOutput{
+				StatusCode: 201,
+				Headers:    map[string]string{"Content-Type": "application/json"},
+				Body:       []byte(`{"id":"todo-1"}`),
+			},
+			wantErr: false,
+		},
+		{
+			name: "error - key reused for a different request",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor {
+				repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+				repo.EXPECT().
+					Reserve(ctx, gomock.Any(), now).
+					Return(&model.IdempotencyKey{RequestHash: "hash-1", StatusCode: &created}, nil)
+
+				return NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+			},
+			input:    &input.BeginIdempotentRequestInput{IdempotencyScope: scope, RequestHash: "hash-2"},
+			wantCode: cerror.ErrCodeUnprocessable,
+			wantErr:  true,
+		},
+		{
+			name: "error - first request still in progress",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor {
+				repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+				repo.EXPECT().
+					Reserve(ctx, gomock.Any(), now).
+					Return(&model.IdempotencyKey{RequestHash: "hash-1"}, nil)
+
+				return NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+			},
+			input:    &input.BeginIdempotentRequestInput{IdempotencyScope: scope, RequestHash: "hash-1"},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+		{
+			name: "error - key too long",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) IIdempotencyInteractor {
+				repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				return NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+			},
+			input: &input.BeginIdempotentRequestInput{
+				IdempotencyScope: input.IdempotencyScope{Key: string(make([]byte, 256))},
+				RequestHash:      "hash-1",
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			got, gotErr := interactor.Begin(ctx, tt.input)
+
+			if tt.wantErr {
+				assert.Nil(t, got)
+				var appErr *cerror.AppError
+				if assert.ErrorAs(t, gotErr, &appErr) {
+					assert.Equal(t, tt.wantCode, appErr.Code)
+				}
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIdempotencyInteractor_PurgeExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mock_repository.NewMockIIdempotencyKeyRepository(ctrl)
+	authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+	clock := mock_pkg.NewMockIClock(ctrl)
+
+	clock.EXPECT().Now().Return(now)
+	authRepo.EXPECT().
+		FindAllTenants(ctx).
+		Return([]*model.Tenant{{ID: "tenant-1"}, {ID: "tenant-2"}}, nil)
+
+	// Unauthenticated keys live under the empty tenant
+	repo.EXPECT().DeleteExpired(ctx, "", now).Return(1, nil)
+	repo.EXPECT().DeleteExpired(ctx, "tenant-1", now).Return(0, errors.New("db error"))
+	repo.EXPECT().DeleteExpired(ctx, "tenant-2", now).Return(2, nil)
+
+	interactor := NewIdempotencyInteractor(repo, authRepo, clock, 24*time.Hour)
+	got, err := interactor.PurgeExpired(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got)
+}
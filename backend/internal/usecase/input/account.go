@@ -0,0 +1,20 @@
+package input
+
+type AccountUserInput struct {
+	TenantID string
+	UserID   string
+}
+
+// DeleteAccountInput is confirmed with either the password or a code mailed by SendDeletionCode
+type DeleteAccountInput struct {
+	TenantID string
+	UserID   string
+	Password string
+	Code     string
+}
+
+type SetDeletionPolicyInput struct {
+	Role        string
+	TenantID    string
+	PublicTodos string
+}
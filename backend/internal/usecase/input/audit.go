@@ -0,0 +1,16 @@
+package input
+
+import "time"
+
+type GetAuditEventsInput struct {
+	// Role of the requesting user; only tenant admins may read the audit log
+	Role       string
+	EntityType *string
+	EntityID   *string
+	ActorID    *string
+	Action     *string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
@@ -5,18 +5,58 @@ type RegisterInput struct {
 	Password   string
 	Name       string
 	TenantSlug string
+	IPAddress  string
+	UserAgent  string
+}
+
+func (in *RegisterInput) Validate() error {
+	f := fieldErrors{}
+	f.email("email", in.Email)
+	f.required("password", in.Password)
+	f.required("tenant_slug", in.TenantSlug)
+	return f.err("invalid registration")
 }
 
 type LoginInput struct {
 	Email      string
 	Password   string
 	TenantSlug string
+	IPAddress  string
+	UserAgent  string
+}
+
+func (in *LoginInput) Validate() error {
+	f := fieldErrors{}
+	f.required("email", in.Email)
+	f.required("password", in.Password)
+	f.required("tenant_slug", in.TenantSlug)
+	return f.err("invalid login")
 }
 
 type VerifyEmailInput struct {
 	Token string
 }
 
+func (in *VerifyEmailInput) Validate() error {
+	f := fieldErrors{}
+	f.required("token", in.Token)
+	return f.err("invalid verification")
+}
+
 type RefreshTokenInput struct {
 	RefreshToken string
+	IPAddress    string
+	UserAgent    string
+}
+
+func (in *RefreshTokenInput) Validate() error {
+	f := fieldErrors{}
+	f.required("refresh_token", in.RefreshToken)
+	return f.err("invalid refresh")
+}
+
+type UnlockUserInput struct {
+	Role     string
+	TenantID string
+	UserID   string
 }
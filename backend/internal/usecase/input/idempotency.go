@@ -0,0 +1,22 @@
+package input
+
+// IdempotencyScope identifies a key. TenantID and UserID are empty for
+// unauthenticated requests
+type IdempotencyScope struct {
+	TenantID string
+	UserID   string
+	Key      string
+}
+
+type BeginIdempotentRequestInput struct {
+	IdempotencyScope
+	// RequestHash fingerprints the request. A retry with the same key must match it
+	RequestHash string
+}
+
+type CompleteIdempotentRequestInput struct {
+	IdempotencyScope
+	StatusCode int
+	Headers    map[string]string
+	Body       []byte
+}
@@ -0,0 +1,48 @@
+package input
+
+type EnrollMFAInput struct {
+	MFAToken string
+}
+
+func (in *EnrollMFAInput) Validate() error {
+	f := fieldErrors{}
+	f.required("mfa_token", in.MFAToken)
+	return f.err("invalid two-factor enrollment")
+}
+
+type VerifyMFAInput struct {
+	MFAToken  string
+	Code      string
+	IPAddress string
+	UserAgent string
+}
+
+func (in *VerifyMFAInput) Validate() error {
+	f := fieldErrors{}
+	f.required("mfa_token", in.MFAToken)
+	f.required("code", in.Code)
+	return f.err("invalid two-factor verification")
+}
+
+type MFAUserInput struct {
+	TenantID string
+	UserID   string
+}
+
+type MFACodeInput struct {
+	TenantID string
+	UserID   string
+	Code     string
+}
+
+func (in *MFACodeInput) Validate() error {
+	f := fieldErrors{}
+	f.required("code", in.Code)
+	return f.err("invalid two-factor code")
+}
+
+type SetMFARequirementInput struct {
+	Role     string
+	TenantID string
+	Required bool
+}
@@ -0,0 +1,34 @@
+package input
+
+type OIDCStartInput struct {
+	TenantSlug string
+}
+
+type OIDCCallbackInput struct {
+	TenantSlug string
+	Code       string
+	State      string
+	// StateToken is the signed state kept in a cookie since the start
+	StateToken string
+	// Error is set when the provider refused the sign-in
+	Error     string
+	IPAddress string
+	UserAgent string
+}
+
+// OIDCConfigInput identifies the tenant of an admin managing its config
+type OIDCConfigInput struct {
+	Role     string
+	TenantID string
+}
+
+type SaveOIDCConfigInput struct {
+	Role     string
+	TenantID string
+	Issuer   string
+	ClientID string
+	// ClientSecret may be left empty to keep the current secret
+	ClientSecret   string
+	AllowedDomains []string
+	DefaultRole    string
+}
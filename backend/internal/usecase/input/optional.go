@@ -0,0 +1,52 @@
+package input
+
+// Optional is a field of a partial update. It is either absent (leave the
+// current value alone), null (clear it) or set to a value.
+// The zero value is absent.
+type Optional[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Some returns an Optional holding v
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// Null returns an Optional that clears the field
+func Null[T any]() Optional[T] {
+	return Optional[T]{set: true, null: true}
+}
+
+// FromPtr treats nil as absent, for requests that cannot express null
+func FromPtr[T any](p *T) Optional[T] {
+	if p == nil {
+		return Optional[T]{}
+	}
+	return Some(*p)
+}
+
+// IsSet reports whether the field was present, including as null
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether the field was explicitly null
+func (o Optional[T]) IsNull() bool {
+	return o.null
+}
+
+// Get returns the value and whether there is one
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set && !o.null
+}
+
+// Ptr returns the value as a pointer, nil when absent or null
+func (o Optional[T]) Ptr() *T {
+	if !o.set || o.null {
+		return nil
+	}
+	v := o.value
+	return &v
+}
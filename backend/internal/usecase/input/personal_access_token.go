@@ -0,0 +1,21 @@
+package input
+
+type CreatePersonalAccessTokenInput struct {
+	TenantID string
+	UserID   string
+	Name     string
+	Scopes   []string
+	// ExpiresInDays defaults to 30 when zero
+	ExpiresInDays int
+}
+
+type PersonalAccessTokenUserInput struct {
+	TenantID string
+	UserID   string
+}
+
+type RevokePersonalAccessTokenInput struct {
+	TenantID string
+	UserID   string
+	TokenID  string
+}
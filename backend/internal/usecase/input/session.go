@@ -0,0 +1,28 @@
+package input
+
+type SessionUserInput struct {
+	TenantID string
+	UserID   string
+	// CurrentSessionID is the session of the calling access token, empty for tokens issued before sessions
+	CurrentSessionID string
+}
+
+type RevokeSessionInput struct {
+	TenantID  string
+	UserID    string
+	SessionID string
+}
+
+type RevokeAllSessionsInput struct {
+	TenantID         string
+	UserID           string
+	CurrentSessionID string
+	// ExceptCurrent keeps the calling session signed in
+	ExceptCurrent bool
+}
+
+type ForceLogoutInput struct {
+	Role     string
+	TenantID string
+	UserID   string
+}
@@ -11,14 +11,39 @@ type CreateTodoInput struct {
 	DueDate     *time.Time
 }
 
+func (in *CreateTodoInput) Validate() error {
+	f := fieldErrors{}
+	f.required("title", in.Title)
+	return f.err("invalid todo")
+}
+
 type UpdateTodoInput struct {
 	TodoID      string
 	UserID      string
-	Title       *string
-	Description *string
-	Completed   *bool
-	IsPublic    *bool
-	DueDate     *time.Time
+	Title       Optional[string]
+	Description Optional[string]
+	Completed   Optional[bool]
+	IsPublic    Optional[bool]
+	DueDate     Optional[time.Time]
+	// IfMatchVersions holds the versions from If-Match. Nil means the update is unconditional
+	IfMatchVersions []int
+}
+
+func (in *UpdateTodoInput) Validate() error {
+	f := fieldErrors{}
+	// Title, completed and is_public have no "empty" state to clear to
+	if in.Title.IsNull() {
+		f.add("title", "must not be null")
+	} else if title, ok := in.Title.Get(); ok {
+		f.required("title", title)
+	}
+	if in.Completed.IsNull() {
+		f.add("completed", "must not be null")
+	}
+	if in.IsPublic.IsNull() {
+		f.add("is_public", "must not be null")
+	}
+	return f.err("invalid todo update")
 }
 
 type GetTodosInput struct {
@@ -31,3 +56,48 @@ type GetPublicTodosInput struct {
 	Limit  int
 	Offset int
 }
+
+type GetTrashInput struct {
+	UserID string
+	Limit  int
+	Offset int
+}
+
+type BatchTodoOp string
+
+const (
+	BatchTodoOpCreate   BatchTodoOp = "create"
+	BatchTodoOpUpdate   BatchTodoOp = "update"
+	BatchTodoOpComplete BatchTodoOp = "complete"
+	BatchTodoOpDelete   BatchTodoOp = "delete"
+	BatchTodoOpMove     BatchTodoOp = "move"
+)
+
+// Destinations of a move operation
+const (
+	BatchTodoMoveToTrash = "trash"
+	BatchTodoMoveToTodos = "todos"
+)
+
+type BatchTodosInput struct {
+	UserID   string
+	TenantID string
+	// Atomic rolls back every operation when one fails. Otherwise each
+	// operation succeeds or fails on its own
+	Atomic     bool
+	Operations []BatchTodoOperation
+}
+
+// BatchTodoOperation is one entry of a batch. UserID and TenantID of
+// Create and Update are taken from BatchTodosInput
+type BatchTodoOperation struct {
+	Op     BatchTodoOp
+	Create *CreateTodoInput
+	Update *UpdateTodoInput
+	// TodoID is the target of complete, delete and move
+	TodoID string
+	// MoveTo is BatchTodoMoveToTrash or BatchTodoMoveToTodos
+	MoveTo string
+	// IfMatchVersions makes complete conditional, like UpdateTodoInput.IfMatchVersions
+	IfMatchVersions []int
+}
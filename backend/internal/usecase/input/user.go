@@ -1,6 +1,119 @@
 package input
 
+import "fmt"
+
 type UpdateUserInput struct {
 	UserID string
-	Name   *string
+	Name   Optional[string]
+}
+
+type ChangePasswordInput struct {
+	TenantID string
+	UserID   string
+	// CurrentSessionID stays logged in; every other session is revoked
+	CurrentSessionID string
+	CurrentPassword  string
+	NewPassword      string
+}
+
+func (in *ChangePasswordInput) Validate() error {
+	f := fieldErrors{}
+	f.required("current_password", in.CurrentPassword)
+	f.required("new_password", in.NewPassword)
+	return f.err("invalid password change")
+}
+
+type RequestEmailChangeInput struct {
+	TenantID        string
+	UserID          string
+	NewEmail        string
+	CurrentPassword string
+}
+
+func (in *RequestEmailChangeInput) Validate() error {
+	f := fieldErrors{}
+	f.email("new_email", in.NewEmail)
+	f.required("current_password", in.CurrentPassword)
+	return f.err("invalid email change")
+}
+
+type ConfirmEmailChangeInput struct {
+	TenantID string
+	UserID   string
+	Token    string
+}
+
+func (in *ConfirmEmailChangeInput) Validate() error {
+	f := fieldErrors{}
+	f.required("token", in.Token)
+	return f.err("invalid email change confirmation")
+}
+
+type SetPasswordPolicyInput struct {
+	Role     string
+	TenantID string
+	// MinLength nil falls back to PASSWORD_MIN_LENGTH
+	MinLength           *int
+	MinCharacterClasses int
+	History             int
+}
+
+const (
+	// MinPasswordLength is the lowest minimum a tenant may set
+	MinPasswordLength = 8
+	// MaxPasswordBytes is where bcrypt stops reading a password
+	MaxPasswordBytes = 72
+	// MaxPasswordHistory bounds the hashes checked on every password change
+	MaxPasswordHistory  = 24
+	maxCharacterClasses = 4
+)
+
+func (in *SetPasswordPolicyInput) Validate() error {
+	f := fieldErrors{}
+	if in.MinLength != nil && (*in.MinLength < MinPasswordLength || *in.MinLength > MaxPasswordBytes) {
+		f.add("min_length", fmt.Sprintf("must be between %d and %d", MinPasswordLength, MaxPasswordBytes))
+	}
+	if in.MinCharacterClasses < 0 || in.MinCharacterClasses > maxCharacterClasses {
+		f.add("min_character_classes", fmt.Sprintf("must be between 0 and %d", maxCharacterClasses))
+	}
+	if in.History < 0 || in.History > MaxPasswordHistory {
+		f.add("history", fmt.Sprintf("must be between 0 and %d", MaxPasswordHistory))
+	}
+	return f.err("invalid password policy")
+}
+
+// MaxRateLimitPerMinute bounds the per-member limit a tenant may set
+const MaxRateLimitPerMinute = 100000
+
+type SetRateLimitInput struct {
+	Role     string
+	TenantID string
+	// PerMinute nil falls back to RATE_LIMIT_USER_PER_MINUTE
+	PerMinute *int
+}
+
+func (in *SetRateLimitInput) Validate() error {
+	f := fieldErrors{}
+	if in.PerMinute != nil && (*in.PerMinute < 1 || *in.PerMinute > MaxRateLimitPerMinute) {
+		f.add("requests_per_minute", fmt.Sprintf("must be between 1 and %d", MaxRateLimitPerMinute))
+	}
+	return f.err("invalid rate limit")
+}
+
+// MaxTrashRetentionDays bounds how long a tenant may keep deleted todos
+const MaxTrashRetentionDays = 3650
+
+type SetTrashRetentionInput struct {
+	Role     string
+	TenantID string
+	// Days nil falls back to TRASH_RETENTION_DAYS
+	Days *int
+}
+
+func (in *SetTrashRetentionInput) Validate() error {
+	f := fieldErrors{}
+	if in.Days != nil && (*in.Days < 1 || *in.Days > MaxTrashRetentionDays) {
+		f.add("days", fmt.Sprintf("must be between 1 and %d", MaxTrashRetentionDays))
+	}
+	return f.err("invalid trash retention")
 }
@@ -0,0 +1,43 @@
+package input
+
+import (
+	"net/mail"
+	"strings"
+
+	"good-todo-go/internal/pkg/cerror"
+)
+
+// fieldErrors collects problems with individual fields of an input. Its err
+// is a VALIDATION_ERROR whose details map each field to its first problem
+type fieldErrors map[string]interface{}
+
+func (f fieldErrors) add(field, problem string) {
+	if _, ok := f[field]; !ok {
+		f[field] = problem
+	}
+}
+
+// required rejects empty and whitespace-only values
+func (f fieldErrors) required(field, value string) {
+	if strings.TrimSpace(value) == "" {
+		f.add(field, "is required")
+	}
+}
+
+// email rejects values that are not a bare address
+func (f fieldErrors) email(field, value string) {
+	if value == "" {
+		f.required(field, value)
+		return
+	}
+	if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+		f.add(field, "must be a valid email")
+	}
+}
+
+func (f fieldErrors) err(message string) error {
+	if len(f) == 0 {
+		return nil
+	}
+	return cerror.NewValidationError(message, map[string]interface{}(f))
+}
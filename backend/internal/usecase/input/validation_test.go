@@ -0,0 +1,99 @@
+package input
+
+import (
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	nine, hundred := 9, 100
+
+	tests := []struct {
+		name        string
+		input       interface{ Validate() error }
+		wantDetails map[string]interface{}
+	}{
+		{
+			name:  "valid registration",
+			input: &RegisterInput{Email: "alice@example.com", Password: "password123", TenantSlug: "acme"},
+		},
+		{
+			name:  "registration with every field missing",
+			input: &RegisterInput{},
+			wantDetails: map[string]interface{}{
+				"email":       "is required",
+				"password":    "is required",
+				"tenant_slug": "is required",
+			},
+		},
+		{
+			name:        "registration with an invalid email",
+			input:       &RegisterInput{Email: "Alice <alice@example.com>", Password: "password123", TenantSlug: "acme"},
+			wantDetails: map[string]interface{}{"email": "must be a valid email"},
+		},
+		{
+			name:        "blank todo title",
+			input:       &CreateTodoInput{Title: " \t"},
+			wantDetails: map[string]interface{}{"title": "is required"},
+		},
+		{
+			name:  "update leaving everything out",
+			input: &UpdateTodoInput{},
+		},
+		{
+			name:  "update clearing fields that cannot be cleared",
+			input: &UpdateTodoInput{Title: Null[string](), Completed: Null[bool](), IsPublic: Null[bool](), Description: Null[string]()},
+			wantDetails: map[string]interface{}{
+				"title":     "must not be null",
+				"completed": "must not be null",
+				"is_public": "must not be null",
+			},
+		},
+		{
+			name:        "update to a blank title",
+			input:       &UpdateTodoInput{Title: Some("")},
+			wantDetails: map[string]interface{}{"title": "is required"},
+		},
+		{
+			name:        "two-factor verification without a code",
+			input:       &VerifyMFAInput{MFAToken: "token"},
+			wantDetails: map[string]interface{}{"code": "is required"},
+		},
+		{
+			name:  "password policy within bounds",
+			input: &SetPasswordPolicyInput{MinLength: &nine, MinCharacterClasses: 4, History: 24},
+		},
+		{
+			name:  "password policy out of bounds",
+			input: &SetPasswordPolicyInput{MinLength: &hundred, MinCharacterClasses: -1, History: 25},
+			wantDetails: map[string]interface{}{
+				"min_length":            "must be between 8 and 72",
+				"min_character_classes": "must be between 0 and 4",
+				"history":               "must be between 0 and 24",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.input.Validate()
+
+			if tt.wantDetails == nil {
+				require.NoError(t, err)
+				return
+			}
+			var appErr *cerror.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, cerror.ErrCodeValidationError, appErr.Code)
+			assert.Equal(t, tt.wantDetails, appErr.Details)
+		})
+	}
+}
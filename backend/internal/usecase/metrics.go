@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// meteredAuthInteractor counts logins and token refreshes. The other methods
+// are those of the wrapped interactor
+type meteredAuthInteractor struct {
+	IAuthInteractor
+	metrics *metrics.Metrics
+}
+
+func NewMeteredAuthInteractor(next IAuthInteractor, m *metrics.Metrics) IAuthInteractor {
+	return &meteredAuthInteractor{IAuthInteractor: next, metrics: m}
+}
+
+func (i *meteredAuthInteractor) Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error) {
+	out, err := i.IAuthInteractor.Login(ctx, in)
+	result := metrics.Result(err)
+	if err == nil && out.MFARequired {
+		result = metrics.ResultMFARequired
+	}
+	i.metrics.Logins.WithLabelValues(result).Inc()
+	return out, err
+}
+
+func (i *meteredAuthInteractor) VerifyMFA(ctx context.Context, in *input.VerifyMFAInput) (*output.AuthOutput, error) {
+	out, err := i.IAuthInteractor.VerifyMFA(ctx, in)
+	i.metrics.Logins.WithLabelValues(metrics.Result(err)).Inc()
+	return out, err
+}
+
+func (i *meteredAuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (*output.AuthOutput, error) {
+	out, err := i.IAuthInteractor.RefreshToken(ctx, in)
+	i.metrics.TokenRefreshes.WithLabelValues(metrics.Result(err)).Inc()
+	return out, err
+}
+
+// meteredTodoInteractor counts todos created and completed, including by batches.
+// The other methods are those of the wrapped interactor
+type meteredTodoInteractor struct {
+	ITodoInteractor
+	metrics *metrics.Metrics
+}
+
+func NewMeteredTodoInteractor(next ITodoInteractor, m *metrics.Metrics) ITodoInteractor {
+	return &meteredTodoInteractor{ITodoInteractor: next, metrics: m}
+}
+
+func (i *meteredTodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error) {
+	out, err := i.ITodoInteractor.CreateTodo(ctx, in)
+	if err == nil {
+		i.metrics.TodosCreated.Inc()
+	}
+	return out, err
+}
+
+func (i *meteredTodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (*output.TodoOutput, error) {
+	out, err := i.ITodoInteractor.UpdateTodo(ctx, in)
+	// Resending completed=true for a todo that is already done is not a completion
+	if err == nil && out.JustCompleted {
+		i.metrics.TodosCompleted.Inc()
+	}
+	return out, err
+}
+
+func (i *meteredTodoInteractor) BatchTodos(ctx context.Context, in *input.BatchTodosInput) (*output.BatchTodosOutput, error) {
+	out, err := i.ITodoInteractor.BatchTodos(ctx, in)
+	if err != nil {
+		return out, err
+	}
+	for idx, result := range out.Results {
+		if result.ErrorCode != "" {
+			continue
+		}
+		if in.Operations[idx].Op == input.BatchTodoOpCreate {
+			i.metrics.TodosCreated.Inc()
+		}
+		if result.Todo != nil && result.Todo.JustCompleted {
+			i.metrics.TodosCompleted.Inc()
+		}
+	}
+	return out, err
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/metrics"
+	"good-todo-go/internal/usecase/input"
+	mock_usecase "good-todo-go/internal/usecase/mock"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMeteredAuthInteractor(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	m := metrics.New(prometheus.NewRegistry())
+	next := mock_usecase.NewMockIAuthInteractor(ctrl)
+	next.EXPECT().Login(ctx, gomock.Any()).Return(&output.AuthOutput{AccessToken: "access"}, nil)
+	next.EXPECT().Login(ctx, gomock.Any()).Return(&output.AuthOutput{MFARequired: true}, nil)
+	next.EXPECT().Login(ctx, gomock.Any()).Return(nil, cerror.NewUnauthorized("invalid email or password", nil))
+	next.EXPECT().VerifyMFA(ctx, gomock.Any()).Return(&output.AuthOutput{AccessToken: "access"}, nil)
+	next.EXPECT().RefreshToken(ctx, gomock.Any()).Return(nil, cerror.NewUnauthorized("invalid refresh token", nil))
+	interactor := NewMeteredAuthInteractor(next, m)
+
+	for range 3 {
+		_, _ = interactor.Login(ctx, &input.LoginInput{})
+	}
+	_, _ = interactor.VerifyMFA(ctx, &input.VerifyMFAInput{})
+	_, _ = interactor.RefreshToken(ctx, &input.RefreshTokenInput{})
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.Logins.WithLabelValues(metrics.ResultSuccess)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.Logins.WithLabelValues(metrics.ResultMFARequired)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.Logins.WithLabelValues(metrics.ResultFailure)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.TokenRefreshes.WithLabelValues(metrics.ResultFailure)))
+}
+
+func TestMeteredTodoInteractor(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	m := metrics.New(prometheus.NewRegistry())
+	next := mock_usecase.NewMockITodoInteractor(ctrl)
+	next.EXPECT().CreateTodo(ctx, gomock.Any()).Return(&output.TodoOutput{}, nil)
+	next.EXPECT().UpdateTodo(ctx, gomock.Any()).Return(&output.TodoOutput{Completed: true, JustCompleted: true}, nil)
+	// A client resending the whole todo, which was already completed
+	next.EXPECT().UpdateTodo(ctx, gomock.Any()).Return(&output.TodoOutput{Completed: true}, nil)
+	next.EXPECT().UpdateTodo(ctx, gomock.Any()).Return(&output.TodoOutput{}, nil)
+	batch := &input.BatchTodosInput{Operations: []input.BatchTodoOperation{
+		{Op: input.BatchTodoOpCreate, Create: &input.CreateTodoInput{Title: "a"}},
+		{Op: input.BatchTodoOpCreate, Create: &input.CreateTodoInput{Title: ""}},
+		{Op: input.BatchTodoOpComplete, TodoID: "todo-1"},
+		{Op: input.BatchTodoOpUpdate, TodoID: "todo-2", Update: &input.UpdateTodoInput{Completed: input.Some(true)}},
+		{Op: input.BatchTodoOpDelete, TodoID: "todo-3"},
+		{Op: input.BatchTodoOpComplete, TodoID: "todo-4"},
+	}}
+	next.EXPECT().BatchTodos(ctx, batch).Return(&output.BatchTodosOutput{Results: []*output.BatchTodoResultOutput{
+		{Index: 0, Status: 201},
+		{Index: 1, Status: 400, ErrorCode: string(cerror.ErrCodeValidationError)},
+		{Index: 2, Status: 200, Todo: &output.TodoOutput{Completed: true, JustCompleted: true}},
+		{Index: 3, Status: 200, Todo: &output.TodoOutput{Completed: true, JustCompleted: true}},
+		{Index: 4, Status: 204},
+		{Index: 5, Status: 200, Todo: &output.TodoOutput{Completed: true}},
+	}}, nil)
+	interactor := NewMeteredTodoInteractor(next, m)
+
+	_, _ = interactor.CreateTodo(ctx, &input.CreateTodoInput{Title: "a"})
+	_, _ = interactor.UpdateTodo(ctx, &input.UpdateTodoInput{TodoID: "todo-1", Completed: input.Some(true)})
+	_, _ = interactor.UpdateTodo(ctx, &input.UpdateTodoInput{TodoID: "todo-1", Title: input.Some("b"), Completed: input.Some(true)})
+	_, _ = interactor.UpdateTodo(ctx, &input.UpdateTodoInput{TodoID: "todo-1", Title: input.Some("c")})
+	_, _ = interactor.BatchTodos(ctx, batch)
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.TodosCreated))
+	assert.Equal(t, float64(3), testutil.ToFloat64(m.TodosCompleted))
+}
@@ -0,0 +1,251 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/totp"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+const (
+	// mfaIssuer is the account issuer shown in authenticator apps
+	mfaIssuer         = "Good Todo"
+	recoveryCodeCount = 10
+)
+
+type IMFAInteractor interface {
+	// Enroll starts enrollment with a new TOTP secret
+	Enroll(ctx context.Context, in *input.MFAUserInput) (*output.MFAEnrollmentOutput, error)
+	// Confirm enables two-factor authentication with a first code and returns the recovery codes
+	Confirm(ctx context.Context, in *input.MFACodeInput) (*output.MFARecoveryCodesOutput, error)
+	Disable(ctx context.Context, in *input.MFACodeInput) error
+	// SetTenantRequirement makes two-factor authentication mandatory for the tenant (tenant admins only)
+	SetTenantRequirement(ctx context.Context, in *input.SetMFARequirementInput) error
+}
+
+type MFAInteractor struct {
+	authRepo repository.IAuthRepository
+	clock    pkg.IClock
+}
+
+func NewMFAInteractor(
+	authRepo repository.IAuthRepository,
+	clock pkg.IClock,
+) IMFAInteractor {
+	return &MFAInteractor{
+		authRepo: authRepo,
+		clock:    clock,
+	}
+}
+
+func (i *MFAInteractor) Enroll(ctx context.Context, in *input.MFAUserInput) (*output.MFAEnrollmentOutput, error) {
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+	if user.MFAEnabled {
+		return nil, cerror.NewConflict("two-factor authentication is already enabled", nil)
+	}
+
+	out, err := startMFAEnrollment(user)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	return out, nil
+}
+
+func (i *MFAInteractor) Confirm(ctx context.Context, in *input.MFACodeInput) (*output.MFARecoveryCodesOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+	if user.MFAEnabled {
+		return nil, cerror.NewConflict("two-factor authentication is already enabled", nil)
+	}
+	if user.MFASecret == nil {
+		return nil, cerror.NewBadRequest("two-factor enrollment has not been started", nil)
+	}
+
+	codes, ok, err := finishMFAEnrollment(user, in.Code, i.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, cerror.NewBadRequest("invalid two-factor code", nil)
+	}
+
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	return &output.MFARecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+func (i *MFAInteractor) Disable(ctx context.Context, in *input.MFACodeInput) error {
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to get tenant", err)
+	}
+	if tenant.RequireMFA {
+		return cerror.NewForbidden("the tenant requires two-factor authentication", nil)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+	if !user.MFAEnabled {
+		return cerror.NewBadRequest("two-factor authentication is not enabled", nil)
+	}
+
+	// Require a second factor, so a stolen access token alone cannot turn it off
+	if !acceptMFACode(user, in.Code, i.clock.Now()) {
+		return cerror.NewBadRequest("invalid two-factor code", nil)
+	}
+
+	user.MFAEnabled = false
+	user.MFASecret = nil
+	user.MFALastUsedStep = nil
+	user.MFARecoveryCodeHashes = nil
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	return nil
+}
+
+func (i *MFAInteractor) SetTenantRequirement(ctx context.Context, in *input.SetMFARequirementInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can change the two-factor requirement", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+
+	tenant.RequireMFA = in.Required
+	if _, err := i.authRepo.UpdateTenant(ctx, tenant); err != nil {
+		return cerror.NewInternalServerError("failed to update tenant", err)
+	}
+
+	return nil
+}
+
+// startMFAEnrollment gives user a new TOTP secret. The caller saves user
+func startMFAEnrollment(user *model.User) (*output.MFAEnrollmentOutput, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate TOTP secret", err)
+	}
+
+	user.MFASecret = &secret
+	user.MFALastUsedStep = nil
+	return &output.MFAEnrollmentOutput{
+		Secret: secret,
+		URI:    totp.URI(mfaIssuer, user.Email, secret),
+	}, nil
+}
+
+// finishMFAEnrollment enables two-factor authentication when code matches the
+// pending secret and returns new recovery codes. The caller saves user
+func finishMFAEnrollment(user *model.User, code string, now time.Time) ([]string, bool, error) {
+	if !acceptTOTP(user, code, now) {
+		return nil, false, nil
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, false, cerror.NewInternalServerError("failed to generate recovery codes", err)
+	}
+
+	user.MFAEnabled = true
+	user.MFARecoveryCodeHashes = hashes
+	return codes, true, nil
+}
+
+// acceptMFACode accepts a TOTP code or an unused recovery code, which is used up
+func acceptMFACode(user *model.User, code string, now time.Time) bool {
+	if acceptTOTP(user, code, now) {
+		return true
+	}
+
+	hash := hashRecoveryCode(code)
+	for idx, h := range user.MFARecoveryCodeHashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			user.MFARecoveryCodeHashes = slices.Delete(slices.Clone(user.MFARecoveryCodeHashes), idx, idx+1)
+			return true
+		}
+	}
+	return false
+}
+
+// acceptTOTP validates code and records its time step so it cannot be replayed
+func acceptTOTP(user *model.User, code string, now time.Time) bool {
+	if user.MFASecret == nil {
+		return false
+	}
+
+	var lastStep int64
+	if user.MFALastUsedStep != nil {
+		lastStep = *user.MFALastUsedStep
+	}
+	step, ok := totp.Validate(*user.MFASecret, code, now, lastStep)
+	if !ok {
+		return false
+	}
+
+	user.MFALastUsedStep = &step
+	return true
+}
+
+// generateRecoveryCodes returns codes like "abcde-fghij" and their hashes.
+// Each has 50 random bits, so a fast hash is enough.
+func generateRecoveryCodes() ([]string, []string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for idx := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(b))[:10]
+		codes[idx] = raw[:5] + "-" + raw[5:]
+		hashes[idx] = hashRecoveryCode(codes[idx])
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, dashes and surrounding spaces, as users retype the code
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/totp"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testMFASecret is a fixed TOTP secret so tests can compute codes
+const testMFASecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func testTOTPCode(t *testing.T, now time.Time) string {
+	t.Helper()
+	code, err := totp.Code(testMFASecret, now)
+	require.NoError(t, err)
+	return code
+}
+
+func TestMFAInteractor_Enroll(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - secret is stored but not enabled",
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "test@example.com"}, nil)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.NotNil(t, u.MFASecret)
+						assert.False(t, u.MFAEnabled)
+						return u, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - already enabled",
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", MFAEnabled: true}, nil)
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			tt.setupMocks(authRepo)
+
+			interactor := NewMFAInteractor(authRepo, mock_pkg.NewMockIClock(ctrl))
+			out, err := interactor.Enroll(context.Background(), &input.MFAUserInput{TenantID: "tenant-id", UserID: "user-id"})
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, out.Secret)
+			assert.Contains(t, out.URI, "otpauth://totp/Good%20Todo:test@example.com?")
+			assert.Contains(t, out.URI, "secret="+out.Secret)
+		})
+	}
+}
+
+func TestMFAInteractor_Confirm(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	secret := testMFASecret
+
+	tests := []struct {
+		name       string
+		code       func(t *testing.T) string
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - first code enables and returns recovery codes",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", MFASecret: &secret}, nil)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, u.MFAEnabled)
+						assert.Len(t, u.MFARecoveryCodeHashes, recoveryCodeCount)
+						assert.Equal(t, totp.Step(now), *u.MFALastUsedStep)
+						return u, nil
+					})
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - wrong code",
+			code: func(*testing.T) string { return "000000" },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", MFASecret: &secret}, nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - enrollment not started",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id"}, nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - already enabled",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().
+					FindUserByID(gomock.Any(), "tenant-id", "user-id").
+					Return(&model.User{ID: "user-id", MFASecret: &secret, MFAEnabled: true}, nil)
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMocks(authRepo)
+
+			interactor := NewMFAInteractor(authRepo, clock)
+			out, err := interactor.Confirm(context.Background(), &input.MFACodeInput{TenantID: "tenant-id", UserID: "user-id", Code: tt.code(t)})
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, out.RecoveryCodes, recoveryCodeCount)
+		})
+	}
+}
+
+func TestMFAInteractor_Disable(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	secret := testMFASecret
+	enabledUser := func() *model.User {
+		return &model.User{
+			ID:                    "user-id",
+			MFAEnabled:            true,
+			MFASecret:             &secret,
+			MFARecoveryCodeHashes: []string{hashRecoveryCode("abcde-fghij")},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		code       func(t *testing.T) string
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - with a TOTP code",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+				authRepo.EXPECT().
+					UpdateUser(gomock.Any(), &model.User{ID: "user-id"}).
+					Return(&model.User{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - with a recovery code, ignoring case and dashes",
+			code: func(*testing.T) string { return "ABCDEFGHIJ" },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+				authRepo.EXPECT().UpdateUser(gomock.Any(), &model.User{ID: "user-id"}).Return(&model.User{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "fail - tenant requires two-factor authentication",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", RequireMFA: true}, nil)
+			},
+			wantCode: cerror.ErrCodeForbidden,
+			wantErr:  true,
+		},
+		{
+			name: "fail - wrong code",
+			code: func(*testing.T) string { return "000000" },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(enabledUser(), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - not enabled",
+			code: func(t *testing.T) string { return testTOTPCode(t, now) },
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(&model.User{ID: "user-id"}, nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMocks(authRepo)
+
+			interactor := NewMFAInteractor(authRepo, clock)
+			err := interactor.Disable(context.Background(), &input.MFACodeInput{TenantID: "tenant-id", UserID: "user-id", Code: tt.code(t)})
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMFAInteractor_SetTenantRequirement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      *input.SetMFARequirementInput
+		setupMocks func(authRepo *mock_repository.MockIAuthRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name:  "success - admin requires two-factor authentication",
+			input: &input.SetMFARequirementInput{Role: "admin", TenantID: "tenant-id", Required: true},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", Name: "Tenant"}, nil)
+				authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id", Name: "Tenant", RequireMFA: true}).
+					Return(&model.Tenant{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:       "fail - not an admin",
+			input:      &input.SetMFARequirementInput{Role: "member", TenantID: "tenant-id", Required: true},
+			setupMocks: func(*mock_repository.MockIAuthRepository) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:  "fail - update error",
+			input: &input.SetMFARequirementInput{Role: "admin", TenantID: "tenant-id", Required: false},
+			setupMocks: func(authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				authRepo.EXPECT().UpdateTenant(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			wantCode: cerror.ErrCodeInternalServerError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			tt.setupMocks(authRepo)
+
+			interactor := NewMFAInteractor(authRepo, mock_pkg.NewMockIClock(ctrl))
+			err := interactor.SetTenantRequirement(context.Background(), tt.input)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: account.go
+//
+// Generated by this command:
+//
+//	mockgen -source=account.go -destination=mock/account.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAccountInteractor is a mock of IAccountInteractor interface.
+type MockIAccountInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAccountInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIAccountInteractorMockRecorder is the mock recorder for MockIAccountInteractor.
+type MockIAccountInteractorMockRecorder struct {
+	mock *MockIAccountInteractor
+}
+
+// NewMockIAccountInteractor creates a new mock instance.
+func NewMockIAccountInteractor(ctrl *gomock.Controller) *MockIAccountInteractor {
+	mock := &MockIAccountInteractor{ctrl: ctrl}
+	mock.recorder = &MockIAccountInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAccountInteractor) EXPECT() *MockIAccountInteractorMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIAccountInteractor) Delete(ctx context.Context, in *input.DeleteAccountInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIAccountInteractorMockRecorder) Delete(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIAccountInteractor)(nil).Delete), ctx, in)
+}
+
+// Export mocks base method.
+func (m *MockIAccountInteractor) Export(ctx context.Context, in *input.AccountUserInput) (*output.AccountExportOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, in)
+	ret0, _ := ret[0].(*output.AccountExportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockIAccountInteractorMockRecorder) Export(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockIAccountInteractor)(nil).Export), ctx, in)
+}
+
+// SendDeletionCode mocks base method.
+func (m *MockIAccountInteractor) SendDeletionCode(ctx context.Context, in *input.AccountUserInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDeletionCode", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDeletionCode indicates an expected call of SendDeletionCode.
+func (mr *MockIAccountInteractorMockRecorder) SendDeletionCode(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDeletionCode", reflect.TypeOf((*MockIAccountInteractor)(nil).SendDeletionCode), ctx, in)
+}
+
+// SetDeletionPolicy mocks base method.
+func (m *MockIAccountInteractor) SetDeletionPolicy(ctx context.Context, in *input.SetDeletionPolicyInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeletionPolicy", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeletionPolicy indicates an expected call of SetDeletionPolicy.
+func (mr *MockIAccountInteractorMockRecorder) SetDeletionPolicy(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeletionPolicy", reflect.TypeOf((*MockIAccountInteractor)(nil).SetDeletionPolicy), ctx, in)
+}
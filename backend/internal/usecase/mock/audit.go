@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=audit.go -destination=mock/audit.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIAuditInteractor is a mock of IAuditInteractor interface.
+type MockIAuditInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAuditInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIAuditInteractorMockRecorder is the mock recorder for MockIAuditInteractor.
+type MockIAuditInteractorMockRecorder struct {
+	mock *MockIAuditInteractor
+}
+
+// NewMockIAuditInteractor creates a new mock instance.
+func NewMockIAuditInteractor(ctrl *gomock.Controller) *MockIAuditInteractor {
+	mock := &MockIAuditInteractor{ctrl: ctrl}
+	mock.recorder = &MockIAuditInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAuditInteractor) EXPECT() *MockIAuditInteractorMockRecorder {
+	return m.recorder
+}
+
+// GetAuditEvents mocks base method.
+func (m *MockIAuditInteractor) GetAuditEvents(ctx context.Context, in *input.GetAuditEventsInput) (*output.AuditEventListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditEvents", ctx, in)
+	ret0, _ := ret[0].(*output.AuditEventListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditEvents indicates an expected call of GetAuditEvents.
+func (mr *MockIAuditInteractorMockRecorder) GetAuditEvents(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditEvents", reflect.TypeOf((*MockIAuditInteractor)(nil).GetAuditEvents), ctx, in)
+}
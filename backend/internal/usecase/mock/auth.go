@@ -42,6 +42,21 @@ func (m *MockIAuthInteractor) EXPECT() *MockIAuthInteractorMockRecorder {
 	return m.recorder
 }
 
+// EnrollMFA mocks base method.
+func (m *MockIAuthInteractor) EnrollMFA(ctx context.Context, in *input.EnrollMFAInput) (*output.MFAEnrollmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMFA", ctx, in)
+	ret0, _ := ret[0].(*output.MFAEnrollmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMFA indicates an expected call of EnrollMFA.
+func (mr *MockIAuthInteractorMockRecorder) EnrollMFA(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMFA", reflect.TypeOf((*MockIAuthInteractor)(nil).EnrollMFA), ctx, in)
+}
+
 // Login mocks base method.
 func (m *MockIAuthInteractor) Login(ctx context.Context, in *input.LoginInput) (*output.AuthOutput, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +72,21 @@ func (mr *MockIAuthInteractorMockRecorder) Login(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthInteractor)(nil).Login), ctx, in)
 }
 
+// PurgeLoginAttempts mocks base method.
+func (m *MockIAuthInteractor) PurgeLoginAttempts(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeLoginAttempts", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeLoginAttempts indicates an expected call of PurgeLoginAttempts.
+func (mr *MockIAuthInteractorMockRecorder) PurgeLoginAttempts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeLoginAttempts", reflect.TypeOf((*MockIAuthInteractor)(nil).PurgeLoginAttempts), ctx)
+}
+
 // RefreshToken mocks base method.
 func (m *MockIAuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (*output.AuthOutput, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +117,20 @@ func (mr *MockIAuthInteractorMockRecorder) Register(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthInteractor)(nil).Register), ctx, in)
 }
 
+// UnlockUser mocks base method.
+func (m *MockIAuthInteractor) UnlockUser(ctx context.Context, in *input.UnlockUserInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser.
+func (mr *MockIAuthInteractorMockRecorder) UnlockUser(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockIAuthInteractor)(nil).UnlockUser), ctx, in)
+}
+
 // VerifyEmail mocks base method.
 func (m *MockIAuthInteractor) VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (*output.VerifyEmailOutput, error) {
 	m.ctrl.T.Helper()
@@ -101,3 +145,18 @@ func (mr *MockIAuthInteractorMockRecorder) VerifyEmail(ctx, in any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockIAuthInteractor)(nil).VerifyEmail), ctx, in)
 }
+
+// VerifyMFA mocks base method.
+func (m *MockIAuthInteractor) VerifyMFA(ctx context.Context, in *input.VerifyMFAInput) (*output.AuthOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFA", ctx, in)
+	ret0, _ := ret[0].(*output.AuthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFA indicates an expected call of VerifyMFA.
+func (mr *MockIAuthInteractorMockRecorder) VerifyMFA(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFA", reflect.TypeOf((*MockIAuthInteractor)(nil).VerifyMFA), ctx, in)
+}
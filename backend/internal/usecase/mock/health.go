@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: health.go
+//
+// Generated by this command:
+//
+//	mockgen -source=health.go -destination=mock/health.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIHealthInteractor is a mock of IHealthInteractor interface.
+type MockIHealthInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIHealthInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIHealthInteractorMockRecorder is the mock recorder for MockIHealthInteractor.
+type MockIHealthInteractorMockRecorder struct {
+	mock *MockIHealthInteractor
+}
+
+// NewMockIHealthInteractor creates a new mock instance.
+func NewMockIHealthInteractor(ctrl *gomock.Controller) *MockIHealthInteractor {
+	mock := &MockIHealthInteractor{ctrl: ctrl}
+	mock.recorder = &MockIHealthInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIHealthInteractor) EXPECT() *MockIHealthInteractorMockRecorder {
+	return m.recorder
+}
+
+// Drain mocks base method.
+func (m *MockIHealthInteractor) Drain() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Drain")
+}
+
+// Drain indicates an expected call of Drain.
+func (mr *MockIHealthInteractorMockRecorder) Drain() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drain", reflect.TypeOf((*MockIHealthInteractor)(nil).Drain))
+}
+
+// Ready mocks base method.
+func (m *MockIHealthInteractor) Ready(ctx context.Context) *output.ReadinessOutput {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ready", ctx)
+	ret0, _ := ret[0].(*output.ReadinessOutput)
+	return ret0
+}
+
+// Ready indicates an expected call of Ready.
+func (mr *MockIHealthInteractorMockRecorder) Ready(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ready", reflect.TypeOf((*MockIHealthInteractor)(nil).Ready), ctx)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: idempotency.go
+//
+// Generated by this command:
+//
+//	mockgen -source=idempotency.go -destination=mock/idempotency.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIIdempotencyInteractor is a mock of IIdempotencyInteractor interface.
+type MockIIdempotencyInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIIdempotencyInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIIdempotencyInteractorMockRecorder is the mock recorder for MockIIdempotencyInteractor.
+type MockIIdempotencyInteractorMockRecorder struct {
+	mock *MockIIdempotencyInteractor
+}
+
+// NewMockIIdempotencyInteractor creates a new mock instance.
+func NewMockIIdempotencyInteractor(ctrl *gomock.Controller) *MockIIdempotencyInteractor {
+	mock := &MockIIdempotencyInteractor{ctrl: ctrl}
+	mock.recorder = &MockIIdempotencyInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIIdempotencyInteractor) EXPECT() *MockIIdempotencyInteractorMockRecorder {
+	return m.recorder
+}
+
+// Abandon mocks base method.
+func (m *MockIIdempotencyInteractor) Abandon(ctx context.Context, in *input.IdempotencyScope) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Abandon", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abandon indicates an expected call of Abandon.
+func (mr *MockIIdempotencyInteractorMockRecorder) Abandon(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abandon", reflect.TypeOf((*MockIIdempotencyInteractor)(nil).Abandon), ctx, in)
+}
+
+// Begin mocks base method.
+func (m *MockIIdempotencyInteractor) Begin(ctx context.Context, in *input.BeginIdempotentRequestInput) (*output.StoredResponseOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, in)
+	ret0, _ := ret[0].(*output.StoredResponseOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIIdempotencyInteractorMockRecorder) Begin(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIIdempotencyInteractor)(nil).Begin), ctx, in)
+}
+
+// Complete mocks base method.
+func (m *MockIIdempotencyInteractor) Complete(ctx context.Context, in *input.CompleteIdempotentRequestInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIIdempotencyInteractorMockRecorder) Complete(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIIdempotencyInteractor)(nil).Complete), ctx, in)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockIIdempotencyInteractor) PurgeExpired(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockIIdempotencyInteractorMockRecorder) PurgeExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockIIdempotencyInteractor)(nil).PurgeExpired), ctx)
+}
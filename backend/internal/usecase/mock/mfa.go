@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: mfa.go
+//
+// Generated by this command:
+//
+//	mockgen -source=mfa.go -destination=mock/mfa.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIMFAInteractor is a mock of IMFAInteractor interface.
+type MockIMFAInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMFAInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIMFAInteractorMockRecorder is the mock recorder for MockIMFAInteractor.
+type MockIMFAInteractorMockRecorder struct {
+	mock *MockIMFAInteractor
+}
+
+// NewMockIMFAInteractor creates a new mock instance.
+func NewMockIMFAInteractor(ctrl *gomock.Controller) *MockIMFAInteractor {
+	mock := &MockIMFAInteractor{ctrl: ctrl}
+	mock.recorder = &MockIMFAInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMFAInteractor) EXPECT() *MockIMFAInteractorMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockIMFAInteractor) Confirm(ctx context.Context, in *input.MFACodeInput) (*output.MFARecoveryCodesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, in)
+	ret0, _ := ret[0].(*output.MFARecoveryCodesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockIMFAInteractorMockRecorder) Confirm(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockIMFAInteractor)(nil).Confirm), ctx, in)
+}
+
+// Disable mocks base method.
+func (m *MockIMFAInteractor) Disable(ctx context.Context, in *input.MFACodeInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Disable", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Disable indicates an expected call of Disable.
+func (mr *MockIMFAInteractorMockRecorder) Disable(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockIMFAInteractor)(nil).Disable), ctx, in)
+}
+
+// Enroll mocks base method.
+func (m *MockIMFAInteractor) Enroll(ctx context.Context, in *input.MFAUserInput) (*output.MFAEnrollmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enroll", ctx, in)
+	ret0, _ := ret[0].(*output.MFAEnrollmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enroll indicates an expected call of Enroll.
+func (mr *MockIMFAInteractorMockRecorder) Enroll(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enroll", reflect.TypeOf((*MockIMFAInteractor)(nil).Enroll), ctx, in)
+}
+
+// SetTenantRequirement mocks base method.
+func (m *MockIMFAInteractor) SetTenantRequirement(ctx context.Context, in *input.SetMFARequirementInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTenantRequirement", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTenantRequirement indicates an expected call of SetTenantRequirement.
+func (mr *MockIMFAInteractorMockRecorder) SetTenantRequirement(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTenantRequirement", reflect.TypeOf((*MockIMFAInteractor)(nil).SetTenantRequirement), ctx, in)
+}
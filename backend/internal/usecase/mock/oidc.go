@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: oidc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=oidc.go -destination=mock/oidc.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIOIDCInteractor is a mock of IOIDCInteractor interface.
+type MockIOIDCInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIOIDCInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIOIDCInteractorMockRecorder is the mock recorder for MockIOIDCInteractor.
+type MockIOIDCInteractorMockRecorder struct {
+	mock *MockIOIDCInteractor
+}
+
+// NewMockIOIDCInteractor creates a new mock instance.
+func NewMockIOIDCInteractor(ctrl *gomock.Controller) *MockIOIDCInteractor {
+	mock := &MockIOIDCInteractor{ctrl: ctrl}
+	mock.recorder = &MockIOIDCInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIOIDCInteractor) EXPECT() *MockIOIDCInteractorMockRecorder {
+	return m.recorder
+}
+
+// Callback mocks base method.
+func (m *MockIOIDCInteractor) Callback(ctx context.Context, in *input.OIDCCallbackInput) (*output.AuthOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", ctx, in)
+	ret0, _ := ret[0].(*output.AuthOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockIOIDCInteractorMockRecorder) Callback(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockIOIDCInteractor)(nil).Callback), ctx, in)
+}
+
+// DeleteConfig mocks base method.
+func (m *MockIOIDCInteractor) DeleteConfig(ctx context.Context, in *input.OIDCConfigInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConfig", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteConfig indicates an expected call of DeleteConfig.
+func (mr *MockIOIDCInteractorMockRecorder) DeleteConfig(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConfig", reflect.TypeOf((*MockIOIDCInteractor)(nil).DeleteConfig), ctx, in)
+}
+
+// GetConfig mocks base method.
+func (m *MockIOIDCInteractor) GetConfig(ctx context.Context, in *input.OIDCConfigInput) (*output.OIDCConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfig", ctx, in)
+	ret0, _ := ret[0].(*output.OIDCConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConfig indicates an expected call of GetConfig.
+func (mr *MockIOIDCInteractorMockRecorder) GetConfig(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockIOIDCInteractor)(nil).GetConfig), ctx, in)
+}
+
+// SaveConfig mocks base method.
+func (m *MockIOIDCInteractor) SaveConfig(ctx context.Context, in *input.SaveOIDCConfigInput) (*output.OIDCConfigOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveConfig", ctx, in)
+	ret0, _ := ret[0].(*output.OIDCConfigOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveConfig indicates an expected call of SaveConfig.
+func (mr *MockIOIDCInteractorMockRecorder) SaveConfig(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveConfig", reflect.TypeOf((*MockIOIDCInteractor)(nil).SaveConfig), ctx, in)
+}
+
+// Start mocks base method.
+func (m *MockIOIDCInteractor) Start(ctx context.Context, in *input.OIDCStartInput) (*output.OIDCStartOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", ctx, in)
+	ret0, _ := ret[0].(*output.OIDCStartOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockIOIDCInteractorMockRecorder) Start(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockIOIDCInteractor)(nil).Start), ctx, in)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: personal_access_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=personal_access_token.go -destination=mock/personal_access_token.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIPersonalAccessTokenInteractor is a mock of IPersonalAccessTokenInteractor interface.
+type MockIPersonalAccessTokenInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPersonalAccessTokenInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIPersonalAccessTokenInteractorMockRecorder is the mock recorder for MockIPersonalAccessTokenInteractor.
+type MockIPersonalAccessTokenInteractorMockRecorder struct {
+	mock *MockIPersonalAccessTokenInteractor
+}
+
+// NewMockIPersonalAccessTokenInteractor creates a new mock instance.
+func NewMockIPersonalAccessTokenInteractor(ctrl *gomock.Controller) *MockIPersonalAccessTokenInteractor {
+	mock := &MockIPersonalAccessTokenInteractor{ctrl: ctrl}
+	mock.recorder = &MockIPersonalAccessTokenInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPersonalAccessTokenInteractor) EXPECT() *MockIPersonalAccessTokenInteractorMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIPersonalAccessTokenInteractor) Authenticate(ctx context.Context, token string) (*output.PersonalAccessTokenPrincipal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*output.PersonalAccessTokenPrincipal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIPersonalAccessTokenInteractorMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIPersonalAccessTokenInteractor)(nil).Authenticate), ctx, token)
+}
+
+// Create mocks base method.
+func (m *MockIPersonalAccessTokenInteractor) Create(ctx context.Context, in *input.CreatePersonalAccessTokenInput) (*output.CreatedPersonalAccessTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, in)
+	ret0, _ := ret[0].(*output.CreatedPersonalAccessTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIPersonalAccessTokenInteractorMockRecorder) Create(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPersonalAccessTokenInteractor)(nil).Create), ctx, in)
+}
+
+// List mocks base method.
+func (m *MockIPersonalAccessTokenInteractor) List(ctx context.Context, in *input.PersonalAccessTokenUserInput) ([]*output.PersonalAccessTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, in)
+	ret0, _ := ret[0].([]*output.PersonalAccessTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIPersonalAccessTokenInteractorMockRecorder) List(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIPersonalAccessTokenInteractor)(nil).List), ctx, in)
+}
+
+// Revoke mocks base method.
+func (m *MockIPersonalAccessTokenInteractor) Revoke(ctx context.Context, in *input.RevokePersonalAccessTokenInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIPersonalAccessTokenInteractorMockRecorder) Revoke(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIPersonalAccessTokenInteractor)(nil).Revoke), ctx, in)
+}
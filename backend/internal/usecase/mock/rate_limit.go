@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rate_limit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=rate_limit.go -destination=mock/rate_limit.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIRateLimitInteractor is a mock of IRateLimitInteractor interface.
+type MockIRateLimitInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRateLimitInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockIRateLimitInteractorMockRecorder is the mock recorder for MockIRateLimitInteractor.
+type MockIRateLimitInteractorMockRecorder struct {
+	mock *MockIRateLimitInteractor
+}
+
+// NewMockIRateLimitInteractor creates a new mock instance.
+func NewMockIRateLimitInteractor(ctrl *gomock.Controller) *MockIRateLimitInteractor {
+	mock := &MockIRateLimitInteractor{ctrl: ctrl}
+	mock.recorder = &MockIRateLimitInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRateLimitInteractor) EXPECT() *MockIRateLimitInteractorMockRecorder {
+	return m.recorder
+}
+
+// AllowIP mocks base method.
+func (m *MockIRateLimitInteractor) AllowIP(ctx context.Context, ip string) (*output.RateLimitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowIP", ctx, ip)
+	ret0, _ := ret[0].(*output.RateLimitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowIP indicates an expected call of AllowIP.
+func (mr *MockIRateLimitInteractorMockRecorder) AllowIP(ctx, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowIP", reflect.TypeOf((*MockIRateLimitInteractor)(nil).AllowIP), ctx, ip)
+}
+
+// AllowUser mocks base method.
+func (m *MockIRateLimitInteractor) AllowUser(ctx context.Context, tenantID, userID string) (*output.RateLimitOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowUser", ctx, tenantID, userID)
+	ret0, _ := ret[0].(*output.RateLimitOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowUser indicates an expected call of AllowUser.
+func (mr *MockIRateLimitInteractorMockRecorder) AllowUser(ctx, tenantID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowUser", reflect.TypeOf((*MockIRateLimitInteractor)(nil).AllowUser), ctx, tenantID, userID)
+}
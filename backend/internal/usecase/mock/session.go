@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: session.go
+//
+// Generated by this command:
+//
+//	mockgen -source=session.go -destination=mock/session.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	input "good-todo-go/internal/usecase/input"
+	output "good-todo-go/internal/usecase/output"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockISessionInteractor is a mock of ISessionInteractor interface.
+type MockISessionInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockISessionInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockISessionInteractorMockRecorder is the mock recorder for MockISessionInteractor.
+type MockISessionInteractorMockRecorder struct {
+	mock *MockISessionInteractor
+}
+
+// NewMockISessionInteractor creates a new mock instance.
+func NewMockISessionInteractor(ctrl *gomock.Controller) *MockISessionInteractor {
+	mock := &MockISessionInteractor{ctrl: ctrl}
+	mock.recorder = &MockISessionInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockISessionInteractor) EXPECT() *MockISessionInteractorMockRecorder {
+	return m.recorder
+}
+
+// ForceLogout mocks base method.
+func (m *MockISessionInteractor) ForceLogout(ctx context.Context, in *input.ForceLogoutInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceLogout", ctx, in)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceLogout indicates an expected call of ForceLogout.
+func (mr *MockISessionInteractorMockRecorder) ForceLogout(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceLogout", reflect.TypeOf((*MockISessionInteractor)(nil).ForceLogout), ctx, in)
+}
+
+// IsActive mocks base method.
+func (m *MockISessionInteractor) IsActive(ctx context.Context, tenantID, sessionID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, tenantID, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockISessionInteractorMockRecorder) IsActive(ctx, tenantID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockISessionInteractor)(nil).IsActive), ctx, tenantID, sessionID)
+}
+
+// List mocks base method.
+func (m *MockISessionInteractor) List(ctx context.Context, in *input.SessionUserInput) ([]*output.SessionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, in)
+	ret0, _ := ret[0].([]*output.SessionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockISessionInteractorMockRecorder) List(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockISessionInteractor)(nil).List), ctx, in)
+}
+
+// PurgeInactive mocks base method.
+func (m *MockISessionInteractor) PurgeInactive(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeInactive", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeInactive indicates an expected call of PurgeInactive.
+func (mr *MockISessionInteractorMockRecorder) PurgeInactive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeInactive", reflect.TypeOf((*MockISessionInteractor)(nil).PurgeInactive), ctx)
+}
+
+// Revoke mocks base method.
+func (m *MockISessionInteractor) Revoke(ctx context.Context, in *input.RevokeSessionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockISessionInteractorMockRecorder) Revoke(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockISessionInteractor)(nil).Revoke), ctx, in)
+}
+
+// RevokeAll mocks base method.
+func (m *MockISessionInteractor) RevokeAll(ctx context.Context, in *input.RevokeAllSessionsInput) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAll", ctx, in)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAll indicates an expected call of RevokeAll.
+func (mr *MockISessionInteractorMockRecorder) RevokeAll(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAll", reflect.TypeOf((*MockISessionInteractor)(nil).RevokeAll), ctx, in)
+}
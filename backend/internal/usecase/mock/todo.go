@@ -42,6 +42,21 @@ func (m *MockITodoInteractor) EXPECT() *MockITodoInteractorMockRecorder {
 	return m.recorder
 }
 
+// BatchTodos mocks base method.
+func (m *MockITodoInteractor) BatchTodos(ctx context.Context, in *input.BatchTodosInput) (*output.BatchTodosOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchTodos", ctx, in)
+	ret0, _ := ret[0].(*output.BatchTodosOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchTodos indicates an expected call of BatchTodos.
+func (mr *MockITodoInteractorMockRecorder) BatchTodos(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchTodos", reflect.TypeOf((*MockITodoInteractor)(nil).BatchTodos), ctx, in)
+}
+
 // CreateTodo mocks base method.
 func (m *MockITodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,50 @@ func (mr *MockITodoInteractorMockRecorder) GetTodos(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodos", reflect.TypeOf((*MockITodoInteractor)(nil).GetTodos), ctx, in)
 }
 
+// GetTrash mocks base method.
+func (m *MockITodoInteractor) GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx, in)
+	ret0, _ := ret[0].(*output.TodoListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockITodoInteractorMockRecorder) GetTrash(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockITodoInteractor)(nil).GetTrash), ctx, in)
+}
+
+// PurgeTodo mocks base method.
+func (m *MockITodoInteractor) PurgeTodo(ctx context.Context, todoID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTodo", ctx, todoID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTodo indicates an expected call of PurgeTodo.
+func (mr *MockITodoInteractorMockRecorder) PurgeTodo(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTodo", reflect.TypeOf((*MockITodoInteractor)(nil).PurgeTodo), ctx, todoID, userID)
+}
+
+// RestoreTodo mocks base method.
+func (m *MockITodoInteractor) RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTodo", ctx, todoID, userID)
+	ret0, _ := ret[0].(*output.TodoOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockITodoInteractorMockRecorder) RestoreTodo(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockITodoInteractor)(nil).RestoreTodo), ctx, todoID, userID)
+}
+
 // UpdateTodo mocks base method.
 func (m *MockITodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (*output.TodoOutput, error) {
 	m.ctrl.T.Helper()
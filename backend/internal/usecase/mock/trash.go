@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: trash.go
+//
+// Generated by this command:
+//
+//	mockgen -source=trash.go -destination=mock/trash.go -package=mock_usecase
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockITrashInteractor is a mock of ITrashInteractor interface.
+type MockITrashInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockITrashInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockITrashInteractorMockRecorder is the mock recorder for MockITrashInteractor.
+type MockITrashInteractorMockRecorder struct {
+	mock *MockITrashInteractor
+}
+
+// NewMockITrashInteractor creates a new mock instance.
+func NewMockITrashInteractor(ctrl *gomock.Controller) *MockITrashInteractor {
+	mock := &MockITrashInteractor{ctrl: ctrl}
+	mock.recorder = &MockITrashInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockITrashInteractor) EXPECT() *MockITrashInteractorMockRecorder {
+	return m.recorder
+}
+
+// PurgeExpired mocks base method.
+func (m *MockITrashInteractor) PurgeExpired(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockITrashInteractorMockRecorder) PurgeExpired(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockITrashInteractor)(nil).PurgeExpired), ctx)
+}
@@ -42,6 +42,35 @@ func (m *MockIUserInteractor) EXPECT() *MockIUserInteractorMockRecorder {
 	return m.recorder
 }
 
+// ChangePassword mocks base method.
+func (m *MockIUserInteractor) ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockIUserInteractorMockRecorder) ChangePassword(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockIUserInteractor)(nil).ChangePassword), ctx, in)
+}
+
+// ConfirmEmailChange mocks base method.
+func (m *MockIUserInteractor) ConfirmEmailChange(ctx context.Context, in *input.ConfirmEmailChangeInput) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, in)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange.
+func (mr *MockIUserInteractorMockRecorder) ConfirmEmailChange(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockIUserInteractor)(nil).ConfirmEmailChange), ctx, in)
+}
+
 // GetMe mocks base method.
 func (m *MockIUserInteractor) GetMe(ctx context.Context, userID string) (*output.UserOutput, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +86,63 @@ func (mr *MockIUserInteractorMockRecorder) GetMe(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockIUserInteractor)(nil).GetMe), ctx, userID)
 }
 
+// RequestEmailChange mocks base method.
+func (m *MockIUserInteractor) RequestEmailChange(ctx context.Context, in *input.RequestEmailChangeInput) (*output.UserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailChange", ctx, in)
+	ret0, _ := ret[0].(*output.UserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestEmailChange indicates an expected call of RequestEmailChange.
+func (mr *MockIUserInteractorMockRecorder) RequestEmailChange(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailChange", reflect.TypeOf((*MockIUserInteractor)(nil).RequestEmailChange), ctx, in)
+}
+
+// SetPasswordPolicy mocks base method.
+func (m *MockIUserInteractor) SetPasswordPolicy(ctx context.Context, in *input.SetPasswordPolicyInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPasswordPolicy", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPasswordPolicy indicates an expected call of SetPasswordPolicy.
+func (mr *MockIUserInteractorMockRecorder) SetPasswordPolicy(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPasswordPolicy", reflect.TypeOf((*MockIUserInteractor)(nil).SetPasswordPolicy), ctx, in)
+}
+
+// SetRateLimit mocks base method.
+func (m *MockIUserInteractor) SetRateLimit(ctx context.Context, in *input.SetRateLimitInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRateLimit", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRateLimit indicates an expected call of SetRateLimit.
+func (mr *MockIUserInteractorMockRecorder) SetRateLimit(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRateLimit", reflect.TypeOf((*MockIUserInteractor)(nil).SetRateLimit), ctx, in)
+}
+
+// SetTrashRetention mocks base method.
+func (m *MockIUserInteractor) SetTrashRetention(ctx context.Context, in *input.SetTrashRetentionInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrashRetention", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrashRetention indicates an expected call of SetTrashRetention.
+func (mr *MockIUserInteractorMockRecorder) SetTrashRetention(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrashRetention", reflect.TypeOf((*MockIUserInteractor)(nil).SetTrashRetention), ctx, in)
+}
+
 // UpdateMe mocks base method.
 func (m *MockIUserInteractor) UpdateMe(ctx context.Context, in *input.UpdateUserInput) (*output.UserOutput, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,337 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/url"
+	"slices"
+	"strings"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/oidc"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// oidcRoles are the roles a config may give to new users
+var oidcRoles = []string{"member", "admin"}
+
+type IOIDCInteractor interface {
+	// Start returns the provider URL to send the user to, and the state to keep until the callback
+	Start(ctx context.Context, in *input.OIDCStartInput) (*output.OIDCStartOutput, error)
+	// Callback signs the user in, creating them on their first sign-in. The provider
+	// authenticates the user, so local two-factor authentication is not asked for
+	Callback(ctx context.Context, in *input.OIDCCallbackInput) (*output.AuthOutput, error)
+	// GetConfig, SaveConfig and DeleteConfig manage the tenant's provider (tenant admins only)
+	GetConfig(ctx context.Context, in *input.OIDCConfigInput) (*output.OIDCConfigOutput, error)
+	SaveConfig(ctx context.Context, in *input.SaveOIDCConfigInput) (*output.OIDCConfigOutput, error)
+	DeleteConfig(ctx context.Context, in *input.OIDCConfigInput) error
+}
+
+type OIDCInteractor struct {
+	authRepo       repository.IAuthRepository
+	oidcConfigRepo repository.IOIDCConfigRepository
+	oidcClient     oidc.IClient
+	sessionRepo    repository.ISessionRepository
+	jwtService     *pkg.JWTService
+	uuidGen        pkg.IUUIDGenerator
+	clock          pkg.IClock
+	// redirectBaseURL is the public URL of this API, which callback paths are appended to
+	redirectBaseURL string
+}
+
+func NewOIDCInteractor(
+	authRepo repository.IAuthRepository,
+	oidcConfigRepo repository.IOIDCConfigRepository,
+	oidcClient oidc.IClient,
+	sessionRepo repository.ISessionRepository,
+	jwtService *pkg.JWTService,
+	uuidGen pkg.IUUIDGenerator,
+	clock pkg.IClock,
+	redirectBaseURL string,
+) IOIDCInteractor {
+	return &OIDCInteractor{
+		authRepo:        authRepo,
+		oidcConfigRepo:  oidcConfigRepo,
+		oidcClient:      oidcClient,
+		sessionRepo:     sessionRepo,
+		jwtService:      jwtService,
+		uuidGen:         uuidGen,
+		clock:           clock,
+		redirectBaseURL: strings.TrimSuffix(redirectBaseURL, "/"),
+	}
+}
+
+func (i *OIDCInteractor) Start(ctx context.Context, in *input.OIDCStartInput) (*output.OIDCStartOutput, error) {
+	tenant, config, err := i.findConfig(ctx, in.TenantSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := oidc.GenerateState()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate state", err)
+	}
+	nonce, err := oidc.GenerateState()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate nonce", err)
+	}
+	verifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate code verifier", err)
+	}
+
+	authURL, err := i.oidcClient.AuthCodeURL(ctx, i.clientConfig(tenant, config), state, nonce, verifier)
+	if err != nil {
+		return nil, cerror.NewBadGateway("failed to reach the identity provider", err)
+	}
+
+	stateToken, err := i.jwtService.GenerateOIDCStateToken(tenant.ID, state, nonce, verifier)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate state token", err)
+	}
+
+	return &output.OIDCStartOutput{
+		AuthURL:    authURL,
+		StateToken: stateToken,
+	}, nil
+}
+
+func (i *OIDCInteractor) Callback(ctx context.Context, in *input.OIDCCallbackInput) (*output.AuthOutput, error) {
+	if in.Error != "" {
+		return nil, cerror.NewUnauthorized("the identity provider refused the sign-in: "+in.Error, nil)
+	}
+
+	state, err := i.jwtService.ValidateOIDCStateToken(in.StateToken)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("sign-in expired, please start again", err)
+	}
+
+	tenant, config, err := i.findConfig(ctx, in.TenantSlug)
+	if err != nil {
+		return nil, err
+	}
+	// The state ties the callback to the browser that started the sign-in
+	if state.TenantID != tenant.ID || subtle.ConstantTimeCompare([]byte(state.State), []byte(in.State)) != 1 {
+		return nil, cerror.NewUnauthorized("sign-in state does not match", nil)
+	}
+	if in.Code == "" {
+		return nil, cerror.NewBadRequest("code is required", nil)
+	}
+
+	identity, err := i.oidcClient.Exchange(ctx, i.clientConfig(tenant, config), in.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return nil, cerror.NewUnauthorized("sign-in could not be verified", err)
+	}
+	if identity.Email == "" {
+		return nil, cerror.NewUnauthorized("the identity provider did not return an email", nil)
+	}
+	if identity.EmailVerified != nil && !*identity.EmailVerified {
+		return nil, cerror.NewUnauthorized("email is not verified by the identity provider", nil)
+	}
+	if !slices.Contains(config.AllowedDomains, emailDomain(identity.Email)) {
+		return nil, cerror.NewForbidden("email domain is not allowed to sign in", nil)
+	}
+
+	user, _ := i.authRepo.FindUserByEmail(ctx, tenant.ID, identity.Email)
+	if user == nil {
+		if user, err = i.provisionUser(ctx, tenant, config, identity); err != nil {
+			return nil, err
+		}
+	} else if identity.EmailVerified == nil || !*identity.EmailVerified {
+		// An existing account is only linked by email when the provider vouches for the address.
+		// Otherwise anyone who can set that email at the provider could take the account over
+		return nil, cerror.NewUnauthorized("the identity provider must verify the email to sign in to an existing account", nil)
+	}
+
+	return startSession(ctx, i.sessionRepo, i.jwtService, i.uuidGen, user, sessionClient{
+		IPAddress: in.IPAddress,
+		UserAgent: in.UserAgent,
+	}, i.clock.Now())
+}
+
+// provisionUser creates a user on their first sign-in. They have no usable password
+func (i *OIDCInteractor) provisionUser(ctx context.Context, tenant *model.Tenant, config *model.OIDCConfig, identity *oidc.Identity) (*model.User, error) {
+	randomPassword, err := generateVerificationToken()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate password", err)
+	}
+	passwordHash, err := pkg.HashPassword(randomPassword)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to hash password", err)
+	}
+
+	name := identity.Name
+	if name == "" {
+		name = identity.Email
+	}
+
+	user, err := i.authRepo.CreateUser(ctx, &model.User{
+		ID:            i.uuidGen.Generate(),
+		TenantID:      tenant.ID,
+		Email:         identity.Email,
+		PasswordHash:  passwordHash,
+		Name:          name,
+		Role:          config.DefaultRole,
+		EmailVerified: true,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create user", err)
+	}
+	return user, nil
+}
+
+func (i *OIDCInteractor) GetConfig(ctx context.Context, in *input.OIDCConfigInput) (*output.OIDCConfigOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can manage single sign-on", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return nil, cerror.NewNotFound("tenant not found", err)
+	}
+	config, err := i.oidcConfigRepo.FindByTenantID(ctx, in.TenantID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get single sign-on config", err)
+	}
+	if config == nil {
+		return nil, cerror.NewNotFound("single sign-on is not configured", nil)
+	}
+
+	return i.toConfigOutput(tenant, config), nil
+}
+
+func (i *OIDCInteractor) SaveConfig(ctx context.Context, in *input.SaveOIDCConfigInput) (*output.OIDCConfigOutput, error) {
+	if in.Role != "admin" {
+		return nil, cerror.NewForbidden("only tenant admins can manage single sign-on", nil)
+	}
+
+	issuer, err := url.Parse(in.Issuer)
+	if err != nil || (issuer.Scheme != "https" && issuer.Scheme != "http") || issuer.Host == "" {
+		return nil, cerror.NewBadRequest("issuer must be an http(s) URL", err)
+	}
+	if in.ClientID == "" {
+		return nil, cerror.NewBadRequest("client_id is required", nil)
+	}
+	domains := normalizeDomains(in.AllowedDomains)
+	if len(domains) == 0 {
+		return nil, cerror.NewBadRequest("allowed_domains must list at least one domain", nil)
+	}
+	role := in.DefaultRole
+	if role == "" {
+		role = "member"
+	}
+	if !slices.Contains(oidcRoles, role) {
+		return nil, cerror.NewBadRequest("default_role must be member or admin", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return nil, cerror.NewNotFound("tenant not found", err)
+	}
+	existing, err := i.oidcConfigRepo.FindByTenantID(ctx, in.TenantID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get single sign-on config", err)
+	}
+	secret := in.ClientSecret
+	if secret == "" && existing != nil {
+		secret = existing.ClientSecret
+	}
+	if secret == "" {
+		return nil, cerror.NewBadRequest("client_secret is required", nil)
+	}
+
+	// Catch a wrong issuer now rather than at the first sign-in
+	if _, err := i.oidcClient.Discover(ctx, in.Issuer); err != nil {
+		return nil, cerror.NewBadRequest("issuer does not serve OpenID Connect discovery", err)
+	}
+
+	saved, err := i.oidcConfigRepo.Save(ctx, &model.OIDCConfig{
+		TenantID:       in.TenantID,
+		Issuer:         in.Issuer,
+		ClientID:       in.ClientID,
+		ClientSecret:   secret,
+		AllowedDomains: domains,
+		DefaultRole:    role,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to save single sign-on config", err)
+	}
+
+	return i.toConfigOutput(tenant, saved), nil
+}
+
+func (i *OIDCInteractor) DeleteConfig(ctx context.Context, in *input.OIDCConfigInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can manage single sign-on", nil)
+	}
+
+	if err := i.oidcConfigRepo.Delete(ctx, in.TenantID); err != nil {
+		return cerror.NewInternalServerError("failed to delete single sign-on config", err)
+	}
+	return nil
+}
+
+// findConfig answers the same for unknown tenants and tenants without a config
+func (i *OIDCInteractor) findConfig(ctx context.Context, slug string) (*model.Tenant, *model.OIDCConfig, error) {
+	tenant, err := i.authRepo.FindTenantBySlug(ctx, slug)
+	if err != nil {
+		return nil, nil, cerror.NewNotFound("single sign-on is not configured", nil)
+	}
+	config, err := i.oidcConfigRepo.FindByTenantID(ctx, tenant.ID)
+	if err != nil {
+		return nil, nil, cerror.NewInternalServerError("failed to get single sign-on config", err)
+	}
+	if config == nil {
+		return nil, nil, cerror.NewNotFound("single sign-on is not configured", nil)
+	}
+	return tenant, config, nil
+}
+
+func (i *OIDCInteractor) clientConfig(tenant *model.Tenant, config *model.OIDCConfig) *oidc.Config {
+	return &oidc.Config{
+		Issuer:       config.Issuer,
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		RedirectURL:  i.redirectURL(tenant),
+	}
+}
+
+func (i *OIDCInteractor) redirectURL(tenant *model.Tenant) string {
+	return i.redirectBaseURL + "/auth/oidc/" + url.PathEscape(tenant.Slug) + "/callback"
+}
+
+func (i *OIDCInteractor) toConfigOutput(tenant *model.Tenant, config *model.OIDCConfig) *output.OIDCConfigOutput {
+	return &output.OIDCConfigOutput{
+		Issuer:         config.Issuer,
+		ClientID:       config.ClientID,
+		AllowedDomains: config.AllowedDomains,
+		DefaultRole:    config.DefaultRole,
+		RedirectURL:    i.redirectURL(tenant),
+		UpdatedAt:      config.UpdatedAt,
+	}
+}
+
+// normalizeDomains lowercases the domains, drops a leading @ and removes duplicates
+func normalizeDomains(domains []string) []string {
+	result := make([]string, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if d != "" && !slices.Contains(result, d) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+func emailDomain(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return ""
+	}
+	return strings.ToLower(email[at+1:])
+}
@@ -0,0 +1,504 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/oidc"
+	mock_oidc "good-todo-go/internal/pkg/oidc/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type oidcMocks struct {
+	authRepo       *mock_repository.MockIAuthRepository
+	oidcConfigRepo *mock_repository.MockIOIDCConfigRepository
+	oidcClient     *mock_oidc.MockIClient
+	sessionRepo    *mock_repository.MockISessionRepository
+	uuidGen        *mock_pkg.MockIUUIDGenerator
+}
+
+func newOIDCTestInteractor(t *testing.T, jwtService *pkg.JWTService) (IOIDCInteractor, oidcMocks) {
+	ctrl := gomock.NewController(t)
+	m := oidcMocks{
+		authRepo:       mock_repository.NewMockIAuthRepository(ctrl),
+		oidcConfigRepo: mock_repository.NewMockIOIDCConfigRepository(ctrl),
+		oidcClient:     mock_oidc.NewMockIClient(ctrl),
+		sessionRepo:    mock_repository.NewMockISessionRepository(ctrl),
+		uuidGen:        mock_pkg.NewMockIUUIDGenerator(ctrl),
+	}
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)).AnyTimes()
+	interactor := NewOIDCInteractor(m.authRepo, m.oidcConfigRepo, m.oidcClient, m.sessionRepo, jwtService, m.uuidGen, clock, "https://api.example.com/")
+	return interactor, m
+}
+
+func testOIDCConfig() *model.OIDCConfig {
+	return &model.OIDCConfig{
+		ID:             "config-id",
+		TenantID:       "tenant-id",
+		Issuer:         "https://idp.example.com",
+		ClientID:       "client-id",
+		ClientSecret:   "client-secret",
+		AllowedDomains: []string{"example.com"},
+		DefaultRole:    "member",
+	}
+}
+
+func TestOIDCInteractor_Start(t *testing.T) {
+	t.Parallel()
+
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "acme"}
+	jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+
+	tests := []struct {
+		name       string
+		setupMocks func(m oidcMocks)
+		wantErr    bool
+		wantCode   cerror.ErrorCode
+	}{
+		{
+			name: "success",
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "acme").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(testOIDCConfig(), nil)
+				m.oidcClient.EXPECT().
+					AuthCodeURL(gomock.Any(), &oidc.Config{
+						Issuer:       "https://idp.example.com",
+						ClientID:     "client-id",
+						ClientSecret: "client-secret",
+						RedirectURL:  "https://api.example.com/auth/oidc/acme/callback",
+					}, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("https://idp.example.com/authorize?client_id=client-id", nil)
+			},
+		},
+		{
+			name: "fail - tenant has no config",
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "acme").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(nil, nil)
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeNotFound,
+		},
+		{
+			name: "fail - unknown tenant looks the same",
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "acme").Return(nil, errors.New("not found"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeNotFound,
+		},
+		{
+			name: "fail - provider unreachable",
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "acme").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(testOIDCConfig(), nil)
+				m.oidcClient.EXPECT().
+					AuthCodeURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("connection refused"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeBadGateway,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newOIDCTestInteractor(t, jwtService)
+			tt.setupMocks(m)
+
+			out, err := interactor.Start(context.Background(), &input.OIDCStartInput{TenantSlug: "acme"})
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "https://idp.example.com/authorize?client_id=client-id", out.AuthURL)
+
+			state, err := jwtService.ValidateOIDCStateToken(out.StateToken)
+			require.NoError(t, err)
+			assert.Equal(t, "tenant-id", state.TenantID)
+			assert.NotEmpty(t, state.State)
+			assert.NotEmpty(t, state.Nonce)
+			assert.NotEmpty(t, state.CodeVerifier)
+		})
+	}
+}
+
+func TestOIDCInteractor_Callback(t *testing.T) {
+	t.Parallel()
+
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "acme"}
+	jwtService := pkg.NewJWTService("test-secret", 3600, 86400)
+	stateToken, err := jwtService.GenerateOIDCStateToken("tenant-id", "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+	otherTenantState, err := jwtService.GenerateOIDCStateToken("other-tenant-id", "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+
+	verified := true
+	unverified := false
+	identity := func(email string, emailVerified *bool) *oidc.Identity {
+		return &oidc.Identity{Subject: "sub-1", Email: email, EmailVerified: emailVerified, Name: "Alice"}
+	}
+	expectConfig := func(m oidcMocks) {
+		m.authRepo.EXPECT().FindTenantBySlug(gomock.Any(), "acme").Return(tenant, nil)
+		m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(testOIDCConfig(), nil)
+	}
+	validInput := func() *input.OIDCCallbackInput {
+		return &input.OIDCCallbackInput{TenantSlug: "acme", Code: "code-1", State: "state-1", StateToken: stateToken, IPAddress: "192.0.2.1"}
+	}
+	expectSession := func(m oidcMocks) {
+		m.uuidGen.EXPECT().Generate().Return("session-id")
+		m.sessionRepo.EXPECT().
+			Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, s *model.Session) error {
+				assert.Equal(t, "session-id", s.ID)
+				assert.Equal(t, "192.0.2.1", s.IPAddress)
+				return nil
+			})
+	}
+
+	tests := []struct {
+		name       string
+		input      *input.OIDCCallbackInput
+		setupMocks func(m oidcMocks)
+		wantErr    bool
+		wantCode   cerror.ErrorCode
+		wantUserID string
+	}{
+		{
+			name:  "success - existing user",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), "code-1", "verifier-1", "nonce-1").
+					Return(identity("alice@example.com", &verified), nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "alice@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "alice@example.com", Role: "admin"}, nil)
+				expectSession(m)
+			},
+			wantUserID: "user-id",
+		},
+		{
+			name:  "success - first sign-in creates the user with the default role",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), "code-1", "verifier-1", "nonce-1").
+					Return(identity("Alice@Example.com", nil), nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "Alice@Example.com").
+					Return(nil, errors.New("not found"))
+				m.uuidGen.EXPECT().Generate().Return("new-user-id")
+				m.authRepo.EXPECT().
+					CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, "tenant-id", u.TenantID)
+						assert.Equal(t, "Alice", u.Name)
+						assert.Equal(t, "member", u.Role)
+						assert.True(t, u.EmailVerified)
+						assert.NotEmpty(t, u.PasswordHash)
+						return u, nil
+					})
+				expectSession(m)
+			},
+			wantUserID: "new-user-id",
+		},
+		{
+			name:       "fail - provider refused the sign-in",
+			input:      &input.OIDCCallbackInput{TenantSlug: "acme", Error: "access_denied", StateToken: stateToken},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:       "fail - no state cookie",
+			input:      &input.OIDCCallbackInput{TenantSlug: "acme", Code: "code-1", State: "state-1"},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:       "fail - state does not match",
+			input:      &input.OIDCCallbackInput{TenantSlug: "acme", Code: "code-1", State: "state-2", StateToken: stateToken},
+			setupMocks: expectConfig,
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:       "fail - state was started for another tenant",
+			input:      &input.OIDCCallbackInput{TenantSlug: "acme", Code: "code-1", State: "state-1", StateToken: otherTenantState},
+			setupMocks: expectConfig,
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:  "fail - code exchange fails",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("invalid_grant"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:  "fail - email not verified",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(identity("alice@example.com", &unverified), nil)
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:  "fail - missing email_verified claim cannot sign in to an existing user",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(identity("alice@example.com", nil), nil)
+				m.authRepo.EXPECT().
+					FindUserByEmail(gomock.Any(), "tenant-id", "alice@example.com").
+					Return(&model.User{ID: "user-id", TenantID: "tenant-id", Email: "alice@example.com", Role: "admin"}, nil)
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeUnauthorized,
+		},
+		{
+			name:  "fail - email domain not allowed",
+			input: validInput(),
+			setupMocks: func(m oidcMocks) {
+				expectConfig(m)
+				m.oidcClient.EXPECT().
+					Exchange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(identity("mallory@example.com.evil.test", &verified), nil)
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newOIDCTestInteractor(t, jwtService)
+			tt.setupMocks(m)
+
+			out, err := interactor.Callback(context.Background(), tt.input)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUserID, out.User.ID)
+
+			claims, err := jwtService.ValidateToken(out.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, pkg.AccessToken, claims.TokenType)
+			assert.Equal(t, "tenant-id", claims.TenantID)
+			assert.Equal(t, "session-id", claims.SessionID)
+		})
+	}
+}
+
+func TestOIDCInteractor_SaveConfig(t *testing.T) {
+	t.Parallel()
+
+	tenant := &model.Tenant{ID: "tenant-id", Slug: "acme"}
+	validInput := func() *input.SaveOIDCConfigInput {
+		return &input.SaveOIDCConfigInput{
+			Role:           "admin",
+			TenantID:       "tenant-id",
+			Issuer:         "https://idp.example.com",
+			ClientID:       "client-id",
+			ClientSecret:   "client-secret",
+			AllowedDomains: []string{" Example.com ", "@example.com", "sub.example.com"},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		input      func() *input.SaveOIDCConfigInput
+		setupMocks func(m oidcMocks)
+		wantErr    bool
+		wantCode   cerror.ErrorCode
+	}{
+		{
+			name:  "success - domains are normalized and the role defaults to member",
+			input: validInput,
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(nil, nil)
+				m.oidcClient.EXPECT().Discover(gomock.Any(), "https://idp.example.com").Return(&oidc.Provider{}, nil)
+				m.oidcConfigRepo.EXPECT().
+					Save(gomock.Any(), &model.OIDCConfig{
+						TenantID:       "tenant-id",
+						Issuer:         "https://idp.example.com",
+						ClientID:       "client-id",
+						ClientSecret:   "client-secret",
+						AllowedDomains: []string{"example.com", "sub.example.com"},
+						DefaultRole:    "member",
+					}).
+					DoAndReturn(func(_ context.Context, c *model.OIDCConfig) (*model.OIDCConfig, error) {
+						return c, nil
+					})
+			},
+		},
+		{
+			name: "success - the current secret is kept when none is given",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.ClientSecret = ""
+				return in
+			},
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(testOIDCConfig(), nil)
+				m.oidcClient.EXPECT().Discover(gomock.Any(), gomock.Any()).Return(&oidc.Provider{}, nil)
+				m.oidcConfigRepo.EXPECT().
+					Save(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *model.OIDCConfig) (*model.OIDCConfig, error) {
+						assert.Equal(t, "client-secret", c.ClientSecret)
+						return c, nil
+					})
+			},
+		},
+		{
+			name: "fail - no secret for a new config",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.ClientSecret = ""
+				return in
+			},
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(nil, nil)
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeBadRequest,
+		},
+		{
+			name: "fail - not an admin",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.Role = "member"
+				return in
+			},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeForbidden,
+		},
+		{
+			name: "fail - issuer is not a URL",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.Issuer = "idp.example.com"
+				return in
+			},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeBadRequest,
+		},
+		{
+			name: "fail - no allowed domains",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.AllowedDomains = []string{" "}
+				return in
+			},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeBadRequest,
+		},
+		{
+			name: "fail - unknown role",
+			input: func() *input.SaveOIDCConfigInput {
+				in := validInput()
+				in.DefaultRole = "owner"
+				return in
+			},
+			setupMocks: func(oidcMocks) {},
+			wantErr:    true,
+			wantCode:   cerror.ErrCodeBadRequest,
+		},
+		{
+			name:  "fail - issuer does not serve discovery",
+			input: validInput,
+			setupMocks: func(m oidcMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(tenant, nil)
+				m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(nil, nil)
+				m.oidcClient.EXPECT().Discover(gomock.Any(), gomock.Any()).Return(nil, errors.New("status 404"))
+			},
+			wantErr:  true,
+			wantCode: cerror.ErrCodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newOIDCTestInteractor(t, pkg.NewJWTService("test-secret", 3600, 86400))
+			tt.setupMocks(m)
+
+			out, err := interactor.SaveConfig(context.Background(), tt.input())
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "https://api.example.com/auth/oidc/acme/callback", out.RedirectURL)
+		})
+	}
+}
+
+func TestOIDCInteractor_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("fail - not configured", func(t *testing.T) {
+		interactor, m := newOIDCTestInteractor(t, pkg.NewJWTService("test-secret", 3600, 86400))
+		m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+		m.oidcConfigRepo.EXPECT().FindByTenantID(gomock.Any(), "tenant-id").Return(nil, nil)
+
+		_, err := interactor.GetConfig(context.Background(), &input.OIDCConfigInput{Role: "admin", TenantID: "tenant-id"})
+		var appErr *cerror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, cerror.ErrCodeNotFound, appErr.Code)
+	})
+
+	t.Run("fail - not an admin", func(t *testing.T) {
+		interactor, _ := newOIDCTestInteractor(t, pkg.NewJWTService("test-secret", 3600, 86400))
+
+		_, err := interactor.GetConfig(context.Background(), &input.OIDCConfigInput{Role: "member", TenantID: "tenant-id"})
+		var appErr *cerror.AppError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, cerror.ErrCodeForbidden, appErr.Code)
+	})
+}
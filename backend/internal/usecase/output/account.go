@@ -0,0 +1,13 @@
+package output
+
+import "time"
+
+// AccountExportOutput is everything stored about a user, for data-subject requests
+type AccountExportOutput struct {
+	ExportedAt time.Time
+	User       *UserOutput
+	// Todos include the trash
+	Todos                []*TodoOutput
+	Sessions             []*SessionOutput
+	PersonalAccessTokens []*PersonalAccessTokenOutput
+}
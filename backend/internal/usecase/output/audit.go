@@ -0,0 +1,50 @@
+package output
+
+import (
+	"good-todo-go/internal/domain/model"
+)
+
+type AuditEventOutput struct {
+	ID         string
+	ActorID    *string
+	Action     string
+	EntityType string
+	EntityID   string
+	Before     map[string]any
+	After      map[string]any
+	RequestID  *string
+	IP         *string
+	CreatedAt  string
+}
+
+type AuditEventListOutput struct {
+	Events []*AuditEventOutput
+	Total  int
+}
+
+func NewAuditEventOutput(event *model.AuditEvent) *AuditEventOutput {
+	return &AuditEventOutput{
+		ID:         event.ID,
+		ActorID:    event.ActorID,
+		Action:     event.Action,
+		EntityType: event.EntityType,
+		EntityID:   event.EntityID,
+		Before:     event.Before,
+		After:      event.After,
+		RequestID:  event.RequestID,
+		IP:         event.IP,
+		CreatedAt:  event.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func NewAuditEventListOutput(events []*model.AuditEvent, total int) *AuditEventListOutput {
+	outputs := make([]*AuditEventOutput, len(events))
+	for i, e := range events {
+		outputs[i] = NewAuditEventOutput(e)
+	}
+
+	return &AuditEventListOutput{
+		Events: outputs,
+		Total:  total,
+	}
+}
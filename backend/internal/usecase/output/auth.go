@@ -8,6 +8,12 @@ type AuthOutput struct {
 	TokenType    string
 	ExpiresIn    int
 	User         *UserOutput
+	// MFARequired means there are no tokens yet: MFAToken must be sent with a code
+	MFARequired           bool
+	MFAToken              string
+	MFAEnrollmentRequired bool
+	// RecoveryCodes are returned once, when a login completes enrollment
+	RecoveryCodes []string
 }
 
 type UserOutput struct {
@@ -16,6 +22,7 @@ type UserOutput struct {
 	Name          string
 	Role          string
 	EmailVerified bool
+	PendingEmail  *string
 	TenantID      string
 	CreatedAt     string
 	UpdatedAt     string
@@ -28,6 +35,7 @@ func NewUserOutput(user *model.User) *UserOutput {
 		Name:          user.Name,
 		Role:          user.Role,
 		EmailVerified: user.EmailVerified,
+		PendingEmail:  user.PendingEmail,
 		TenantID:      user.TenantID,
 		CreatedAt:     user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:     user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -0,0 +1,19 @@
+package output
+
+import "time"
+
+// HealthCheckOutput is the result of one readiness check
+type HealthCheckOutput struct {
+	Name    string
+	OK      bool
+	Latency time.Duration
+	// Error says why the check failed, without internal details
+	Error string
+}
+
+type ReadinessOutput struct {
+	Ready bool
+	// Draining means the server is shutting down; no check was run
+	Draining bool
+	Checks   []*HealthCheckOutput
+}
@@ -0,0 +1,8 @@
+package output
+
+// StoredResponseOutput is the response recorded for an idempotency key
+type StoredResponseOutput struct {
+	StatusCode int
+	Headers    map[string]string
+	Body       []byte
+}
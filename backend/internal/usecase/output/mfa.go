@@ -0,0 +1,11 @@
+package output
+
+type MFAEnrollmentOutput struct {
+	Secret string
+	// URI is the otpauth:// URI to show as a QR code
+	URI string
+}
+
+type MFARecoveryCodesOutput struct {
+	RecoveryCodes []string
+}
@@ -0,0 +1,21 @@
+package output
+
+import "time"
+
+type OIDCStartOutput struct {
+	// AuthURL is the provider page to send the user to
+	AuthURL string
+	// StateToken must be returned with the callback
+	StateToken string
+}
+
+// OIDCConfigOutput leaves out the client secret
+type OIDCConfigOutput struct {
+	Issuer         string
+	ClientID       string
+	AllowedDomains []string
+	DefaultRole    string
+	// RedirectURL is the callback to register with the provider
+	RedirectURL string
+	UpdatedAt   time.Time
+}
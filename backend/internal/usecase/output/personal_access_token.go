@@ -0,0 +1,42 @@
+package output
+
+import (
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type PersonalAccessTokenOutput struct {
+	ID         string
+	Name       string
+	Scopes     []string
+	ExpiresAt  time.Time
+	LastUsedAt *time.Time
+	CreatedAt  time.Time
+}
+
+func NewPersonalAccessTokenOutput(t *model.PersonalAccessToken) *PersonalAccessTokenOutput {
+	return &PersonalAccessTokenOutput{
+		ID:         t.ID,
+		Name:       t.Name,
+		Scopes:     t.Scopes,
+		ExpiresAt:  t.ExpiresAt,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+// CreatedPersonalAccessTokenOutput is the only time the token itself is returned
+type CreatedPersonalAccessTokenOutput struct {
+	PersonalAccessTokenOutput
+	Token string
+}
+
+// PersonalAccessTokenPrincipal is who a token acts for, as a JWT would say
+type PersonalAccessTokenPrincipal struct {
+	TenantID string
+	UserID   string
+	Email    string
+	Role     string
+	Scopes   []string
+}
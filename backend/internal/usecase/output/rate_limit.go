@@ -0,0 +1,11 @@
+package output
+
+type RateLimitOutput struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// ResetSeconds is how long until the full limit is available again
+	ResetSeconds int
+	// RetryAfterSeconds is set when the request was not allowed
+	RetryAfterSeconds int
+}
@@ -0,0 +1,30 @@
+package output
+
+import (
+	"time"
+
+	"good-todo-go/internal/domain/model"
+)
+
+type SessionOutput struct {
+	ID         string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	// Current is the session of the calling access token
+	Current bool
+}
+
+func NewSessionOutput(s *model.Session, currentSessionID string) *SessionOutput {
+	return &SessionOutput{
+		ID:         s.ID,
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		CreatedAt:  s.CreatedAt,
+		LastUsedAt: s.LastUsedAt,
+		ExpiresAt:  s.ExpiresAt,
+		Current:    s.ID == currentSessionID,
+	}
+}
@@ -17,9 +17,13 @@ type TodoOutput struct {
 	Completed   bool
 	IsPublic    bool
 	DueDate     *string
+	DeletedAt   *string
+	Version     int
 	CreatedBy   *TodoCreatorOutput
 	CreatedAt   string
 	UpdatedAt   string
+	// JustCompleted is set by updates that moved the todo from open to completed
+	JustCompleted bool
 }
 
 type TodoListOutput struct {
@@ -34,6 +38,12 @@ func NewTodoOutput(todo *model.Todo) *TodoOutput {
 		dueDate = &formatted
 	}
 
+	var deletedAt *string
+	if todo.DeletedAt != nil {
+		formatted := todo.DeletedAt.Format("2006-01-02T15:04:05Z07:00")
+		deletedAt = &formatted
+	}
+
 	return &TodoOutput{
 		ID:          todo.ID,
 		UserID:      todo.UserID,
@@ -42,6 +52,8 @@ func NewTodoOutput(todo *model.Todo) *TodoOutput {
 		Completed:   todo.Completed,
 		IsPublic:    todo.IsPublic,
 		DueDate:     dueDate,
+		DeletedAt:   deletedAt,
+		Version:     todo.Version,
 		CreatedAt:   todo.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:   todo.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -81,3 +93,17 @@ func NewTodoListOutputWithCreators(todos []*model.Todo, total int, userMap map[s
 		Total: total,
 	}
 }
+
+type BatchTodoResultOutput struct {
+	Index  int
+	Op     string
+	Status int
+	// Todo is nil for operations that leave nothing to return, such as delete
+	Todo         *TodoOutput
+	ErrorCode    string
+	ErrorMessage string
+}
+
+type BatchTodosOutput struct {
+	Results []*BatchTodoResultOutput
+}
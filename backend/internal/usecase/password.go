@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"unicode"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/breach"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+)
+
+// PasswordPolicy checks new passwords against the deployment defaults and the
+// tenant's rules, and hashes them with the configured algorithm
+type PasswordPolicy struct {
+	hasher   *pkg.PasswordHasher
+	breached breach.IChecker
+	// minLength applies to tenants without their own minimum
+	minLength int
+}
+
+func NewPasswordPolicy(hasher *pkg.PasswordHasher, breached breach.IChecker, minLength int) *PasswordPolicy {
+	return &PasswordPolicy{
+		hasher:    hasher,
+		breached:  breached,
+		minLength: minLength,
+	}
+}
+
+// Validate checks a new password given in field; the problem is reported against
+// that field. user is nil when registering
+func (p *PasswordPolicy) Validate(ctx context.Context, tenant *model.Tenant, user *model.User, field, password string) error {
+	problem, err := p.problem(ctx, tenant, user, password)
+	if err != nil {
+		return err
+	}
+	if problem != "" {
+		return cerror.NewValidationError(field+" "+problem, map[string]interface{}{field: problem})
+	}
+	return nil
+}
+
+func (p *PasswordPolicy) problem(ctx context.Context, tenant *model.Tenant, user *model.User, password string) (string, error) {
+	minLength := p.minLength
+	if tenant.PasswordMinLength != nil {
+		minLength = *tenant.PasswordMinLength
+	}
+	if len([]rune(password)) < minLength {
+		return fmt.Sprintf("must be at least %d characters", minLength), nil
+	}
+	if len(password) > input.MaxPasswordBytes {
+		return fmt.Sprintf("must be at most %d bytes", input.MaxPasswordBytes), nil
+	}
+	if characterClasses(password) < tenant.PasswordMinCharacterClasses {
+		return fmt.Sprintf("must contain at least %d of lowercase letters, uppercase letters, digits and symbols", tenant.PasswordMinCharacterClasses), nil
+	}
+	if user != nil && p.reused(tenant, user, password) {
+		return fmt.Sprintf("must differ from the last %d passwords", tenant.PasswordHistory), nil
+	}
+
+	breached, err := p.breached.IsBreached(ctx, password)
+	if err != nil {
+		return "", cerror.NewInternalServerError("failed to check password", err)
+	}
+	if breached {
+		return "has appeared in a data breach, choose another", nil
+	}
+	return "", nil
+}
+
+// SetPassword hashes a validated password for the user and keeps the old hash
+// for as long as the tenant's history needs it
+func (p *PasswordPolicy) SetPassword(tenant *model.Tenant, user *model.User, password string) error {
+	hash, err := p.hasher.Hash(password)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to hash password", err)
+	}
+
+	// The current password counts towards the history, so one fewer is kept
+	keep := tenant.PasswordHistory - 1
+	if keep > 0 && user.PasswordHash != "" {
+		history := append([]string{user.PasswordHash}, user.PasswordHistoryHashes...)
+		if len(history) > keep {
+			history = history[:keep]
+		}
+		user.PasswordHistoryHashes = history
+	} else {
+		user.PasswordHistoryHashes = nil
+	}
+	user.PasswordHash = hash
+	return nil
+}
+
+// Hash hashes a password without applying the policy
+func (p *PasswordPolicy) Hash(password string) (string, error) {
+	return p.hasher.Hash(password)
+}
+
+// NeedsRehash reports a hash made with an older algorithm or cost
+func (p *PasswordPolicy) NeedsRehash(hash string) bool {
+	return p.hasher.NeedsRehash(hash)
+}
+
+// reused reports whether the password is the current one or in the history
+func (p *PasswordPolicy) reused(tenant *model.Tenant, user *model.User, password string) bool {
+	if tenant.PasswordHistory == 0 {
+		return false
+	}
+	hashes := append([]string{user.PasswordHash}, user.PasswordHistoryHashes...)
+	if len(hashes) > tenant.PasswordHistory {
+		hashes = hashes[:tenant.PasswordHistory]
+	}
+	for _, hash := range hashes {
+		if pkg.CheckPasswordHash(password, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// characterClasses counts which of lowercase, uppercase, digits and symbols appear
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	count := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/breach"
+	mock_breach "good-todo-go/internal/pkg/breach/mock"
+	"good-todo-go/internal/pkg/cerror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newTestPasswordPolicy hashes with bcrypt at the default cost and has no breach dataset
+func newTestPasswordPolicy(t *testing.T) *PasswordPolicy {
+	t.Helper()
+	hasher, err := pkg.NewPasswordHasher(pkg.PasswordAlgorithmBcrypt, pkg.DefaultCost, pkg.DefaultArgon2Params)
+	require.NoError(t, err)
+	return NewPasswordPolicy(hasher, breach.NewNoopChecker(), 8)
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	oldHash, err := pkg.HashPassword("previous-password")
+	require.NoError(t, err)
+	currentHash, err := pkg.HashPassword("current-password")
+	require.NoError(t, err)
+	user := &model.User{PasswordHash: currentHash, PasswordHistoryHashes: []string{oldHash}}
+	twelve := 12
+
+	tests := []struct {
+		name     string
+		tenant   *model.Tenant
+		user     *model.User
+		password string
+		breached bool
+		wantErr  string
+	}{
+		{name: "default minimum", tenant: &model.Tenant{}, password: "eight-ch"},
+		{name: "too short for the default", tenant: &model.Tenant{}, password: "seven-c", wantErr: "at least 8 characters"},
+		{name: "too short for the tenant", tenant: &model.Tenant{PasswordMinLength: &twelve}, password: "eleven-char", wantErr: "at least 12 characters"},
+		{name: "characters are counted, not bytes", tenant: &model.Tenant{}, password: "パスワードです", wantErr: "at least 8 characters"},
+		{name: "longer than bcrypt reads", tenant: &model.Tenant{}, password: string(make([]byte, 73)), wantErr: "at most 72 bytes"},
+		{name: "enough character classes", tenant: &model.Tenant{PasswordMinCharacterClasses: 3}, password: "Password1"},
+		{name: "too few character classes", tenant: &model.Tenant{PasswordMinCharacterClasses: 3}, password: "password1", wantErr: "at least 3 of"},
+		{name: "current password reused", tenant: &model.Tenant{PasswordHistory: 1}, user: user, password: "current-password", wantErr: "last 1 passwords"},
+		{name: "previous password reused", tenant: &model.Tenant{PasswordHistory: 2}, user: user, password: "previous-password", wantErr: "last 2 passwords"},
+		{name: "previous password outside the history", tenant: &model.Tenant{PasswordHistory: 1}, user: user, password: "previous-password"},
+		{name: "no history policy", tenant: &model.Tenant{}, user: user, password: "current-password"},
+		{name: "breached", tenant: &model.Tenant{}, password: "password123", breached: true, wantErr: "data breach"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			checker := mock_breach.NewMockIChecker(ctrl)
+			checker.EXPECT().IsBreached(gomock.Any(), tt.password).Return(tt.breached, nil).MaxTimes(1)
+			hasher, err := pkg.NewPasswordHasher(pkg.PasswordAlgorithmBcrypt, pkg.DefaultCost, pkg.DefaultArgon2Params)
+			require.NoError(t, err)
+			policy := NewPasswordPolicy(hasher, checker, 8)
+
+			err = policy.Validate(context.Background(), tt.tenant, tt.user, "password", tt.password)
+
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			var appErr *cerror.AppError
+			require.ErrorAs(t, err, &appErr)
+			assert.Equal(t, cerror.ErrCodeValidationError, appErr.Code)
+			assert.Contains(t, appErr.Details["password"], tt.wantErr)
+		})
+	}
+}
+
+func TestPasswordPolicy_Validate_CheckerError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	checker := mock_breach.NewMockIChecker(ctrl)
+	checker.EXPECT().IsBreached(gomock.Any(), gomock.Any()).Return(false, errors.New("disk error"))
+	hasher, err := pkg.NewPasswordHasher(pkg.PasswordAlgorithmBcrypt, pkg.DefaultCost, pkg.DefaultArgon2Params)
+	require.NoError(t, err)
+
+	err = NewPasswordPolicy(hasher, checker, 8).Validate(context.Background(), &model.Tenant{}, nil, "password", "password123")
+
+	var appErr *cerror.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, cerror.ErrCodeInternalServerError, appErr.Code)
+}
+
+func TestPasswordPolicy_SetPassword(t *testing.T) {
+	t.Parallel()
+
+	policy := newTestPasswordPolicy(t)
+
+	t.Run("history keeps the previous passwords the policy needs", func(t *testing.T) {
+		t.Parallel()
+		user := &model.User{PasswordHash: "hash-3", PasswordHistoryHashes: []string{"hash-2", "hash-1"}}
+
+		require.NoError(t, policy.SetPassword(&model.Tenant{PasswordHistory: 3}, user, "new-password"))
+
+		assert.True(t, pkg.CheckPasswordHash("new-password", user.PasswordHash))
+		assert.Equal(t, []string{"hash-3", "hash-2"}, user.PasswordHistoryHashes)
+	})
+
+	t.Run("history is dropped without a policy", func(t *testing.T) {
+		t.Parallel()
+		user := &model.User{PasswordHash: "hash-2", PasswordHistoryHashes: []string{"hash-1"}}
+
+		require.NoError(t, policy.SetPassword(&model.Tenant{}, user, "new-password"))
+
+		assert.Nil(t, user.PasswordHistoryHashes)
+	})
+}
@@ -0,0 +1,218 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/google/uuid"
+)
+
+// PersonalAccessTokenPrefix starts every personal access token, so they can be
+// told apart from JWTs and found by secret scanners. The tenant ID follows it,
+// which lets the token be looked up under row level security
+const PersonalAccessTokenPrefix = "gtd_pat_"
+
+const (
+	defaultTokenExpiresInDays = 30
+	maxTokenExpiresInDays     = 365
+	maxTokenNameLength        = 100
+	maxTokensPerUser          = 50
+	// tokenLastUsedPrecision limits last_used_at writes to one per token per minute
+	tokenLastUsedPrecision = time.Minute
+)
+
+type IPersonalAccessTokenInteractor interface {
+	// Create issues a token; the returned token is not stored and cannot be shown again
+	Create(ctx context.Context, in *input.CreatePersonalAccessTokenInput) (*output.CreatedPersonalAccessTokenOutput, error)
+	List(ctx context.Context, in *input.PersonalAccessTokenUserInput) ([]*output.PersonalAccessTokenOutput, error)
+	Revoke(ctx context.Context, in *input.RevokePersonalAccessTokenInput) error
+	// Authenticate resolves a token to the user it acts for
+	Authenticate(ctx context.Context, token string) (*output.PersonalAccessTokenPrincipal, error)
+}
+
+type PersonalAccessTokenInteractor struct {
+	tokenRepo repository.IPersonalAccessTokenRepository
+	authRepo  repository.IAuthRepository
+	uuidGen   pkg.IUUIDGenerator
+	clock     pkg.IClock
+}
+
+func NewPersonalAccessTokenInteractor(
+	tokenRepo repository.IPersonalAccessTokenRepository,
+	authRepo repository.IAuthRepository,
+	uuidGen pkg.IUUIDGenerator,
+	clock pkg.IClock,
+) IPersonalAccessTokenInteractor {
+	return &PersonalAccessTokenInteractor{
+		tokenRepo: tokenRepo,
+		authRepo:  authRepo,
+		uuidGen:   uuidGen,
+		clock:     clock,
+	}
+}
+
+func (i *PersonalAccessTokenInteractor) Create(ctx context.Context, in *input.CreatePersonalAccessTokenInput) (*output.CreatedPersonalAccessTokenOutput, error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxTokenNameLength {
+		return nil, cerror.NewBadRequest("name must be 1 to 100 characters", nil)
+	}
+	scopes, err := normalizeTokenScopes(in.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	expiresInDays := in.ExpiresInDays
+	if expiresInDays == 0 {
+		expiresInDays = defaultTokenExpiresInDays
+	}
+	if expiresInDays < 1 || expiresInDays > maxTokenExpiresInDays {
+		return nil, cerror.NewBadRequest("expires_in_days must be 1 to 365", nil)
+	}
+
+	existing, err := i.tokenRepo.FindByUserID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get tokens", err)
+	}
+	if len(existing) >= maxTokensPerUser {
+		return nil, cerror.NewConflict("too many tokens; revoke one first", nil)
+	}
+
+	secret, err := generateVerificationToken()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate token", err)
+	}
+	token := PersonalAccessTokenPrefix + in.TenantID + "_" + secret
+
+	now := i.clock.Now()
+	created, err := i.tokenRepo.Create(ctx, &model.PersonalAccessToken{
+		ID:        i.uuidGen.Generate(),
+		TenantID:  in.TenantID,
+		UserID:    in.UserID,
+		Name:      name,
+		TokenHash: hashPersonalAccessToken(token),
+		Scopes:    scopes,
+		ExpiresAt: now.AddDate(0, 0, expiresInDays),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create token", err)
+	}
+
+	return &output.CreatedPersonalAccessTokenOutput{
+		PersonalAccessTokenOutput: *output.NewPersonalAccessTokenOutput(created),
+		Token:                     token,
+	}, nil
+}
+
+func (i *PersonalAccessTokenInteractor) List(ctx context.Context, in *input.PersonalAccessTokenUserInput) ([]*output.PersonalAccessTokenOutput, error) {
+	tokens, err := i.tokenRepo.FindByUserID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get tokens", err)
+	}
+
+	result := make([]*output.PersonalAccessTokenOutput, len(tokens))
+	for idx, t := range tokens {
+		result[idx] = output.NewPersonalAccessTokenOutput(t)
+	}
+	return result, nil
+}
+
+func (i *PersonalAccessTokenInteractor) Revoke(ctx context.Context, in *input.RevokePersonalAccessTokenInput) error {
+	deleted, err := i.tokenRepo.Delete(ctx, in.TenantID, in.UserID, in.TokenID)
+	if err != nil {
+		return cerror.NewInternalServerError("failed to revoke token", err)
+	}
+	if !deleted {
+		return cerror.NewNotFound("token not found", nil)
+	}
+	return nil
+}
+
+func (i *PersonalAccessTokenInteractor) Authenticate(ctx context.Context, token string) (*output.PersonalAccessTokenPrincipal, error) {
+	invalid := cerror.NewUnauthorized("invalid or expired token", nil)
+
+	rest, ok := strings.CutPrefix(token, PersonalAccessTokenPrefix)
+	if !ok {
+		return nil, invalid
+	}
+	tenantID, _, ok := strings.Cut(rest, "_")
+	if !ok || !isCanonicalUUID(tenantID) {
+		// The tenant is used for the RLS context before the token is verified
+		return nil, invalid
+	}
+
+	t, err := i.tokenRepo.FindByHash(ctx, tenantID, hashPersonalAccessToken(token))
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get token", err)
+	}
+	now := i.clock.Now()
+	if t == nil || !now.Before(t.ExpiresAt) {
+		return nil, invalid
+	}
+
+	// The role comes from the user, so a demoted user's tokens lose admin rights
+	user, err := i.authRepo.FindUserByID(ctx, t.TenantID, t.UserID)
+	if err != nil {
+		return nil, invalid
+	}
+
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) >= tokenLastUsedPrecision {
+		// Bookkeeping only; a failed write must not fail the request
+		if err := i.tokenRepo.UpdateLastUsedAt(ctx, t.TenantID, t.ID, now); err != nil {
+			slog.ErrorContext(ctx, "failed to update personal access token last use", "token_id", t.ID, "error", err)
+		}
+	}
+
+	return &output.PersonalAccessTokenPrincipal{
+		TenantID: user.TenantID,
+		UserID:   user.ID,
+		Email:    user.Email,
+		Role:     user.Role,
+		Scopes:   t.Scopes,
+	}, nil
+}
+
+// isCanonicalUUID accepts only the lowercase hyphenated form the tenant IDs are generated in
+func isCanonicalUUID(s string) bool {
+	id, err := uuid.Parse(s)
+	return err == nil && id.String() == s
+}
+
+// normalizeTokenScopes rejects unknown scopes and returns the rest in canonical order
+func normalizeTokenScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, cerror.NewBadRequest("at least one scope is required", nil)
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(model.PersonalAccessTokenScopes, scope) {
+			return nil, cerror.NewBadRequest("unknown scope: "+scope, nil)
+		}
+	}
+
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range model.PersonalAccessTokenScopes {
+		if slices.Contains(scopes, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// hashPersonalAccessToken needs no salt or stretching: tokens are 256 random bits
+func hashPersonalAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPersonalAccessTokenInteractor_Create(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		in         *input.CreatePersonalAccessTokenInput
+		setupMocks func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - token is stored hashed with scopes in canonical order",
+			in: &input.CreatePersonalAccessTokenInput{
+				TenantID: "tenant-id",
+				UserID:   "user-id",
+				Name:     " ci ",
+				Scopes:   []string{model.ScopeWriteTodos, model.ScopeReadTodos, model.ScopeReadTodos},
+			},
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository) {
+				tokenRepo.EXPECT().
+					FindByUserID(gomock.Any(), "tenant-id", "user-id").
+					Return(nil, nil)
+				tokenRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pat *model.PersonalAccessToken) (*model.PersonalAccessToken, error) {
+						assert.Equal(t, "token-id", pat.ID)
+						assert.Equal(t, "ci", pat.Name)
+						assert.Equal(t, []string{model.ScopeReadTodos, model.ScopeWriteTodos}, pat.Scopes)
+						assert.Equal(t, now.AddDate(0, 0, 30), pat.ExpiresAt)
+						assert.Len(t, pat.TokenHash, 64)
+						return pat, nil
+					})
+			},
+		},
+		{
+			name: "fail - unknown scope",
+			in: &input.CreatePersonalAccessTokenInput{
+				TenantID: "tenant-id",
+				UserID:   "user-id",
+				Name:     "ci",
+				Scopes:   []string{"admin:everything"},
+			},
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository) {},
+			wantCode:   cerror.ErrCodeBadRequest,
+			wantErr:    true,
+		},
+		{
+			name: "fail - no scopes",
+			in: &input.CreatePersonalAccessTokenInput{
+				TenantID: "tenant-id",
+				UserID:   "user-id",
+				Name:     "ci",
+			},
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository) {},
+			wantCode:   cerror.ErrCodeBadRequest,
+			wantErr:    true,
+		},
+		{
+			name: "fail - expiry too long",
+			in: &input.CreatePersonalAccessTokenInput{
+				TenantID:      "tenant-id",
+				UserID:        "user-id",
+				Name:          "ci",
+				Scopes:        []string{model.ScopeReadTodos},
+				ExpiresInDays: 366,
+			},
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository) {},
+			wantCode:   cerror.ErrCodeBadRequest,
+			wantErr:    true,
+		},
+		{
+			name: "fail - too many tokens",
+			in: &input.CreatePersonalAccessTokenInput{
+				TenantID: "tenant-id",
+				UserID:   "user-id",
+				Name:     "ci",
+				Scopes:   []string{model.ScopeReadTodos},
+			},
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository) {
+				tokenRepo.EXPECT().
+					FindByUserID(gomock.Any(), "tenant-id", "user-id").
+					Return(make([]*model.PersonalAccessToken, maxTokensPerUser), nil)
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenRepo := mock_repository.NewMockIPersonalAccessTokenRepository(ctrl)
+			uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			uuidGen.EXPECT().Generate().Return("token-id").AnyTimes()
+			clock.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMocks(tokenRepo)
+
+			interactor := NewPersonalAccessTokenInteractor(tokenRepo, mock_repository.NewMockIAuthRepository(ctrl), uuidGen, clock)
+			out, err := interactor.Create(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(out.Token, PersonalAccessTokenPrefix+"tenant-id_"))
+			assert.Equal(t, "token-id", out.ID)
+		})
+	}
+}
+
+func TestPersonalAccessTokenInteractor_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tenantID := "7c1f3f0e-5b1a-4d6e-9c3a-2f8e4b6d1a90"
+	token := PersonalAccessTokenPrefix + tenantID + "_0123456789abcdef"
+	recent := now.Add(-10 * time.Second)
+	stored := func(expiresAt time.Time, lastUsedAt *time.Time) *model.PersonalAccessToken {
+		return &model.PersonalAccessToken{
+			ID:         "token-id",
+			TenantID:   tenantID,
+			UserID:     "user-id",
+			TokenHash:  hashPersonalAccessToken(token),
+			Scopes:     []string{model.ScopeReadTodos},
+			ExpiresAt:  expiresAt,
+			LastUsedAt: lastUsedAt,
+		}
+	}
+	user := &model.User{ID: "user-id", TenantID: tenantID, Email: "test@example.com", Role: "admin"}
+
+	tests := []struct {
+		name       string
+		token      string
+		setupMocks func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository)
+		wantErr    bool
+	}{
+		{
+			name:  "success - last use is recorded",
+			token: token,
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository) {
+				tokenRepo.EXPECT().
+					FindByHash(gomock.Any(), tenantID, hashPersonalAccessToken(token)).
+					Return(stored(now.Add(time.Hour), nil), nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), tenantID, "user-id").Return(user, nil)
+				tokenRepo.EXPECT().UpdateLastUsedAt(gomock.Any(), tenantID, "token-id", now).Return(nil)
+			},
+		},
+		{
+			name:  "success - recent use is not written again",
+			token: token,
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository) {
+				tokenRepo.EXPECT().
+					FindByHash(gomock.Any(), tenantID, hashPersonalAccessToken(token)).
+					Return(stored(now.Add(time.Hour), &recent), nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), tenantID, "user-id").Return(user, nil)
+			},
+		},
+		{
+			name:  "fail - expired",
+			token: token,
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository) {
+				tokenRepo.EXPECT().
+					FindByHash(gomock.Any(), tenantID, hashPersonalAccessToken(token)).
+					Return(stored(now, nil), nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "fail - unknown token",
+			token: token,
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository) {
+				tokenRepo.EXPECT().
+					FindByHash(gomock.Any(), tenantID, hashPersonalAccessToken(token)).
+					Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:  "fail - user no longer exists",
+			token: token,
+			setupMocks: func(tokenRepo *mock_repository.MockIPersonalAccessTokenRepository, authRepo *mock_repository.MockIAuthRepository) {
+				tokenRepo.EXPECT().
+					FindByHash(gomock.Any(), tenantID, hashPersonalAccessToken(token)).
+					Return(stored(now.Add(time.Hour), nil), nil)
+				authRepo.EXPECT().FindUserByID(gomock.Any(), tenantID, "user-id").Return(nil, assert.AnError)
+			},
+			wantErr: true,
+		},
+		{
+			name:       "fail - not a personal access token",
+			token:      "eyJhbGciOiJIUzI1NiJ9.e30.sig",
+			setupMocks: func(*mock_repository.MockIPersonalAccessTokenRepository, *mock_repository.MockIAuthRepository) {},
+			wantErr:    true,
+		},
+		{
+			name:       "fail - no tenant",
+			token:      PersonalAccessTokenPrefix + "0123456789abcdef",
+			setupMocks: func(*mock_repository.MockIPersonalAccessTokenRepository, *mock_repository.MockIAuthRepository) {},
+			wantErr:    true,
+		},
+		{
+			// The tenant segment reaches the database as the RLS tenant, so it must be rejected before any lookup
+			name:       "fail - tenant is not a UUID",
+			token:      PersonalAccessTokenPrefix + "x';DROP TABLE todos;--_0123456789abcdef",
+			setupMocks: func(*mock_repository.MockIPersonalAccessTokenRepository, *mock_repository.MockIAuthRepository) {},
+			wantErr:    true,
+		},
+		{
+			name:       "fail - tenant is not a canonical UUID",
+			token:      PersonalAccessTokenPrefix + "{" + tenantID + "}_0123456789abcdef",
+			setupMocks: func(*mock_repository.MockIPersonalAccessTokenRepository, *mock_repository.MockIAuthRepository) {},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tokenRepo := mock_repository.NewMockIPersonalAccessTokenRepository(ctrl)
+			authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+			clock := mock_pkg.NewMockIClock(ctrl)
+			clock.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMocks(tokenRepo, authRepo)
+
+			interactor := NewPersonalAccessTokenInteractor(tokenRepo, authRepo, mock_pkg.NewMockIUUIDGenerator(ctrl), clock)
+			principal, err := interactor.Authenticate(context.Background(), tt.token)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, cerror.ErrCodeUnauthorized, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "user-id", principal.UserID)
+			assert.Equal(t, tenantID, principal.TenantID)
+			assert.Equal(t, "admin", principal.Role)
+			assert.Equal(t, []string{model.ScopeReadTodos}, principal.Scopes)
+		})
+	}
+}
+
+func TestPersonalAccessTokenInteractor_Revoke(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	tokenRepo := mock_repository.NewMockIPersonalAccessTokenRepository(ctrl)
+	tokenRepo.EXPECT().Delete(gomock.Any(), "tenant-id", "user-id", "token-id").Return(true, nil)
+	tokenRepo.EXPECT().Delete(gomock.Any(), "tenant-id", "user-id", "other-token").Return(false, nil)
+
+	interactor := NewPersonalAccessTokenInteractor(tokenRepo, mock_repository.NewMockIAuthRepository(ctrl), mock_pkg.NewMockIUUIDGenerator(ctrl), mock_pkg.NewMockIClock(ctrl))
+
+	err := interactor.Revoke(context.Background(), &input.RevokePersonalAccessTokenInput{TenantID: "tenant-id", UserID: "user-id", TokenID: "token-id"})
+	assert.NoError(t, err)
+
+	// Another user's token, or one that does not exist
+	err = interactor.Revoke(context.Background(), &input.RevokePersonalAccessTokenInput{TenantID: "tenant-id", UserID: "user-id", TokenID: "other-token"})
+	var appErr *cerror.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, cerror.ErrCodeNotFound, appErr.Code)
+}
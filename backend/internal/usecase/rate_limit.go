@@ -0,0 +1,107 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/ratelimit"
+	"good-todo-go/internal/usecase/output"
+)
+
+// tenantLimitCacheTTL bounds how long a changed tenant limit takes to apply
+const tenantLimitCacheTTL = time.Minute
+
+type IRateLimitInteractor interface {
+	// AllowIP limits unauthenticated auth requests by client IP
+	AllowIP(ctx context.Context, ip string) (*output.RateLimitOutput, error)
+	// AllowUser limits authenticated requests by user, using the tenant's limit
+	AllowUser(ctx context.Context, tenantID, userID string) (*output.RateLimitOutput, error)
+}
+
+type tenantLimit struct {
+	perMinute int
+	fetchedAt time.Time
+}
+
+type RateLimitInteractor struct {
+	store             ratelimit.IStore
+	authRepo          repository.IAuthRepository
+	clock             pkg.IClock
+	ipPerMinute       int
+	userPerMinute     int
+	mu                sync.Mutex
+	tenantLimitsCache map[string]tenantLimit
+}
+
+func NewRateLimitInteractor(
+	store ratelimit.IStore,
+	authRepo repository.IAuthRepository,
+	clock pkg.IClock,
+	ipPerMinute int,
+	userPerMinute int,
+) IRateLimitInteractor {
+	return &RateLimitInteractor{
+		store:             store,
+		authRepo:          authRepo,
+		clock:             clock,
+		ipPerMinute:       ipPerMinute,
+		userPerMinute:     userPerMinute,
+		tenantLimitsCache: make(map[string]tenantLimit),
+	}
+}
+
+func (i *RateLimitInteractor) AllowIP(ctx context.Context, ip string) (*output.RateLimitOutput, error) {
+	return i.take(ctx, "ip:"+ip, i.ipPerMinute)
+}
+
+func (i *RateLimitInteractor) AllowUser(ctx context.Context, tenantID, userID string) (*output.RateLimitOutput, error) {
+	return i.take(ctx, "user:"+tenantID+":"+userID, i.tenantLimit(ctx, tenantID))
+}
+
+func (i *RateLimitInteractor) take(ctx context.Context, key string, perMinute int) (*output.RateLimitOutput, error) {
+	res, err := i.store.Take(ctx, key, ratelimit.PerMinute(perMinute), i.clock.Now())
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to check rate limit", err)
+	}
+
+	return &output.RateLimitOutput{
+		Allowed:           res.Allowed,
+		Limit:             res.Limit,
+		Remaining:         res.Remaining,
+		ResetSeconds:      ceilSeconds(res.ResetAfter),
+		RetryAfterSeconds: ceilSeconds(res.RetryAfter),
+	}, nil
+}
+
+// tenantLimit returns the tenant's per-user limit, or the default
+func (i *RateLimitInteractor) tenantLimit(ctx context.Context, tenantID string) int {
+	now := i.clock.Now()
+
+	i.mu.Lock()
+	cached, ok := i.tenantLimitsCache[tenantID]
+	i.mu.Unlock()
+	if ok && now.Sub(cached.fetchedAt) < tenantLimitCacheTTL {
+		return cached.perMinute
+	}
+
+	perMinute := i.userPerMinute
+	// On lookup errors the default applies, so a database hiccup does not block requests
+	if t, err := i.authRepo.FindTenantByID(ctx, tenantID); err == nil && t.RateLimitPerMinute != nil {
+		perMinute = *t.RateLimitPerMinute
+	}
+
+	i.mu.Lock()
+	i.tenantLimitsCache[tenantID] = tenantLimit{perMinute: perMinute, fetchedAt: now}
+	i.mu.Unlock()
+	return perMinute
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/pkg/ratelimit"
+	mock_ratelimit "good-todo-go/internal/pkg/ratelimit/mock"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRateLimitInteractor_AllowIP(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+	authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+
+	interactor := NewRateLimitInteractor(ratelimit.NewMemoryStore(), authRepo, clock, 2, 300)
+	ctx := context.Background()
+
+	for range 2 {
+		got, err := interactor.AllowIP(ctx, "192.0.2.1")
+		assert.NoError(t, err)
+		assert.True(t, got.Allowed)
+		assert.Equal(t, 2, got.Limit)
+	}
+
+	got, err := interactor.AllowIP(ctx, "192.0.2.1")
+	assert.NoError(t, err)
+	assert.False(t, got.Allowed)
+	assert.Equal(t, 0, got.Remaining)
+	assert.Equal(t, 30, got.RetryAfterSeconds)
+
+	// Another IP has its own bucket
+	got, err = interactor.AllowIP(ctx, "192.0.2.2")
+	assert.NoError(t, err)
+	assert.True(t, got.Allowed)
+}
+
+func TestRateLimitInteractor_AllowUser(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	tenantLimit := 1
+
+	tests := []struct {
+		name     string
+		usecase  func(ctrl *gomock.Controller) IRateLimitInteractor
+		calls    int
+		want     []bool
+		wantCode cerror.ErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "success - tenant override applies and is cached",
+			usecase: func(ctrl *gomock.Controller) IRateLimitInteractor {
+				clock := mock_pkg.NewMockIClock(ctrl)
+				clock.EXPECT().Now().Return(now).AnyTimes()
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-1").
+					Return(&model.Tenant{ID: "tenant-1", RateLimitPerMinute: &tenantLimit}, nil).
+					Times(1)
+
+				return NewRateLimitInteractor(ratelimit.NewMemoryStore(), authRepo, clock, 10, 300)
+			},
+			calls: 2,
+			want:  []bool{true, false},
+		},
+		{
+			name: "success - tenant without override uses the default",
+			usecase: func(ctrl *gomock.Controller) IRateLimitInteractor {
+				clock := mock_pkg.NewMockIClock(ctrl)
+				clock.EXPECT().Now().Return(now).AnyTimes()
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-1").
+					Return(&model.Tenant{ID: "tenant-1"}, nil)
+
+				return NewRateLimitInteractor(ratelimit.NewMemoryStore(), authRepo, clock, 10, 2)
+			},
+			calls: 3,
+			want:  []bool{true, true, false},
+		},
+		{
+			name: "success - tenant lookup error falls back to the default",
+			usecase: func(ctrl *gomock.Controller) IRateLimitInteractor {
+				clock := mock_pkg.NewMockIClock(ctrl)
+				clock.EXPECT().Now().Return(now).AnyTimes()
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-1").
+					Return(nil, errors.New("db error"))
+
+				return NewRateLimitInteractor(ratelimit.NewMemoryStore(), authRepo, clock, 10, 1)
+			},
+			calls: 2,
+			want:  []bool{true, false},
+		},
+		{
+			name: "error - store failure",
+			usecase: func(ctrl *gomock.Controller) IRateLimitInteractor {
+				clock := mock_pkg.NewMockIClock(ctrl)
+				clock.EXPECT().Now().Return(now).AnyTimes()
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				authRepo.EXPECT().
+					FindTenantByID(gomock.Any(), "tenant-1").
+					Return(&model.Tenant{ID: "tenant-1"}, nil)
+				store := mock_ratelimit.NewMockIStore(ctrl)
+				store.EXPECT().
+					Take(gomock.Any(), "user:tenant-1:user-1", ratelimit.PerMinute(300), now).
+					Return(ratelimit.Result{}, errors.New("store down"))
+
+				return NewRateLimitInteractor(store, authRepo, clock, 10, 300)
+			},
+			calls:    1,
+			wantCode: cerror.ErrCodeInternalServerError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			interactor := tt.usecase(ctrl)
+
+			var got []bool
+			for range tt.calls {
+				out, err := interactor.AllowUser(context.Background(), "tenant-1", "user-1")
+				if tt.wantErr {
+					assert.Error(t, err)
+					var appErr *cerror.AppError
+					if assert.ErrorAs(t, err, &appErr) {
+						assert.Equal(t, tt.wantCode, appErr.Code)
+					}
+					return
+				}
+				assert.NoError(t, err)
+				got = append(got, out.Allowed)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+)
+
+// maxUserAgentLength keeps clients from storing arbitrary amounts of text
+const maxUserAgentLength = 512
+
+type ISessionInteractor interface {
+	// List returns the user's active sessions, most recently used first
+	List(ctx context.Context, in *input.SessionUserInput) ([]*output.SessionOutput, error)
+	// Revoke ends one of the user's sessions at its next refresh
+	Revoke(ctx context.Context, in *input.RevokeSessionInput) error
+	// RevokeAll logs the user out everywhere and returns how many sessions were ended
+	RevokeAll(ctx context.Context, in *input.RevokeAllSessionsInput) (int, error)
+	// ForceLogout ends every session of a user in the tenant (tenant admins only)
+	ForceLogout(ctx context.Context, in *input.ForceLogoutInput) (int, error)
+	// IsActive reports whether access tokens of the session may still be used
+	IsActive(ctx context.Context, tenantID, sessionID string) (bool, error)
+	// PurgeInactive deletes the expired and revoked sessions of every tenant
+	PurgeInactive(ctx context.Context) (int, error)
+}
+
+type SessionInteractor struct {
+	sessionRepo repository.ISessionRepository
+	authRepo    repository.IAuthRepository
+	clock       pkg.IClock
+}
+
+func NewSessionInteractor(
+	sessionRepo repository.ISessionRepository,
+	authRepo repository.IAuthRepository,
+	clock pkg.IClock,
+) ISessionInteractor {
+	return &SessionInteractor{
+		sessionRepo: sessionRepo,
+		authRepo:    authRepo,
+		clock:       clock,
+	}
+}
+
+func (i *SessionInteractor) List(ctx context.Context, in *input.SessionUserInput) ([]*output.SessionOutput, error) {
+	sessions, err := i.sessionRepo.FindActiveByUserID(ctx, in.TenantID, in.UserID, i.clock.Now())
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get sessions", err)
+	}
+
+	result := make([]*output.SessionOutput, len(sessions))
+	for idx, s := range sessions {
+		result[idx] = output.NewSessionOutput(s, in.CurrentSessionID)
+	}
+	return result, nil
+}
+
+func (i *SessionInteractor) Revoke(ctx context.Context, in *input.RevokeSessionInput) error {
+	revoked, err := i.sessionRepo.Revoke(ctx, in.TenantID, in.UserID, in.SessionID, i.clock.Now())
+	if err != nil {
+		return cerror.NewInternalServerError("failed to revoke session", err)
+	}
+	if !revoked {
+		return cerror.NewNotFound("session not found", nil)
+	}
+	return nil
+}
+
+func (i *SessionInteractor) RevokeAll(ctx context.Context, in *input.RevokeAllSessionsInput) (int, error) {
+	exceptID := ""
+	if in.ExceptCurrent {
+		if in.CurrentSessionID == "" {
+			return 0, cerror.NewBadRequest("the current token has no session to keep", nil)
+		}
+		exceptID = in.CurrentSessionID
+	}
+
+	n, err := i.sessionRepo.RevokeAllByUserID(ctx, in.TenantID, in.UserID, exceptID, i.clock.Now())
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to revoke sessions", err)
+	}
+	return n, nil
+}
+
+func (i *SessionInteractor) ForceLogout(ctx context.Context, in *input.ForceLogoutInput) (int, error) {
+	if in.Role != "admin" {
+		return 0, cerror.NewForbidden("only tenant admins can log users out", nil)
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return 0, cerror.NewNotFound("user not found", err)
+	}
+
+	n, err := i.sessionRepo.RevokeAllByUserID(ctx, user.TenantID, user.ID, "", i.clock.Now())
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to revoke sessions", err)
+	}
+	return n, nil
+}
+
+func (i *SessionInteractor) IsActive(ctx context.Context, tenantID, sessionID string) (bool, error) {
+	s, err := i.sessionRepo.FindByID(ctx, tenantID, sessionID)
+	if err != nil {
+		return false, cerror.NewInternalServerError("failed to get session", err)
+	}
+	return s != nil && s.Active(i.clock.Now()), nil
+}
+
+func (i *SessionInteractor) PurgeInactive(ctx context.Context) (int, error) {
+	tenants, err := i.authRepo.FindAllTenants(ctx)
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to get tenants", err)
+	}
+
+	now := i.clock.Now()
+	purged := 0
+	for _, t := range tenants {
+		n, err := i.sessionRepo.DeleteInactiveBefore(ctx, t.ID, now)
+		if err != nil {
+			// Keep going so one broken tenant does not block the others
+			slog.ErrorContext(ctx, "failed to purge sessions", "tenant_id", t.ID, "error", err)
+			continue
+		}
+		purged += n
+	}
+
+	return purged, nil
+}
+
+// sessionClient is the device a session is started or refreshed from
+type sessionClient struct {
+	IPAddress string
+	UserAgent string
+}
+
+// startSession records a new session for the user and issues its first token pair
+func startSession(
+	ctx context.Context,
+	sessionRepo repository.ISessionRepository,
+	jwtService *pkg.JWTService,
+	uuidGen pkg.IUUIDGenerator,
+	user *model.User,
+	client sessionClient,
+	now time.Time,
+) (*output.AuthOutput, error) {
+	sessionID := uuidGen.Generate()
+	tokenPair, err := jwtService.GenerateTokenPair(user.ID, user.TenantID, user.Email, user.Role, sessionID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate tokens", err)
+	}
+
+	err = sessionRepo.Create(ctx, &model.Session{
+		ID:               sessionID,
+		TenantID:         user.TenantID,
+		UserID:           user.ID,
+		RefreshTokenHash: hashRefreshToken(tokenPair.RefreshToken),
+		UserAgent:        truncateUserAgent(client.UserAgent),
+		IPAddress:        client.IPAddress,
+		CreatedAt:        now,
+		LastUsedAt:       now,
+		ExpiresAt:        tokenPair.RefreshExpiresAt,
+	})
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to create session", err)
+	}
+
+	return newAuthOutput(tokenPair, user), nil
+}
+
+func newAuthOutput(tokenPair *pkg.TokenPair, user *model.User) *output.AuthOutput {
+	return &output.AuthOutput{
+		AccessToken:  tokenPair.AccessToken,
+		RefreshToken: tokenPair.RefreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    tokenPair.ExpiresIn,
+		User:         output.NewUserOutput(user),
+	}
+}
+
+// hashRefreshToken needs no salt: refresh tokens are signed and carry a random ID
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func truncateUserAgent(ua string) string {
+	runes := []rune(ua)
+	if len(runes) > maxUserAgentLength {
+		return string(runes[:maxUserAgentLength])
+	}
+	return ua
+}
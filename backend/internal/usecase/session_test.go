@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg/cerror"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+	"good-todo-go/internal/usecase/input"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newSessionTestInteractor(t *testing.T, now time.Time) (ISessionInteractor, *mock_repository.MockISessionRepository, *mock_repository.MockIAuthRepository) {
+	ctrl := gomock.NewController(t)
+	sessionRepo := mock_repository.NewMockISessionRepository(ctrl)
+	authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+	return NewSessionInteractor(sessionRepo, authRepo, clock), sessionRepo, authRepo
+}
+
+func TestSessionInteractor_List(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	interactor, sessionRepo, _ := newSessionTestInteractor(t, now)
+	sessionRepo.EXPECT().
+		FindActiveByUserID(gomock.Any(), "tenant-id", "user-id", now).
+		Return([]*model.Session{
+			{ID: "session-1", UserAgent: "phone"},
+			{ID: "session-2", UserAgent: "laptop"},
+		}, nil)
+
+	out, err := interactor.List(context.Background(), &input.SessionUserInput{
+		TenantID:         "tenant-id",
+		UserID:           "user-id",
+		CurrentSessionID: "session-2",
+	})
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	assert.False(t, out[0].Current)
+	assert.True(t, out[1].Current)
+	assert.Equal(t, "laptop", out[1].UserAgent)
+}
+
+func TestSessionInteractor_Revoke(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	interactor, sessionRepo, _ := newSessionTestInteractor(t, now)
+	sessionRepo.EXPECT().Revoke(gomock.Any(), "tenant-id", "user-id", "session-id", now).Return(true, nil)
+	sessionRepo.EXPECT().Revoke(gomock.Any(), "tenant-id", "user-id", "other-session", now).Return(false, nil)
+
+	err := interactor.Revoke(context.Background(), &input.RevokeSessionInput{TenantID: "tenant-id", UserID: "user-id", SessionID: "session-id"})
+	assert.NoError(t, err)
+
+	// Another user's session, or one that has already ended
+	err = interactor.Revoke(context.Background(), &input.RevokeSessionInput{TenantID: "tenant-id", UserID: "user-id", SessionID: "other-session"})
+	var appErr *cerror.AppError
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, cerror.ErrCodeNotFound, appErr.Code)
+}
+
+func TestSessionInteractor_RevokeAll(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		in         *input.RevokeAllSessionsInput
+		setupMocks func(sessionRepo *mock_repository.MockISessionRepository)
+		want       int
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - everywhere",
+			in:   &input.RevokeAllSessionsInput{TenantID: "tenant-id", UserID: "user-id", CurrentSessionID: "current"},
+			setupMocks: func(sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), "tenant-id", "user-id", "", now).Return(3, nil)
+			},
+			want: 3,
+		},
+		{
+			name: "success - except the current session",
+			in:   &input.RevokeAllSessionsInput{TenantID: "tenant-id", UserID: "user-id", CurrentSessionID: "current", ExceptCurrent: true},
+			setupMocks: func(sessionRepo *mock_repository.MockISessionRepository) {
+				sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), "tenant-id", "user-id", "current", now).Return(2, nil)
+			},
+			want: 2,
+		},
+		{
+			name:       "fail - no current session to keep",
+			in:         &input.RevokeAllSessionsInput{TenantID: "tenant-id", UserID: "user-id", ExceptCurrent: true},
+			setupMocks: func(*mock_repository.MockISessionRepository) {},
+			wantCode:   cerror.ErrCodeBadRequest,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, sessionRepo, _ := newSessionTestInteractor(t, now)
+			tt.setupMocks(sessionRepo)
+
+			n, err := interactor.RevokeAll(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, n)
+		})
+	}
+}
+
+func TestSessionInteractor_ForceLogout(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		in         *input.ForceLogoutInput
+		setupMocks func(sessionRepo *mock_repository.MockISessionRepository, authRepo *mock_repository.MockIAuthRepository)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			in:   &input.ForceLogoutInput{Role: "admin", TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(sessionRepo *mock_repository.MockISessionRepository, authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(&model.User{ID: "user-id", TenantID: "tenant-id"}, nil)
+				sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), "tenant-id", "user-id", "", now).Return(1, nil)
+			},
+		},
+		{
+			name:       "fail - not an admin",
+			in:         &input.ForceLogoutInput{Role: "member", TenantID: "tenant-id", UserID: "user-id"},
+			setupMocks: func(*mock_repository.MockISessionRepository, *mock_repository.MockIAuthRepository) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name: "fail - user not in the tenant",
+			in:   &input.ForceLogoutInput{Role: "admin", TenantID: "tenant-id", UserID: "other-user"},
+			setupMocks: func(sessionRepo *mock_repository.MockISessionRepository, authRepo *mock_repository.MockIAuthRepository) {
+				authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "other-user").Return(nil, assert.AnError)
+			},
+			wantCode: cerror.ErrCodeNotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, sessionRepo, authRepo := newSessionTestInteractor(t, now)
+			tt.setupMocks(sessionRepo, authRepo)
+
+			_, err := interactor.ForceLogout(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionInteractor_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	interactor, sessionRepo, _ := newSessionTestInteractor(t, now)
+	sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "active").Return(&model.Session{ExpiresAt: now.Add(time.Hour)}, nil)
+	sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "revoked").Return(&model.Session{ExpiresAt: now.Add(time.Hour), RevokedAt: &now}, nil)
+	sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "expired").Return(&model.Session{ExpiresAt: now}, nil)
+	sessionRepo.EXPECT().FindByID(gomock.Any(), "tenant-id", "deleted").Return(nil, nil)
+
+	for id, want := range map[string]bool{"active": true, "revoked": false, "expired": false, "deleted": false} {
+		active, err := interactor.IsActive(context.Background(), "tenant-id", id)
+		require.NoError(t, err)
+		assert.Equal(t, want, active, id)
+	}
+}
+
+func TestTruncateUserAgent(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "curl/8.0", truncateUserAgent("curl/8.0"))
+	assert.Len(t, []rune(truncateUserAgent(strings.Repeat("あ", 600))), maxUserAgentLength)
+}
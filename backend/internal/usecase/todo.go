@@ -3,6 +3,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
 
 	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
@@ -19,23 +23,33 @@ type ITodoInteractor interface {
 	CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error)
 	UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (*output.TodoOutput, error)
 	DeleteTodo(ctx context.Context, todoID, userID string) error
+	GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error)
+	RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error)
+	PurgeTodo(ctx context.Context, todoID, userID string) error
+	BatchTodos(ctx context.Context, in *input.BatchTodosInput) (*output.BatchTodosOutput, error)
 }
 
+// MaxBatchOperations is the largest number of operations accepted by BatchTodos
+const MaxBatchOperations = 100
+
 type TodoInteractor struct {
-	todoRepo repository.ITodoRepository
-	userRepo repository.IUserRepository
-	uuidGen  pkg.IUUIDGenerator
+	todoRepo  repository.ITodoRepository
+	userRepo  repository.IUserRepository
+	txManager repository.ITransactionManager
+	uuidGen   pkg.IUUIDGenerator
 }
 
 func NewTodoInteractor(
 	todoRepo repository.ITodoRepository,
 	userRepo repository.IUserRepository,
+	txManager repository.ITransactionManager,
 	uuidGen pkg.IUUIDGenerator,
 ) ITodoInteractor {
 	return &TodoInteractor{
-		todoRepo: todoRepo,
-		userRepo: userRepo,
-		uuidGen:  uuidGen,
+		todoRepo:  todoRepo,
+		userRepo:  userRepo,
+		txManager: txManager,
+		uuidGen:   uuidGen,
 	}
 }
 
@@ -118,6 +132,10 @@ func (i *TodoInteractor) GetTodo(ctx context.Context, todoID, userID string) (*o
 }
 
 func (i *TodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (*output.TodoOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
 	todo := &model.Todo{
 		ID:          i.uuidGen.Generate(),
 		UserID:      in.UserID,
@@ -148,28 +166,46 @@ func (i *TodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInp
 		return nil, cerror.NewForbidden("not allowed to update this todo", nil)
 	}
 
-	if in.Title != nil {
-		todo.Title = *in.Title
+	if in.IfMatchVersions != nil && !slices.Contains(in.IfMatchVersions, todo.Version) {
+		return nil, cerror.NewPreconditionFailed("todo has been modified", nil)
+	}
+
+	if err := in.Validate(); err != nil {
+		return nil, err
 	}
-	if in.Description != nil {
-		todo.Description = *in.Description
+
+	wasCompleted := todo.Completed
+	if title, ok := in.Title.Get(); ok {
+		todo.Title = title
+	}
+	if in.Description.IsSet() {
+		todo.Description, _ = in.Description.Get()
 	}
-	if in.Completed != nil {
-		todo.Completed = *in.Completed
+	if completed, ok := in.Completed.Get(); ok {
+		todo.Completed = completed
 	}
-	if in.IsPublic != nil {
-		todo.IsPublic = *in.IsPublic
+	if isPublic, ok := in.IsPublic.Get(); ok {
+		todo.IsPublic = isPublic
 	}
-	if in.DueDate != nil {
-		todo.DueDate = in.DueDate
+	if in.DueDate.IsSet() {
+		todo.DueDate = in.DueDate.Ptr()
 	}
 
 	updated, err := i.todoRepo.Update(ctx, todo)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		// Someone else updated the todo between our read and write
+		if in.IfMatchVersions != nil {
+			return nil, cerror.NewPreconditionFailed("todo has been modified", err)
+		}
+		return nil, cerror.NewConflict("todo was modified concurrently", err)
+	}
 	if err != nil {
 		return nil, cerror.NewInternalServerError("failed to update todo", err)
 	}
 
-	return output.NewTodoOutput(updated), nil
+	out := output.NewTodoOutput(updated)
+	out.JustCompleted = !wasCompleted && updated.Completed
+	return out, nil
 }
 
 func (i *TodoInteractor) DeleteTodo(ctx context.Context, todoID, userID string) error {
@@ -189,3 +225,200 @@ func (i *TodoInteractor) DeleteTodo(ctx context.Context, todoID, userID string)
 
 	return nil
 }
+
+func (i *TodoInteractor) GetTrash(ctx context.Context, in *input.GetTrashInput) (*output.TodoListOutput, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	todos, err := i.todoRepo.FindDeletedByUserID(ctx, in.UserID, limit, in.Offset)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to get trash", err)
+	}
+
+	total, err := i.todoRepo.CountDeletedByUserID(ctx, in.UserID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to count trash", err)
+	}
+
+	return output.NewTodoListOutput(todos, total), nil
+}
+
+func (i *TodoInteractor) RestoreTodo(ctx context.Context, todoID, userID string) (*output.TodoOutput, error) {
+	todo, err := i.todoRepo.FindDeletedByID(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewNotFound("todo not found in trash", err)
+	}
+
+	// Only owner can restore
+	if todo.UserID != userID {
+		return nil, cerror.NewForbidden("not allowed to restore this todo", nil)
+	}
+
+	restored, err := i.todoRepo.Restore(ctx, todoID)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to restore todo", err)
+	}
+
+	return output.NewTodoOutput(restored), nil
+}
+
+func (i *TodoInteractor) PurgeTodo(ctx context.Context, todoID, userID string) error {
+	todo, err := i.todoRepo.FindDeletedByID(ctx, todoID)
+	if err != nil {
+		return cerror.NewNotFound("todo not found in trash", err)
+	}
+
+	// Only owner can purge
+	if todo.UserID != userID {
+		return cerror.NewForbidden("not allowed to purge this todo", nil)
+	}
+
+	if err := i.todoRepo.Purge(ctx, todoID); err != nil {
+		return cerror.NewInternalServerError("failed to purge todo", err)
+	}
+
+	return nil
+}
+
+// BatchTodos runs the operations in one transaction. Each operation goes
+// through the same method (and ownership check) as its single-todo endpoint.
+func (i *TodoInteractor) BatchTodos(ctx context.Context, in *input.BatchTodosInput) (*output.BatchTodosOutput, error) {
+	if len(in.Operations) == 0 {
+		return nil, cerror.NewValidationError("no operations", map[string]interface{}{"operations": "must not be empty"})
+	}
+	if len(in.Operations) > MaxBatchOperations {
+		return nil, cerror.NewValidationError("too many operations", map[string]interface{}{
+			"operations": fmt.Sprintf("must have at most %d items", MaxBatchOperations),
+		})
+	}
+
+	results := make([]*output.BatchTodoResultOutput, len(in.Operations))
+	err := i.txManager.InTx(ctx, func(ctx context.Context) error {
+		for idx, op := range in.Operations {
+			var (
+				todo   *output.TodoOutput
+				status int
+			)
+			apply := func(ctx context.Context) error {
+				var err error
+				todo, status, err = i.applyBatchOperation(ctx, in, op)
+				return err
+			}
+
+			if in.Atomic {
+				if err := apply(ctx); err != nil {
+					return batchOperationError(idx, err)
+				}
+			} else if err := i.txManager.Savepoint(ctx, apply); err != nil {
+				results[idx] = newBatchErrorResult(idx, op.Op, err)
+				continue
+			}
+
+			results[idx] = &output.BatchTodoResultOutput{
+				Index:  idx,
+				Op:     string(op.Op),
+				Status: status,
+				Todo:   todo,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *cerror.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, cerror.NewInternalServerError("failed to run batch", err)
+	}
+
+	return &output.BatchTodosOutput{Results: results}, nil
+}
+
+// applyBatchOperation returns the resulting todo (if any) and the status the
+// single-todo endpoint would have answered with
+func (i *TodoInteractor) applyBatchOperation(ctx context.Context, in *input.BatchTodosInput, op input.BatchTodoOperation) (*output.TodoOutput, int, error) {
+	switch op.Op {
+	case input.BatchTodoOpCreate:
+		if op.Create == nil || op.Create.Title == "" {
+			return nil, 0, cerror.NewValidationError("title is required", map[string]interface{}{"title": "is required"})
+		}
+		create := *op.Create
+		create.UserID = in.UserID
+		create.TenantID = in.TenantID
+		todo, err := i.CreateTodo(ctx, &create)
+		return todo, http.StatusCreated, err
+
+	case input.BatchTodoOpUpdate:
+		if op.Update == nil {
+			return nil, 0, cerror.NewBadRequest("update requires fields to change", nil)
+		}
+		update := *op.Update
+		update.UserID = in.UserID
+		todo, err := i.UpdateTodo(ctx, &update)
+		return todo, http.StatusOK, err
+
+	case input.BatchTodoOpComplete:
+		todo, err := i.UpdateTodo(ctx, &input.UpdateTodoInput{
+			TodoID:          op.TodoID,
+			UserID:          in.UserID,
+			Completed:       input.Some(true),
+			IfMatchVersions: op.IfMatchVersions,
+		})
+		return todo, http.StatusOK, err
+
+	case input.BatchTodoOpDelete:
+		return nil, http.StatusNoContent, i.DeleteTodo(ctx, op.TodoID, in.UserID)
+
+	case input.BatchTodoOpMove:
+		switch op.MoveTo {
+		case input.BatchTodoMoveToTrash:
+			return nil, http.StatusNoContent, i.DeleteTodo(ctx, op.TodoID, in.UserID)
+		case input.BatchTodoMoveToTodos:
+			todo, err := i.RestoreTodo(ctx, op.TodoID, in.UserID)
+			return todo, http.StatusOK, err
+		}
+		return nil, 0, cerror.NewValidationError("invalid move destination", map[string]interface{}{
+			"to": fmt.Sprintf("must be %q or %q", input.BatchTodoMoveToTrash, input.BatchTodoMoveToTodos),
+		})
+	}
+
+	return nil, 0, cerror.NewValidationError("unknown operation", map[string]interface{}{"op": string(op.Op)})
+}
+
+// batchOperationError reports which operation aborted an atomic batch
+func batchOperationError(idx int, err error) error {
+	var appErr *cerror.AppError
+	if !errors.As(err, &appErr) {
+		appErr = cerror.NewInternalServerError("failed to run batch", err)
+	}
+	details := map[string]interface{}{"index": idx}
+	for k, v := range appErr.Details {
+		details[k] = v
+	}
+	return &cerror.AppError{
+		Code:       appErr.Code,
+		Message:    fmt.Sprintf("operation %d: %s", idx, appErr.Message),
+		HTTPStatus: appErr.HTTPStatus,
+		Details:    details,
+		Err:        appErr.Err,
+	}
+}
+
+func newBatchErrorResult(idx int, op input.BatchTodoOp, err error) *output.BatchTodoResultOutput {
+	var appErr *cerror.AppError
+	if !errors.As(err, &appErr) {
+		appErr = cerror.NewInternalServerError("failed to run operation", err)
+	}
+	return &output.BatchTodoResultOutput{
+		Index:        idx,
+		Op:           string(op),
+		Status:       appErr.HTTPStatus,
+		ErrorCode:    string(appErr.Code),
+		ErrorMessage: appErr.Message,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	mock_repository "good-todo-go/internal/domain/repository/mock"
 	"good-todo-go/internal/pkg/cerror"
 	mock_pkg "good-todo-go/internal/pkg/mock"
@@ -424,6 +425,22 @@ func TestTodoInteractor_CreateTodo(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "error - blank title is rejected",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				return &TodoInteractor{
+					todoRepo: mock_repository.NewMockITodoRepository(ctrl),
+					userRepo: mock_repository.NewMockIUserRepository(ctrl),
+					uuidGen:  mock_pkg.NewMockIUUIDGenerator(ctrl),
+				}
+			},
+			input: &input.CreateTodoInput{
+				UserID:   "user-1",
+				TenantID: "tenant-1",
+				Title:    "   ",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -457,10 +474,11 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 	newTitle := "Updated Title"
 
 	tests := []struct {
-		name    string
-		usecase func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
-		input   *input.UpdateTodoInput
-		wantErr bool
+		name     string
+		usecase  func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
+		input    *input.UpdateTodoInput
+		wantCode cerror.ErrorCode
+		wantErr  bool
 	}{
 		{
 			name: "success - owner can update",
@@ -497,10 +515,84 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 			input: &input.UpdateTodoInput{
 				TodoID: "todo-1",
 				UserID: "user-1", // owner
-				Title:  &newTitle,
+				Title:  input.Some(newTitle),
 			},
 			wantErr: false,
 		},
+		{
+			name: "success - null due date clears it",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						Title:     "Original Title",
+						DueDate:   &now,
+						CreatedAt: now,
+						UpdatedAt: now,
+					}, nil)
+
+				todoRepo.EXPECT().
+					Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+						if todo.DueDate != nil {
+							return nil, errors.New("due date was not cleared")
+						}
+						return todo, nil
+					})
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.UpdateTodoInput{
+				TodoID:  "todo-1",
+				UserID:  "user-1",
+				Title:   input.Some(newTitle),
+				DueDate: input.Null[time.Time](),
+			},
+			wantErr: false,
+		},
+		{
+			name: "error - null title is rejected",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						Title:     "Original Title",
+						CreatedAt: now,
+						UpdatedAt: now,
+					}, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.UpdateTodoInput{
+				TodoID: "todo-1",
+				UserID: "user-1",
+				Title:  input.Null[string](),
+			},
+			wantCode: cerror.ErrCodeValidationError,
+			wantErr:  true,
+		},
 		{
 			name: "error - non-owner cannot update",
 			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
@@ -530,7 +622,7 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 			input: &input.UpdateTodoInput{
 				TodoID: "todo-1",
 				UserID: "user-2", // not owner
-				Title:  &newTitle,
+				Title:  input.Some(newTitle),
 			},
 			wantErr: true,
 		},
@@ -554,10 +646,81 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 			input: &input.UpdateTodoInput{
 				TodoID: "todo-not-exist",
 				UserID: "user-1",
-				Title:  &newTitle,
+				Title:  input.Some(newTitle),
 			},
 			wantErr: true,
 		},
+		{
+			name: "error - If-Match does not match current version",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						Title:     "Changed in another tab",
+						Version:   2,
+						CreatedAt: now,
+						UpdatedAt: now,
+					}, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.UpdateTodoInput{
+				TodoID:          "todo-1",
+				UserID:          "user-1",
+				Title:           input.Some(newTitle),
+				IfMatchVersions: []int{1},
+			},
+			wantCode: cerror.ErrCodePreconditionFailed,
+			wantErr:  true,
+		},
+		{
+			name: "error - concurrent update detected by repository",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						Title:     "Original Title",
+						Version:   1,
+						CreatedAt: now,
+						UpdatedAt: now,
+					}, nil)
+
+				todoRepo.EXPECT().
+					Update(ctx, gomock.Any()).
+					Return(nil, repository.ErrVersionConflict)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.UpdateTodoInput{
+				TodoID: "todo-1",
+				UserID: "user-1",
+				Title:  input.Some(newTitle),
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -574,6 +737,12 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 			if tt.wantErr {
 				assert.Error(t, gotErr)
 				assert.Nil(t, gotOutput)
+				if tt.wantCode != "" {
+					var appErr *cerror.AppError
+					if assert.ErrorAs(t, gotErr, &appErr) {
+						assert.Equal(t, tt.wantCode, appErr.Code)
+					}
+				}
 			} else {
 				assert.NoError(t, gotErr)
 				assert.NotNil(t, gotOutput)
@@ -583,6 +752,50 @@ func TestTodoInteractor_UpdateTodo(t *testing.T) {
 	}
 }
 
+func TestTodoInteractor_UpdateTodo_JustCompleted(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		wasCompleted bool
+		completed    bool
+		want         bool
+	}{
+		{name: "open to completed", wasCompleted: false, completed: true, want: true},
+		{name: "already completed", wasCompleted: true, completed: true, want: false},
+		{name: "reopened", wasCompleted: true, completed: false, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+			todoRepo.EXPECT().
+				FindByID(ctx, "todo-1").
+				Return(&model.Todo{ID: "todo-1", UserID: "user-1", Title: "Title", Completed: tt.wasCompleted}, nil)
+			todoRepo.EXPECT().
+				Update(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, todo *model.Todo) (*model.Todo, error) {
+					return todo, nil
+				})
+			interactor := &TodoInteractor{todoRepo: todoRepo}
+
+			gotOutput, gotErr := interactor.UpdateTodo(ctx, &input.UpdateTodoInput{
+				TodoID:    "todo-1",
+				UserID:    "user-1",
+				Completed: input.Some(tt.completed),
+			})
+
+			assert.NoError(t, gotErr)
+			assert.Equal(t, tt.want, gotOutput.JustCompleted)
+		})
+	}
+}
+
 func TestTodoInteractor_DeleteTodo(t *testing.T) {
 	t.Parallel()
 
@@ -680,3 +893,489 @@ func TestTodoInteractor_DeleteTodo(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoInteractor_GetTrash(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		usecase func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
+		input   *input.GetTrashInput
+		wantLen int
+		wantErr bool
+	}{
+		{
+			name: "success - get deleted todos",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByUserID(ctx, "user-1", 20, 0).
+					Return([]*model.Todo{
+						{
+							ID:        "todo-1",
+							UserID:    "user-1",
+							TenantID:  "tenant-1",
+							Title:     "Deleted Todo",
+							DeletedAt: &now,
+							CreatedAt: now,
+							UpdatedAt: now,
+						},
+					}, nil)
+
+				todoRepo.EXPECT().
+					CountDeletedByUserID(ctx, "user-1").
+					Return(1, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.GetTrashInput{
+				UserID: "user-1",
+			},
+			wantLen: 1,
+			wantErr: false,
+		},
+		{
+			name: "error - repository error",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByUserID(ctx, "user-1", 100, 0).
+					Return(nil, errors.New("db error"))
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			input: &input.GetTrashInput{
+				UserID: "user-1",
+				Limit:  500, // clamped to 100
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			gotOutput, gotErr := interactor.GetTrash(ctx, tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+				assert.Nil(t, gotOutput)
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Len(t, gotOutput.Todos, tt.wantLen)
+				assert.NotNil(t, gotOutput.Todos[0].DeletedAt)
+			}
+		})
+	}
+}
+
+func TestTodoInteractor_RestoreTodo(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	deletedTodo := func() *model.Todo {
+		return &model.Todo{
+			ID:        "todo-1",
+			UserID:    "user-1",
+			TenantID:  "tenant-1",
+			Title:     "Deleted Todo",
+			DeletedAt: &now,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		usecase  func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
+		todoID   string
+		userID   string
+		wantCode cerror.ErrorCode
+		wantErr  bool
+	}{
+		{
+			name: "success - owner can restore",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByID(ctx, "todo-1").
+					Return(deletedTodo(), nil)
+
+				restored := deletedTodo()
+				restored.DeletedAt = nil
+				todoRepo.EXPECT().
+					Restore(ctx, "todo-1").
+					Return(restored, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			todoID:  "todo-1",
+			userID:  "user-1",
+			wantErr: false,
+		},
+		{
+			name: "error - non-owner cannot restore",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByID(ctx, "todo-1").
+					Return(deletedTodo(), nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			todoID:   "todo-1",
+			userID:   "user-2",
+			wantCode: cerror.ErrCodeForbidden,
+			wantErr:  true,
+		},
+		{
+			name: "error - todo not in trash",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByID(ctx, "todo-2").
+					Return(nil, errors.New("not found"))
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			todoID:   "todo-2",
+			userID:   "user-1",
+			wantCode: cerror.ErrCodeNotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			gotOutput, gotErr := interactor.RestoreTodo(ctx, tt.todoID, tt.userID)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+				assert.Nil(t, gotOutput)
+				var cerr *cerror.AppError
+				if assert.ErrorAs(t, gotErr, &cerr) {
+					assert.Equal(t, tt.wantCode, cerr.Code)
+				}
+			} else {
+				assert.NoError(t, gotErr)
+				assert.Nil(t, gotOutput.DeletedAt)
+			}
+		})
+	}
+}
+
+func TestTodoInteractor_PurgeTodo(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		usecase func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
+		todoID  string
+		userID  string
+		wantErr bool
+	}{
+		{
+			name: "success - owner can purge",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						DeletedAt: &now,
+					}, nil)
+
+				todoRepo.EXPECT().
+					Purge(ctx, "todo-1").
+					Return(nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			todoID:  "todo-1",
+			userID:  "user-1",
+			wantErr: false,
+		},
+		{
+			name: "error - non-owner cannot purge",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				userRepo := mock_repository.NewMockIUserRepository(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+
+				todoRepo.EXPECT().
+					FindDeletedByID(ctx, "todo-1").
+					Return(&model.Todo{
+						ID:        "todo-1",
+						UserID:    "user-1",
+						TenantID:  "tenant-1",
+						DeletedAt: &now,
+					}, nil)
+
+				return &TodoInteractor{
+					todoRepo: todoRepo,
+					userRepo: userRepo,
+					uuidGen:  uuidGen,
+				}
+			},
+			todoID:  "todo-1",
+			userID:  "user-2",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			gotErr := interactor.PurgeTodo(ctx, tt.todoID, tt.userID)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+// expectInlineTx makes the transaction manager run callbacks directly
+func expectInlineTx(txManager *mock_repository.MockITransactionManager) {
+	run := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	txManager.EXPECT().InTx(gomock.Any(), gomock.Any()).DoAndReturn(run).AnyTimes()
+	txManager.EXPECT().Savepoint(gomock.Any(), gomock.Any()).DoAndReturn(run).AnyTimes()
+}
+
+func TestTodoInteractor_BatchTodos(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	ownTodo := func() *model.Todo {
+		return &model.Todo{ID: "todo-1", UserID: "user-1", TenantID: "tenant-1", Title: "Mine", Version: 1, CreatedAt: now, UpdatedAt: now}
+	}
+	otherTodo := func() *model.Todo {
+		return &model.Todo{ID: "todo-2", UserID: "user-2", TenantID: "tenant-1", Title: "Theirs", Version: 1, CreatedAt: now, UpdatedAt: now}
+	}
+	operations := []input.BatchTodoOperation{
+		{Op: input.BatchTodoOpCreate, Create: &input.CreateTodoInput{Title: "New"}},
+		{Op: input.BatchTodoOpComplete, TodoID: "todo-1"},
+		{Op: input.BatchTodoOpDelete, TodoID: "todo-2"},
+	}
+
+	tests := []struct {
+		name         string
+		usecase      func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor
+		input        *input.BatchTodosInput
+		wantStatuses []int
+		wantCode     cerror.ErrorCode
+		wantErr      bool
+	}{
+		{
+			name: "success - independent mode reports each result",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				txManager := mock_repository.NewMockITransactionManager(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+				expectInlineTx(txManager)
+
+				uuidGen.EXPECT().Generate().Return("generated-uuid")
+				todoRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						if todo.UserID != "user-1" || todo.TenantID != "tenant-1" {
+							return nil, errors.New("owner not taken from the batch")
+						}
+						return todo, nil
+					})
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(ownTodo(), nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+				// Ownership is still checked, so todo-2 is never deleted
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-2").Return(otherTodo(), nil)
+
+				return &TodoInteractor{
+					todoRepo:  todoRepo,
+					txManager: txManager,
+					uuidGen:   uuidGen,
+				}
+			},
+			input: &input.BatchTodosInput{
+				UserID:     "user-1",
+				TenantID:   "tenant-1",
+				Operations: operations,
+			},
+			wantStatuses: []int{201, 200, 403},
+			wantErr:      false,
+		},
+		{
+			name: "error - atomic mode fails on the first failing operation",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				txManager := mock_repository.NewMockITransactionManager(ctrl)
+				uuidGen := mock_pkg.NewMockIUUIDGenerator(ctrl)
+				expectInlineTx(txManager)
+
+				uuidGen.EXPECT().Generate().Return("generated-uuid")
+				todoRepo.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-1").Return(ownTodo(), nil)
+				todoRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, todo *model.Todo) (*model.Todo, error) {
+						return todo, nil
+					})
+				todoRepo.EXPECT().FindByID(gomock.Any(), "todo-2").Return(otherTodo(), nil)
+
+				return &TodoInteractor{
+					todoRepo:  todoRepo,
+					txManager: txManager,
+					uuidGen:   uuidGen,
+				}
+			},
+			input: &input.BatchTodosInput{
+				UserID:     "user-1",
+				TenantID:   "tenant-1",
+				Atomic:     true,
+				Operations: operations,
+			},
+			wantCode: cerror.ErrCodeForbidden,
+			wantErr:  true,
+		},
+		{
+			name: "error - create without title",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				txManager := mock_repository.NewMockITransactionManager(ctrl)
+				expectInlineTx(txManager)
+
+				return &TodoInteractor{
+					txManager: txManager,
+				}
+			},
+			input: &input.BatchTodosInput{
+				UserID:   "user-1",
+				TenantID: "tenant-1",
+				Atomic:   true,
+				Operations: []input.BatchTodoOperation{
+					{Op: input.BatchTodoOpCreate, Create: &input.CreateTodoInput{}},
+				},
+			},
+			wantCode: cerror.ErrCodeValidationError,
+			wantErr:  true,
+		},
+		{
+			name: "error - too many operations",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITodoInteractor {
+				return &TodoInteractor{}
+			},
+			input: &input.BatchTodosInput{
+				UserID:     "user-1",
+				TenantID:   "tenant-1",
+				Operations: make([]input.BatchTodoOperation, MaxBatchOperations+1),
+			},
+			wantCode: cerror.ErrCodeValidationError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			gotOutput, gotErr := interactor.BatchTodos(ctx, tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+				assert.Nil(t, gotOutput)
+				var appErr *cerror.AppError
+				if assert.ErrorAs(t, gotErr, &appErr) {
+					assert.Equal(t, tt.wantCode, appErr.Code)
+				}
+			} else {
+				assert.NoError(t, gotErr)
+				gotStatuses := make([]int, len(gotOutput.Results))
+				for i, r := range gotOutput.Results {
+					gotStatuses[i] = r.Status
+				}
+				assert.Equal(t, tt.wantStatuses, gotStatuses)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	"good-todo-go/internal/usecase/output"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of the usecase spans
+const tracerName = "good-todo-go/internal/usecase"
+
+// endSpan records the outcome of a usecase method. Client errors are recorded
+// without failing the span, as the server span already carries the status
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		var appErr *cerror.AppError
+		if !errors.As(err, &appErr) || appErr.HTTPStatus >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+// tracedTodoInteractor records a span around each ITodoInteractor method
+type tracedTodoInteractor struct {
+	next   ITodoInteractor
+	tracer trace.Tracer
+}
+
+func NewTracedTodoInteractor(next ITodoInteractor, tp trace.TracerProvider) ITodoInteractor {
+	return &tracedTodoInteractor{next: next, tracer: tp.Tracer(tracerName)}
+}
+
+func (t *tracedTodoInteractor) GetTodos(ctx context.Context, in *input.GetTodosInput) (out *output.TodoListOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.GetTodos")
+	defer func() { endSpan(span, err) }()
+	return t.next.GetTodos(ctx, in)
+}
+
+func (t *tracedTodoInteractor) GetPublicTodos(ctx context.Context, in *input.GetPublicTodosInput) (out *output.TodoListOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.GetPublicTodos")
+	defer func() { endSpan(span, err) }()
+	return t.next.GetPublicTodos(ctx, in)
+}
+
+func (t *tracedTodoInteractor) GetTodo(ctx context.Context, todoID, userID string) (out *output.TodoOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.GetTodo", trace.WithAttributes(attribute.String("todo.id", todoID)))
+	defer func() { endSpan(span, err) }()
+	return t.next.GetTodo(ctx, todoID, userID)
+}
+
+func (t *tracedTodoInteractor) CreateTodo(ctx context.Context, in *input.CreateTodoInput) (out *output.TodoOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.CreateTodo")
+	defer func() { endSpan(span, err) }()
+	return t.next.CreateTodo(ctx, in)
+}
+
+func (t *tracedTodoInteractor) UpdateTodo(ctx context.Context, in *input.UpdateTodoInput) (out *output.TodoOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.UpdateTodo", trace.WithAttributes(attribute.String("todo.id", in.TodoID)))
+	defer func() { endSpan(span, err) }()
+	return t.next.UpdateTodo(ctx, in)
+}
+
+func (t *tracedTodoInteractor) DeleteTodo(ctx context.Context, todoID, userID string) (err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.DeleteTodo", trace.WithAttributes(attribute.String("todo.id", todoID)))
+	defer func() { endSpan(span, err) }()
+	return t.next.DeleteTodo(ctx, todoID, userID)
+}
+
+func (t *tracedTodoInteractor) GetTrash(ctx context.Context, in *input.GetTrashInput) (out *output.TodoListOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.GetTrash")
+	defer func() { endSpan(span, err) }()
+	return t.next.GetTrash(ctx, in)
+}
+
+func (t *tracedTodoInteractor) RestoreTodo(ctx context.Context, todoID, userID string) (out *output.TodoOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.RestoreTodo", trace.WithAttributes(attribute.String("todo.id", todoID)))
+	defer func() { endSpan(span, err) }()
+	return t.next.RestoreTodo(ctx, todoID, userID)
+}
+
+func (t *tracedTodoInteractor) PurgeTodo(ctx context.Context, todoID, userID string) (err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.PurgeTodo", trace.WithAttributes(attribute.String("todo.id", todoID)))
+	defer func() { endSpan(span, err) }()
+	return t.next.PurgeTodo(ctx, todoID, userID)
+}
+
+func (t *tracedTodoInteractor) BatchTodos(ctx context.Context, in *input.BatchTodosInput) (out *output.BatchTodosOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "TodoInteractor.BatchTodos", trace.WithAttributes(attribute.Int("todo.batch.operations", len(in.Operations))))
+	defer func() { endSpan(span, err) }()
+	return t.next.BatchTodos(ctx, in)
+}
+
+// tracedAuthInteractor records a span around each IAuthInteractor method
+type tracedAuthInteractor struct {
+	next   IAuthInteractor
+	tracer trace.Tracer
+}
+
+func NewTracedAuthInteractor(next IAuthInteractor, tp trace.TracerProvider) IAuthInteractor {
+	return &tracedAuthInteractor{next: next, tracer: tp.Tracer(tracerName)}
+}
+
+func (t *tracedAuthInteractor) Register(ctx context.Context, in *input.RegisterInput) (out *output.AuthOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.Register")
+	defer func() { endSpan(span, err) }()
+	return t.next.Register(ctx, in)
+}
+
+func (t *tracedAuthInteractor) Login(ctx context.Context, in *input.LoginInput) (out *output.AuthOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.Login")
+	defer func() { endSpan(span, err) }()
+	return t.next.Login(ctx, in)
+}
+
+func (t *tracedAuthInteractor) EnrollMFA(ctx context.Context, in *input.EnrollMFAInput) (out *output.MFAEnrollmentOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.EnrollMFA")
+	defer func() { endSpan(span, err) }()
+	return t.next.EnrollMFA(ctx, in)
+}
+
+func (t *tracedAuthInteractor) VerifyMFA(ctx context.Context, in *input.VerifyMFAInput) (out *output.AuthOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.VerifyMFA")
+	defer func() { endSpan(span, err) }()
+	return t.next.VerifyMFA(ctx, in)
+}
+
+func (t *tracedAuthInteractor) VerifyEmail(ctx context.Context, in *input.VerifyEmailInput) (out *output.VerifyEmailOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.VerifyEmail")
+	defer func() { endSpan(span, err) }()
+	return t.next.VerifyEmail(ctx, in)
+}
+
+func (t *tracedAuthInteractor) RefreshToken(ctx context.Context, in *input.RefreshTokenInput) (out *output.AuthOutput, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.RefreshToken")
+	defer func() { endSpan(span, err) }()
+	return t.next.RefreshToken(ctx, in)
+}
+
+func (t *tracedAuthInteractor) UnlockUser(ctx context.Context, in *input.UnlockUserInput) (err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.UnlockUser")
+	defer func() { endSpan(span, err) }()
+	return t.next.UnlockUser(ctx, in)
+}
+
+func (t *tracedAuthInteractor) PurgeLoginAttempts(ctx context.Context) (n int, err error) {
+	ctx, span := t.tracer.Start(ctx, "AuthInteractor.PurgeLoginAttempts")
+	defer func() { endSpan(span, err) }()
+	return t.next.PurgeLoginAttempts(ctx)
+}
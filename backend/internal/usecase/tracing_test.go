@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/usecase/input"
+	mock_usecase "good-todo-go/internal/usecase/mock"
+	"good-todo-go/internal/usecase/output"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/mock/gomock"
+)
+
+// inSpan matches a context carrying the span of the traced method
+var inSpan = gomock.Cond(func(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+})
+
+func TestTracedInteractors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		call       func(ctx context.Context, ctrl *gomock.Controller, tp trace.TracerProvider) error
+		wantSpan   string
+		wantAttrs  []attribute.KeyValue
+		wantStatus codes.Code
+		wantEvents int
+	}{
+		{
+			name: "success - span around the todo method",
+			call: func(ctx context.Context, ctrl *gomock.Controller, tp trace.TracerProvider) error {
+				next := mock_usecase.NewMockITodoInteractor(ctrl)
+				next.EXPECT().GetTodo(inSpan, "todo-1", "user-1").Return(&output.TodoOutput{ID: "todo-1"}, nil)
+
+				_, err := NewTracedTodoInteractor(next, tp).GetTodo(ctx, "todo-1", "user-1")
+				return err
+			},
+			wantSpan:   "TodoInteractor.GetTodo",
+			wantAttrs:  []attribute.KeyValue{attribute.String("todo.id", "todo-1")},
+			wantStatus: codes.Unset,
+		},
+		{
+			name: "client error is recorded without failing the span",
+			call: func(ctx context.Context, ctrl *gomock.Controller, tp trace.TracerProvider) error {
+				next := mock_usecase.NewMockITodoInteractor(ctrl)
+				next.EXPECT().DeleteTodo(inSpan, "todo-1", "user-1").Return(cerror.NewNotFound("todo not found", nil))
+
+				return NewTracedTodoInteractor(next, tp).DeleteTodo(ctx, "todo-1", "user-1")
+			},
+			wantSpan:   "TodoInteractor.DeleteTodo",
+			wantAttrs:  []attribute.KeyValue{attribute.String("todo.id", "todo-1")},
+			wantStatus: codes.Unset,
+			wantEvents: 1,
+		},
+		{
+			name: "unexpected error fails the span",
+			call: func(ctx context.Context, ctrl *gomock.Controller, tp trace.TracerProvider) error {
+				next := mock_usecase.NewMockIAuthInteractor(ctrl)
+				next.EXPECT().Login(inSpan, gomock.Any()).Return(nil, errors.New("db error"))
+
+				_, err := NewTracedAuthInteractor(next, tp).Login(ctx, &input.LoginInput{Email: "alice@example.com"})
+				return err
+			},
+			wantSpan:   "AuthInteractor.Login",
+			wantStatus: codes.Error,
+			wantEvents: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			_ = tt.call(context.Background(), ctrl, tp)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, tt.wantSpan, spans[0].Name())
+			assert.Equal(t, tt.wantStatus, spans[0].Status().Code)
+			assert.Len(t, spans[0].Events(), tt.wantEvents)
+			for _, attr := range tt.wantAttrs {
+				assert.Contains(t, spans[0].Attributes(), attr)
+			}
+		})
+	}
+}
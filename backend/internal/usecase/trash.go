@@ -0,0 +1,65 @@
+//go:generate mockgen -source=$GOFILE -destination=mock/$GOFILE -package=mock_usecase
+package usecase
+
+import (
+	"context"
+	"log/slog"
+
+	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+)
+
+type ITrashInteractor interface {
+	// PurgeExpired permanently deletes trashed todos older than each tenant's retention period
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type TrashInteractor struct {
+	todoRepo             repository.ITodoRepository
+	authRepo             repository.IAuthRepository
+	clock                pkg.IClock
+	defaultRetentionDays int
+}
+
+func NewTrashInteractor(
+	todoRepo repository.ITodoRepository,
+	authRepo repository.IAuthRepository,
+	clock pkg.IClock,
+	defaultRetentionDays int,
+) ITrashInteractor {
+	return &TrashInteractor{
+		todoRepo:             todoRepo,
+		authRepo:             authRepo,
+		clock:                clock,
+		defaultRetentionDays: defaultRetentionDays,
+	}
+}
+
+func (i *TrashInteractor) PurgeExpired(ctx context.Context) (int, error) {
+	tenants, err := i.authRepo.FindAllTenants(ctx)
+	if err != nil {
+		return 0, cerror.NewInternalServerError("failed to get tenants", err)
+	}
+
+	now := i.clock.Now()
+	purged := 0
+	for _, t := range tenants {
+		days := i.defaultRetentionDays
+		// An override below a day would purge todos as soon as they are deleted
+		if t.TrashRetentionDays != nil && *t.TrashRetentionDays >= 1 {
+			days = *t.TrashRetentionDays
+		}
+		before := now.AddDate(0, 0, -days)
+
+		n, err := i.todoRepo.PurgeDeletedBefore(ctx, t.ID, before)
+		if err != nil {
+			// Keep going so one broken tenant does not block the others
+			slog.ErrorContext(ctx, "failed to purge trash", "tenant_id", t.ID, "error", err)
+			continue
+		}
+		purged += n
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"good-todo-go/internal/domain/model"
+	mock_repository "good-todo-go/internal/domain/repository/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTrashInteractor_PurgeExpired(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 31, 12, 0, 0, 0, time.UTC)
+	sevenDays := 7
+
+	tests := []struct {
+		name    string
+		usecase func(ctx context.Context, ctrl *gomock.Controller) ITrashInteractor
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "success - tenant override and default retention",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITrashInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+
+				authRepo.EXPECT().
+					FindAllTenants(ctx).
+					Return([]*model.Tenant{
+						{ID: "tenant-1", TrashRetentionDays: &sevenDays},
+						{ID: "tenant-2"},
+					}, nil)
+
+				todoRepo.EXPECT().
+					PurgeDeletedBefore(ctx, "tenant-1", now.AddDate(0, 0, -7)).
+					Return(2, nil)
+				todoRepo.EXPECT().
+					PurgeDeletedBefore(ctx, "tenant-2", now.AddDate(0, 0, -30)).
+					Return(3, nil)
+
+				return NewTrashInteractor(todoRepo, authRepo, clock, 30)
+			},
+			want:    5,
+			wantErr: false,
+		},
+		{
+			name: "success - override below a day falls back to the default",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITrashInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+
+				zeroDays := 0
+				authRepo.EXPECT().
+					FindAllTenants(ctx).
+					Return([]*model.Tenant{
+						{ID: "tenant-1", TrashRetentionDays: &zeroDays},
+					}, nil)
+
+				todoRepo.EXPECT().
+					PurgeDeletedBefore(ctx, "tenant-1", now.AddDate(0, 0, -30)).
+					Return(1, nil)
+
+				return NewTrashInteractor(todoRepo, authRepo, clock, 30)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "success - failing tenant does not block others",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITrashInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				clock.EXPECT().Now().Return(now)
+
+				authRepo.EXPECT().
+					FindAllTenants(ctx).
+					Return([]*model.Tenant{
+						{ID: "tenant-1"},
+						{ID: "tenant-2"},
+					}, nil)
+
+				todoRepo.EXPECT().
+					PurgeDeletedBefore(ctx, "tenant-1", gomock.Any()).
+					Return(0, errors.New("db error"))
+				todoRepo.EXPECT().
+					PurgeDeletedBefore(ctx, "tenant-2", gomock.Any()).
+					Return(1, nil)
+
+				return NewTrashInteractor(todoRepo, authRepo, clock, 30)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "error - cannot list tenants",
+			usecase: func(ctx context.Context, ctrl *gomock.Controller) ITrashInteractor {
+				todoRepo := mock_repository.NewMockITodoRepository(ctrl)
+				authRepo := mock_repository.NewMockIAuthRepository(ctrl)
+				clock := mock_pkg.NewMockIClock(ctrl)
+
+				authRepo.EXPECT().
+					FindAllTenants(ctx).
+					Return(nil, errors.New("db error"))
+
+				return NewTrashInteractor(todoRepo, authRepo, clock, 30)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			interactor := tt.usecase(ctx, ctrl)
+
+			got, gotErr := interactor.PurgeExpired(ctx)
+
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+			} else {
+				assert.NoError(t, gotErr)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
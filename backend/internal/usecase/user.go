@@ -3,25 +3,71 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
 
+	"good-todo-go/internal/domain/model"
 	"good-todo-go/internal/domain/repository"
+	"good-todo-go/internal/pkg"
 	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
 	"good-todo-go/internal/usecase/input"
 	"good-todo-go/internal/usecase/output"
 )
 
+const (
+	// emailChangeTTL is how long the token mailed to the new address can be confirmed
+	emailChangeTTL = 24 * time.Hour
+)
+
 type IUserInteractor interface {
 	GetMe(ctx context.Context, userID string) (*output.UserOutput, error)
 	UpdateMe(ctx context.Context, in *input.UpdateUserInput) (*output.UserOutput, error)
+	// ChangePassword replaces the password and logs out every other session of the user
+	ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error
+	// RequestEmailChange mails a confirmation token to the new address. The email is not changed yet
+	RequestEmailChange(ctx context.Context, in *input.RequestEmailChangeInput) (*output.UserOutput, error)
+	// ConfirmEmailChange switches to the pending email once its token is confirmed
+	ConfirmEmailChange(ctx context.Context, in *input.ConfirmEmailChangeInput) (*output.UserOutput, error)
+	// SetPasswordPolicy changes the tenant's password rules (tenant admins only).
+	// Existing passwords are checked against them at their next change
+	SetPasswordPolicy(ctx context.Context, in *input.SetPasswordPolicyInput) error
+	// SetRateLimit changes the tenant's per-member request limit (tenant admins only).
+	// Instances apply it once their cached limit expires
+	SetRateLimit(ctx context.Context, in *input.SetRateLimitInput) error
+	// SetTrashRetention changes how long the tenant's deleted todos are kept (tenant admins only)
+	SetTrashRetention(ctx context.Context, in *input.SetTrashRetentionInput) error
 }
 
 type UserInteractor struct {
-	userRepo repository.IUserRepository
+	userRepo    repository.IUserRepository
+	authRepo    repository.IAuthRepository
+	sessionRepo repository.ISessionRepository
+	clock       pkg.IClock
+	mailer      mailer.IMailer
+	passwords   *PasswordPolicy
 }
 
-func NewUserInteractor(userRepo repository.IUserRepository) IUserInteractor {
+func NewUserInteractor(
+	userRepo repository.IUserRepository,
+	authRepo repository.IAuthRepository,
+	sessionRepo repository.ISessionRepository,
+	clock pkg.IClock,
+	mail mailer.IMailer,
+	passwords *PasswordPolicy,
+) IUserInteractor {
 	return &UserInteractor{
-		userRepo: userRepo,
+		userRepo:    userRepo,
+		authRepo:    authRepo,
+		sessionRepo: sessionRepo,
+		clock:       clock,
+		mailer:      mail,
+		passwords:   passwords,
 	}
 }
 
@@ -39,8 +85,9 @@ func (i *UserInteractor) UpdateMe(ctx context.Context, in *input.UpdateUserInput
 		return nil, cerror.NewNotFound("user not found", err)
 	}
 
-	if in.Name != nil {
-		user.Name = *in.Name
+	// A null name clears it
+	if in.Name.IsSet() {
+		user.Name, _ = in.Name.Get()
 	}
 
 	updated, err := i.userRepo.Update(ctx, user)
@@ -50,3 +97,212 @@ func (i *UserInteractor) UpdateMe(ctx context.Context, in *input.UpdateUserInput
 
 	return output.NewUserOutput(updated), nil
 }
+
+func (i *UserInteractor) ChangePassword(ctx context.Context, in *input.ChangePasswordInput) error {
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return cerror.NewNotFound("user not found", err)
+	}
+	if !pkg.CheckPasswordHash(in.CurrentPassword, user.PasswordHash) {
+		return cerror.NewBadRequest("current password is incorrect", nil)
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, user.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+	if err := i.passwords.Validate(ctx, tenant, user, "new_password", in.NewPassword); err != nil {
+		return err
+	}
+	if err := i.passwords.SetPassword(tenant, user, in.NewPassword); err != nil {
+		return err
+	}
+	if _, err := i.authRepo.UpdateUser(ctx, user); err != nil {
+		return cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	// Whoever knew the old password is logged out, the caller stays logged in
+	if _, err := i.sessionRepo.RevokeAllByUserID(ctx, user.TenantID, user.ID, in.CurrentSessionID, i.clock.Now()); err != nil {
+		return cerror.NewInternalServerError("failed to revoke sessions", err)
+	}
+	return nil
+}
+
+func (i *UserInteractor) RequestEmailChange(ctx context.Context, in *input.RequestEmailChangeInput) (*output.UserOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+	if !pkg.CheckPasswordHash(in.CurrentPassword, user.PasswordHash) {
+		return nil, cerror.NewBadRequest("current password is incorrect", nil)
+	}
+	if in.NewEmail == user.Email {
+		return nil, cerror.NewBadRequest("new email is the current email", nil)
+	}
+	if existing, _ := i.authRepo.FindUserByEmail(ctx, user.TenantID, in.NewEmail); existing != nil {
+		return nil, cerror.NewConflict("email already exists", nil)
+	}
+
+	token, err := generateVerificationToken()
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to generate token", err)
+	}
+	tokenHash := hashEmailChangeToken(token)
+	expiresAt := i.clock.Now().Add(emailChangeTTL)
+	user.PendingEmail = &in.NewEmail
+	user.EmailChangeTokenHash = &tokenHash
+	user.EmailChangeExpiresAt = &expiresAt
+
+	updated, err := i.authRepo.UpdateUser(ctx, user)
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	msg := &mailer.Message{
+		To:      in.NewEmail,
+		Subject: "Confirm your new Good Todo email address",
+		Body: fmt.Sprintf(
+			"Confirm this address with the following token to make it the email of your account:\n\n%s\n\n"+
+				"The token expires at %s. If you did not ask for this, ignore this email.\n",
+			token,
+			expiresAt.UTC().Format(time.RFC1123),
+		),
+	}
+	if err := i.mailer.Send(ctx, msg); err != nil {
+		return nil, cerror.NewInternalServerError("failed to send confirmation email", err)
+	}
+
+	return output.NewUserOutput(updated), nil
+}
+
+func (i *UserInteractor) ConfirmEmailChange(ctx context.Context, in *input.ConfirmEmailChangeInput) (*output.UserOutput, error) {
+	if err := in.Validate(); err != nil {
+		return nil, err
+	}
+
+	user, err := i.authRepo.FindUserByID(ctx, in.TenantID, in.UserID)
+	if err != nil {
+		return nil, cerror.NewNotFound("user not found", err)
+	}
+	if user.PendingEmail == nil || user.EmailChangeTokenHash == nil ||
+		subtle.ConstantTimeCompare([]byte(hashEmailChangeToken(in.Token)), []byte(*user.EmailChangeTokenHash)) != 1 {
+		return nil, cerror.NewBadRequest("invalid or expired token", nil)
+	}
+	if user.EmailChangeExpiresAt != nil && !i.clock.Now().Before(*user.EmailChangeExpiresAt) {
+		return nil, cerror.NewBadRequest("invalid or expired token", nil)
+	}
+
+	oldEmail := user.Email
+	user.Email = *user.PendingEmail
+	// Confirming the token proves the new address is the user's
+	user.EmailVerified = true
+	user.PendingEmail = nil
+	user.EmailChangeTokenHash = nil
+	user.EmailChangeExpiresAt = nil
+
+	updated, err := i.authRepo.UpdateUser(ctx, user)
+	if errors.Is(err, repository.ErrEmailTaken) {
+		return nil, cerror.NewConflict("email already exists", err)
+	}
+	if err != nil {
+		return nil, cerror.NewInternalServerError("failed to update user", err)
+	}
+
+	i.notifyEmailChanged(ctx, updated, oldEmail)
+	return output.NewUserOutput(updated), nil
+}
+
+// notifyEmailChanged tells the old address about the change. A failure only logs, the change stays
+func (i *UserInteractor) notifyEmailChanged(ctx context.Context, user *model.User, oldEmail string) {
+	msg := &mailer.Message{
+		To:      oldEmail,
+		Subject: "Your Good Todo email address has been changed",
+		Body: fmt.Sprintf(
+			"The email of your account was changed to %s.\n"+
+				"If this was not you, contact a tenant admin.\n",
+			user.Email,
+		),
+	}
+	if err := i.mailer.Send(ctx, msg); err != nil {
+		slog.ErrorContext(ctx, "failed to send email change notification", "user_id", user.ID, "error", err)
+	}
+}
+
+// hashEmailChangeToken needs no salt: the token is 32 random bytes
+func hashEmailChangeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (i *UserInteractor) SetPasswordPolicy(ctx context.Context, in *input.SetPasswordPolicyInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can change the password policy", nil)
+	}
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+
+	tenant.PasswordMinLength = in.MinLength
+	tenant.PasswordMinCharacterClasses = in.MinCharacterClasses
+	tenant.PasswordHistory = in.History
+	if _, err := i.authRepo.UpdateTenant(ctx, tenant); err != nil {
+		return cerror.NewInternalServerError("failed to update tenant", err)
+	}
+
+	return nil
+}
+
+func (i *UserInteractor) SetRateLimit(ctx context.Context, in *input.SetRateLimitInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can change the rate limit", nil)
+	}
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+
+	tenant.RateLimitPerMinute = in.PerMinute
+	if _, err := i.authRepo.UpdateTenant(ctx, tenant); err != nil {
+		return cerror.NewInternalServerError("failed to update tenant", err)
+	}
+
+	return nil
+}
+
+func (i *UserInteractor) SetTrashRetention(ctx context.Context, in *input.SetTrashRetentionInput) error {
+	if in.Role != "admin" {
+		return cerror.NewForbidden("only tenant admins can change the trash retention", nil)
+	}
+	if err := in.Validate(); err != nil {
+		return err
+	}
+
+	tenant, err := i.authRepo.FindTenantByID(ctx, in.TenantID)
+	if err != nil {
+		return cerror.NewNotFound("tenant not found", err)
+	}
+
+	tenant.TrashRetentionDays = in.Days
+	if _, err := i.authRepo.UpdateTenant(ctx, tenant); err != nil {
+		return cerror.NewInternalServerError("failed to update tenant", err)
+	}
+
+	return nil
+}
@@ -3,10 +3,18 @@ package usecase
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"good-todo-go/internal/domain/model"
+	"good-todo-go/internal/domain/repository"
 	mock_repository "good-todo-go/internal/domain/repository/mock"
+	"good-todo-go/internal/pkg"
+	"good-todo-go/internal/pkg/cerror"
+	"good-todo-go/internal/pkg/mailer"
+	mock_mailer "good-todo-go/internal/pkg/mailer/mock"
+	mock_pkg "good-todo-go/internal/pkg/mock"
 	"good-todo-go/internal/usecase/input"
 
 	"github.com/stretchr/testify/assert"
@@ -61,7 +69,7 @@ func TestUserInteractor_GetMe(t *testing.T) {
 			userRepo := mock_repository.NewMockIUserRepository(ctrl)
 			tt.setupMocks(userRepo)
 
-			interactor := NewUserInteractor(userRepo)
+			interactor := NewUserInteractor(userRepo, nil, nil, nil, nil, nil)
 
 			result, err := interactor.GetMe(context.Background(), tt.userID)
 
@@ -94,7 +102,7 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			name: "success - update name",
 			input: &input.UpdateUserInput{
 				UserID: "user-id-1",
-				Name:   strPtr("Updated Name"),
+				Name:   input.Some("Updated Name"),
 			},
 			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
 				userRepo.EXPECT().
@@ -123,10 +131,9 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			expectedName: "Updated Name",
 		},
 		{
-			name: "success - no update (nil name)",
+			name: "success - no update (name absent)",
 			input: &input.UpdateUserInput{
 				UserID: "user-id-1",
-				Name:   nil,
 			},
 			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
 				userRepo.EXPECT().
@@ -154,11 +161,37 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			wantErr:      false,
 			expectedName: "Original Name",
 		},
+		{
+			name: "success - null name clears it",
+			input: &input.UpdateUserInput{
+				UserID: "user-id-1",
+				Name:   input.Null[string](),
+			},
+			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
+				userRepo.EXPECT().
+					FindByID(gomock.Any(), "user-id-1").
+					Return(&model.User{
+						ID:       "user-id-1",
+						TenantID: "tenant-id",
+						Email:    "test@example.com",
+						Name:     "Original Name",
+						Role:     "member",
+					}, nil)
+
+				userRepo.EXPECT().
+					Update(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						return u, nil
+					})
+			},
+			wantErr:      false,
+			expectedName: "",
+		},
 		{
 			name: "fail - user not found",
 			input: &input.UpdateUserInput{
 				UserID: "non-existent-id",
-				Name:   strPtr("New Name"),
+				Name:   input.Some("New Name"),
 			},
 			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
 				userRepo.EXPECT().
@@ -172,7 +205,7 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			name: "fail - update error",
 			input: &input.UpdateUserInput{
 				UserID: "user-id-1",
-				Name:   strPtr("New Name"),
+				Name:   input.Some("New Name"),
 			},
 			setupMocks: func(userRepo *mock_repository.MockIUserRepository) {
 				userRepo.EXPECT().
@@ -202,7 +235,7 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 			userRepo := mock_repository.NewMockIUserRepository(ctrl)
 			tt.setupMocks(userRepo)
 
-			interactor := NewUserInteractor(userRepo)
+			interactor := NewUserInteractor(userRepo, nil, nil, nil, nil, nil)
 
 			result, err := interactor.UpdateMe(context.Background(), tt.input)
 
@@ -220,6 +253,494 @@ func TestUserInteractor_UpdateMe(t *testing.T) {
 	}
 }
 
-func strPtr(s string) *string {
-	return &s
+type userTestMocks struct {
+	authRepo    *mock_repository.MockIAuthRepository
+	sessionRepo *mock_repository.MockISessionRepository
+	mailer      *mock_mailer.MockIMailer
+}
+
+func newUserTestInteractor(t *testing.T, now time.Time) (IUserInteractor, userTestMocks) {
+	ctrl := gomock.NewController(t)
+	m := userTestMocks{
+		authRepo:    mock_repository.NewMockIAuthRepository(ctrl),
+		sessionRepo: mock_repository.NewMockISessionRepository(ctrl),
+		mailer:      mock_mailer.NewMockIMailer(ctrl),
+	}
+	clock := mock_pkg.NewMockIClock(ctrl)
+	clock.EXPECT().Now().Return(now).AnyTimes()
+	return NewUserInteractor(mock_repository.NewMockIUserRepository(ctrl), m.authRepo, m.sessionRepo, clock, m.mailer, newTestPasswordPolicy(t)), m
+}
+
+func TestUserInteractor_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	hash, err := pkg.HashPassword("old-password")
+	require.NoError(t, err)
+	newUser := func() *model.User {
+		return &model.User{ID: "user-id", TenantID: "tenant-id", PasswordHash: hash}
+	}
+
+	tests := []struct {
+		name       string
+		in         *input.ChangePasswordInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - other sessions are revoked",
+			in: &input.ChangePasswordInput{
+				TenantID: "tenant-id", UserID: "user-id", CurrentSessionID: "current",
+				CurrentPassword: "old-password", NewPassword: "new-password",
+			},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", PasswordHistory: 3}, nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.True(t, pkg.CheckPasswordHash("new-password", u.PasswordHash))
+						assert.Equal(t, []string{hash}, u.PasswordHistoryHashes)
+						return u, nil
+					})
+				m.sessionRepo.EXPECT().RevokeAllByUserID(gomock.Any(), "tenant-id", "user-id", "current", now).Return(2, nil)
+			},
+		},
+		{
+			name: "fail - wrong current password",
+			in: &input.ChangePasswordInput{
+				TenantID: "tenant-id", UserID: "user-id",
+				CurrentPassword: "wrong-password", NewPassword: "new-password",
+			},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - new password too short",
+			in: &input.ChangePasswordInput{
+				TenantID: "tenant-id", UserID: "user-id",
+				CurrentPassword: "old-password", NewPassword: "short",
+			},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+			},
+			wantCode: cerror.ErrCodeValidationError,
+			wantErr:  true,
+		},
+		{
+			name: "fail - current password reused under a history policy",
+			in: &input.ChangePasswordInput{
+				TenantID: "tenant-id", UserID: "user-id",
+				CurrentPassword: "old-password", NewPassword: "old-password",
+			},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", PasswordHistory: 1}, nil)
+			},
+			wantCode: cerror.ErrCodeValidationError,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.ChangePassword(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUserInteractor_RequestEmailChange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	hash, err := pkg.HashPassword("password123")
+	require.NoError(t, err)
+	newUser := func() *model.User {
+		return &model.User{ID: "user-id", TenantID: "tenant-id", Email: "old@example.com", PasswordHash: hash}
+	}
+
+	tests := []struct {
+		name       string
+		in         *input.RequestEmailChangeInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - token is mailed to the new address",
+			in:   &input.RequestEmailChangeInput{TenantID: "tenant-id", UserID: "user-id", NewEmail: "new@example.com", CurrentPassword: "password123"},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindUserByEmail(gomock.Any(), "tenant-id", "new@example.com").Return(nil, errors.New("not found"))
+				var saved *model.User
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, "old@example.com", u.Email)
+						assert.Equal(t, "new@example.com", *u.PendingEmail)
+						assert.Equal(t, now.Add(emailChangeTTL), *u.EmailChangeExpiresAt)
+						saved = u
+						return u, nil
+					})
+				m.mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg *mailer.Message) error {
+						assert.Equal(t, "new@example.com", msg.To)
+						// Only the hash of the mailed token is stored
+						token := strings.TrimSpace(strings.Split(msg.Body, "\n\n")[1])
+						assert.Equal(t, hashEmailChangeToken(token), *saved.EmailChangeTokenHash)
+						return nil
+					})
+			},
+		},
+		{
+			name: "fail - wrong current password",
+			in:   &input.RequestEmailChangeInput{TenantID: "tenant-id", UserID: "user-id", NewEmail: "new@example.com", CurrentPassword: "wrong"},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - address taken in the tenant",
+			in:   &input.RequestEmailChangeInput{TenantID: "tenant-id", UserID: "user-id", NewEmail: "taken@example.com", CurrentPassword: "password123"},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(), nil)
+				m.authRepo.EXPECT().FindUserByEmail(gomock.Any(), "tenant-id", "taken@example.com").Return(&model.User{ID: "other-user"}, nil)
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			out, err := interactor.RequestEmailChange(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "old@example.com", out.Email)
+			assert.Equal(t, "new@example.com", *out.PendingEmail)
+		})
+	}
+}
+
+func TestUserInteractor_ConfirmEmailChange(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	newUser := func(expiresAt time.Time) *model.User {
+		pending := "new@example.com"
+		tokenHash := hashEmailChangeToken("the-token")
+		return &model.User{
+			ID: "user-id", TenantID: "tenant-id", Email: "old@example.com",
+			PendingEmail: &pending, EmailChangeTokenHash: &tokenHash, EmailChangeExpiresAt: &expiresAt,
+		}
+	}
+	in := func(token string) *input.ConfirmEmailChangeInput {
+		return &input.ConfirmEmailChangeInput{TenantID: "tenant-id", UserID: "user-id", Token: token}
+	}
+
+	tests := []struct {
+		name       string
+		in         *input.ConfirmEmailChangeInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success - email switched and the old address notified",
+			in:   in("the-token"),
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(now.Add(time.Hour)), nil)
+				m.authRepo.EXPECT().
+					UpdateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u *model.User) (*model.User, error) {
+						assert.Equal(t, "new@example.com", u.Email)
+						assert.True(t, u.EmailVerified)
+						assert.Nil(t, u.PendingEmail)
+						assert.Nil(t, u.EmailChangeTokenHash)
+						assert.Nil(t, u.EmailChangeExpiresAt)
+						return u, nil
+					})
+				m.mailer.EXPECT().
+					Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, msg *mailer.Message) error {
+						assert.Equal(t, "old@example.com", msg.To)
+						return errors.New("smtp down")
+					})
+			},
+		},
+		{
+			name: "fail - wrong token",
+			in:   in("other-token"),
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(now.Add(time.Hour)), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - expired token",
+			in:   in("the-token"),
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(now), nil)
+			},
+			wantCode: cerror.ErrCodeBadRequest,
+			wantErr:  true,
+		},
+		{
+			name: "fail - address taken since the request",
+			in:   in("the-token"),
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindUserByID(gomock.Any(), "tenant-id", "user-id").Return(newUser(now.Add(time.Hour)), nil)
+				m.authRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil, repository.ErrEmailTaken)
+			},
+			wantCode: cerror.ErrCodeConflict,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			out, err := interactor.ConfirmEmailChange(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "new@example.com", out.Email)
+		})
+	}
+}
+
+func TestUserInteractor_SetPasswordPolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	minLength := 12
+
+	tests := []struct {
+		name       string
+		in         *input.SetPasswordPolicyInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			in:   &input.SetPasswordPolicyInput{Role: "admin", TenantID: "tenant-id", MinLength: &minLength, MinCharacterClasses: 3, History: 5},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id", PasswordMinLength: &minLength, PasswordMinCharacterClasses: 3, PasswordHistory: 5}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name:       "fail - not an admin",
+			in:         &input.SetPasswordPolicyInput{Role: "member", TenantID: "tenant-id"},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:       "fail - too many character classes",
+			in:         &input.SetPasswordPolicyInput{Role: "admin", TenantID: "tenant-id", MinCharacterClasses: 5},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeValidationError,
+			wantErr:    true,
+		},
+		{
+			name:       "fail - history too long",
+			in:         &input.SetPasswordPolicyInput{Role: "admin", TenantID: "tenant-id", History: 25},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeValidationError,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.SetPasswordPolicy(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUserInteractor_SetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	perMinute := 60
+	current := 120
+
+	tests := []struct {
+		name       string
+		in         *input.SetRateLimitInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			in:   &input.SetRateLimitInput{Role: "admin", TenantID: "tenant-id", PerMinute: &perMinute},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id", RateLimitPerMinute: &perMinute}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name: "success - null restores the default",
+			in:   &input.SetRateLimitInput{Role: "admin", TenantID: "tenant-id"},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", RateLimitPerMinute: &current}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id"}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name:       "fail - not an admin",
+			in:         &input.SetRateLimitInput{Role: "member", TenantID: "tenant-id", PerMinute: &perMinute},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:       "fail - zero",
+			in:         &input.SetRateLimitInput{Role: "admin", TenantID: "tenant-id", PerMinute: new(int)},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeValidationError,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.SetRateLimit(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUserInteractor_SetTrashRetention(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	days := 7
+	current := 90
+
+	tests := []struct {
+		name       string
+		in         *input.SetTrashRetentionInput
+		setupMocks func(m userTestMocks)
+		wantCode   cerror.ErrorCode
+		wantErr    bool
+	}{
+		{
+			name: "success",
+			in:   &input.SetTrashRetentionInput{Role: "admin", TenantID: "tenant-id", Days: &days},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id"}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id", TrashRetentionDays: &days}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name: "success - null restores the default",
+			in:   &input.SetTrashRetentionInput{Role: "admin", TenantID: "tenant-id"},
+			setupMocks: func(m userTestMocks) {
+				m.authRepo.EXPECT().FindTenantByID(gomock.Any(), "tenant-id").Return(&model.Tenant{ID: "tenant-id", TrashRetentionDays: &current}, nil)
+				m.authRepo.EXPECT().
+					UpdateTenant(gomock.Any(), &model.Tenant{ID: "tenant-id"}).
+					Return(&model.Tenant{}, nil)
+			},
+		},
+		{
+			name:       "fail - not an admin",
+			in:         &input.SetTrashRetentionInput{Role: "member", TenantID: "tenant-id", Days: &days},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeForbidden,
+			wantErr:    true,
+		},
+		{
+			name:       "fail - zero",
+			in:         &input.SetTrashRetentionInput{Role: "admin", TenantID: "tenant-id", Days: new(int)},
+			setupMocks: func(userTestMocks) {},
+			wantCode:   cerror.ErrCodeValidationError,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interactor, m := newUserTestInteractor(t, now)
+			tt.setupMocks(m)
+
+			err := interactor.SetTrashRetention(context.Background(), tt.in)
+
+			if tt.wantErr {
+				var appErr *cerror.AppError
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }